@@ -56,6 +56,10 @@ func init() {
 	flags.BoolVar(&autoUpdateOptions.DryRun, "dry-run", false, "Check for pending updates")
 	flags.BoolVar(&autoUpdateOptions.Rollback, "rollback", true, "Rollback to previous image if update fails")
 
+	healthTimeoutFlagName := "health-timeout"
+	flags.DurationVar(&autoUpdateOptions.HealthTimeout, healthTimeoutFlagName, 0, "Consider the update failed if updated containers are not healthy within the timeout")
+	_ = autoUpdateCommand.RegisterFlagCompletionFunc(healthTimeoutFlagName, completion.AutocompleteNone)
+
 	flags.StringVar(&autoUpdateOptions.format, "format", "", "Change the output format to JSON or a Go template")
 	_ = autoUpdateCommand.RegisterFlagCompletionFunc("format", common.AutocompleteFormat(&autoUpdateOutput{}))
 
@@ -97,6 +101,7 @@ type autoUpdateOutput struct {
 	Image         string
 	Policy        string
 	Updated       string
+	Digest        string
 }
 
 func reportsToOutput(allReports []*entities.AutoUpdateReport) []autoUpdateOutput {
@@ -110,6 +115,7 @@ func reportsToOutput(allReports []*entities.AutoUpdateReport) []autoUpdateOutput
 			Image:         r.ImageName,
 			Policy:        r.Policy,
 			Updated:       r.Updated,
+			Digest:        r.Digest,
 		}
 	}
 	return output
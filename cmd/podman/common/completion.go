@@ -747,6 +747,18 @@ func AutocompleteSecretCreate(_ *cobra.Command, args []string, _ string) ([]stri
 	return nil, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteSecretRotate - Autocomplete the secret, then the file with the
+// new data.
+func AutocompleteSecretRotate(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		return getSecrets(cmd, toComplete, completeDefault)
+	case 1:
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteImages - Autocomplete images.
 func AutocompleteImages(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	if !ValidCurrentCmdLine(cmd, args, toComplete) {
@@ -972,6 +984,47 @@ func AutocompleteNetworkConnectCmd(cmd *cobra.Command, args []string, toComplete
 	return nil, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteContainerMigrateCmd - Autocomplete podman container migrate command args.
+func AutocompleteContainerMigrateCmd(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return AutocompleteContainersRunning(cmd, args, toComplete)
+	}
+	if len(args) == 1 {
+		return AutocompleteSystemConnections(cmd, args, toComplete)
+	}
+	// don't complete more than 2 args
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
+// AutocompleteVolumeSnapshots - Autocomplete a volume as first arg and
+// snapshots of that volume as further args.
+func AutocompleteVolumeSnapshots(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if !ValidCurrentCmdLine(cmd, args, toComplete) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	if len(args) == 0 {
+		return getVolumes(cmd, toComplete)
+	}
+
+	engine, err := setupContainerEngine(cmd)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	snapshots, err := engine.VolumeSnapshotList(registry.Context(), args[:1])
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	suggestions := []string{}
+	for _, s := range snapshots {
+		if strings.HasPrefix(s.Name, toComplete) {
+			suggestions = append(suggestions, s.Name)
+		}
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteTopCmd - Autocomplete podman top/pod top command args.
 func AutocompleteTopCmd(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	latest := cmd.Flags().Lookup("latest")
@@ -1158,10 +1211,10 @@ func AutocompleteImageVolume(_ *cobra.Command, _ []string, _ string) ([]string,
 }
 
 // AutocompleteLogDriver - Autocomplete log-driver options.
-// -> "journald", "none", "k8s-file", "passthrough", "passthrough-tty"
+// -> "journald", "none", "k8s-file", "syslog", "gelf", "fluentd", "passthrough", "passthrough-tty"
 func AutocompleteLogDriver(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 	// don't show json-file
-	logDrivers := []string{define.JournaldLogging, define.NoLogging, define.KubernetesLogging}
+	logDrivers := []string{define.JournaldLogging, define.NoLogging, define.KubernetesLogging, define.SyslogLogging, define.GELFLogging, define.FluentdLogging}
 	if !registry.IsRemote() {
 		logDrivers = append(logDrivers, define.PassthroughLogging, define.PassthroughTTYLogging)
 	}
@@ -1171,7 +1224,10 @@ func AutocompleteLogDriver(_ *cobra.Command, _ []string, _ string) ([]string, co
 // AutocompleteLogOpt - Autocomplete log-opt options.
 // -> "path=", "tag="
 func AutocompleteLogOpt(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	logOptions := []string{"path=", "tag=", "max-size=", "label="}
+	logOptions := []string{
+		"path=", "tag=", "max-size=", "max-file=", "compress=", "max-age=", "label=",
+		"syslog-address=", "syslog-facility=", "gelf-address=", "gelf-compression-type=", "fluentd-address=",
+	}
 	if strings.HasPrefix(toComplete, "path=") {
 		return nil, cobra.ShellCompDirectiveDefault
 	}
@@ -1603,7 +1659,7 @@ func AutocompleteEventFilter(cmd *cobra.Command, _ []string, toComplete string)
 			events.Attach.String(), events.AutoUpdate.String(), events.Checkpoint.String(), events.Cleanup.String(),
 			events.Commit.String(), events.Create.String(), events.Exec.String(), events.ExecDied.String(),
 			events.Exited.String(), events.Export.String(), events.Import.String(), events.Init.String(), events.Kill.String(),
-			events.LoadFromArchive.String(), events.Mount.String(), events.NetworkConnect.String(),
+			events.LoadFromArchive.String(), events.LogShipError.String(), events.Mount.String(), events.NetworkConnect.String(),
 			events.NetworkDisconnect.String(), events.Pause.String(), events.Prune.String(), events.Pull.String(),
 			events.PullError.String(), events.Push.String(), events.Refresh.String(), events.Remove.String(),
 			events.Rename.String(), events.Renumber.String(), events.Restart.String(), events.Restore.String(),
@@ -1723,7 +1779,7 @@ func AutocompleteImageScpFormat(_ *cobra.Command, _ []string, _ string) ([]strin
 func AutocompleteWaitCondition(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 	states := []string{
 		"unknown", "configured", "created", "exited",
-		"healthy", "initialized", "paused", "removing", "running",
+		"healthy", "initialized", "paused", "ready", "removing", "running",
 		"stopped", "stopping", "unhealthy",
 	}
 	return states, cobra.ShellCompDirectiveNoFileComp
@@ -1769,9 +1825,9 @@ func AutocompleteContainersConfModules(_ *cobra.Command, _ []string, toComplete
 }
 
 // AutocompleteEventBackend - Autocomplete event backend options.
-// -> "file", "journald", "none"
+// -> "file", "journald", "webhook", "none"
 func AutocompleteEventBackend(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
-	types := []string{events.LogFile.String(), events.Journald.String(), events.Null.String()}
+	types := []string{events.LogFile.String(), events.Journald.String(), events.Webhook.String(), events.Null.String()}
 	return types, cobra.ShellCompDirectiveNoFileComp
 }
 
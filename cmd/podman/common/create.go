@@ -712,6 +712,31 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 		)
 		_ = cmd.RegisterFlagCompletionFunc(restartFlagName, AutocompleteRestartOption)
 	}
+	if mode == entities.CreateMode {
+		restartDelayFlagName := "restart-delay"
+		createFlags.StringVar(
+			&cf.RestartDelay,
+			restartDelayFlagName, "",
+			"Delay before the restart policy restarts the container, doubled on every consecutive restart",
+		)
+		_ = cmd.RegisterFlagCompletionFunc(restartDelayFlagName, completion.AutocompleteNone)
+
+		restartMaxDelayFlagName := "restart-max-delay"
+		createFlags.StringVar(
+			&cf.RestartMaxDelay,
+			restartMaxDelayFlagName, "",
+			"Maximum delay before the restart policy restarts the container",
+		)
+		_ = cmd.RegisterFlagCompletionFunc(restartMaxDelayFlagName, completion.AutocompleteNone)
+
+		restartResetWindowFlagName := "restart-reset-window"
+		createFlags.StringVar(
+			&cf.RestartResetWindow,
+			restartResetWindowFlagName, "",
+			"Time a container must run before its restart delay is reset",
+		)
+		_ = cmd.RegisterFlagCompletionFunc(restartResetWindowFlagName, completion.AutocompleteNone)
+	}
 	if mode == entities.InfraMode || (mode == entities.CreateMode) { // infra container flags, create should also pick these up
 		shmSizeFlagName := "shm-size"
 		createFlags.String(
@@ -116,9 +116,6 @@ func checkpoint(cmd *cobra.Command, args []string) error {
 	if checkpointOptions.Export == "" && checkpointOptions.IgnoreVolumes {
 		return errors.New("--ignore-volumes can only be used with --export")
 	}
-	if checkpointOptions.WithPrevious && checkpointOptions.PreCheckPoint {
-		return errors.New("--with-previous can not be used with --pre-checkpoint")
-	}
 	if (checkpointOptions.WithPrevious || checkpointOptions.PreCheckPoint) && !criu.MemTrack() {
 		return errors.New("system (architecture/kernel/CRIU) does not support memory tracking")
 	}
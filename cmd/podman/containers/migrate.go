@@ -0,0 +1,75 @@
+package containers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/criu"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/common/pkg/ssh"
+)
+
+var (
+	migrateDescription = `
+   podman container migrate
+
+   Migrates a running container to another host.  Pre-checkpoints and the final
+   checkpoint are copied to the destination over ssh, where the container is
+   restored.  The container is stopped on this host.
+`
+	migrateCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "migrate [options] CONTAINER DESTINATION",
+		Short:             "Migrate a container to another host",
+		Long:              migrateDescription,
+		RunE:              migrate,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: common.AutocompleteContainerMigrateCmd,
+		Example: `podman container migrate ctrID server2
+podman container migrate --pre-dumps 3 --tcp-established ctrID root@192.168.1.20`,
+	}
+)
+
+var migrateOptions entities.ContainerMigrateOptions
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: migrateCommand,
+		Parent:  containerCmd,
+	})
+	flags := migrateCommand.Flags()
+
+	preDumpsFlagName := "pre-dumps"
+	flags.IntVar(&migrateOptions.PreDumps, preDumpsFlagName, 1, "Number of pre-checkpoints to transfer while the container keeps running")
+	_ = migrateCommand.RegisterFlagCompletionFunc(preDumpsFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&migrateOptions.TCPEstablished, "tcp-established", false, "Migrate a container with established TCP connections")
+	flags.BoolVar(&migrateOptions.FileLocks, "file-locks", false, "Migrate a container with file locks")
+	flags.BoolVar(&migrateOptions.IgnoreVolumes, "ignore-volumes", false, "Do not transfer volumes associated with the container")
+}
+
+func migrate(_ *cobra.Command, args []string) error {
+	if rootless.IsRootless() {
+		return errors.New("migrating a container requires root")
+	}
+	if migrateOptions.PreDumps < 0 {
+		return fmt.Errorf("invalid number of pre-dumps %d", migrateOptions.PreDumps)
+	}
+	if migrateOptions.PreDumps > 0 && !criu.MemTrack() {
+		return errors.New("system (architecture/kernel/CRIU) does not support memory tracking, use --pre-dumps=0")
+	}
+
+	migrateOptions.Destination = args[1]
+	migrateOptions.SSHMode = ssh.DefineMode(registry.PodmanConfig().SSHMode)
+	report, err := registry.ContainerEngine().ContainerMigrate(registry.Context(), args[0], migrateOptions)
+	if err != nil {
+		return err
+	}
+	fmt.Println(report.RemoteID)
+	return nil
+}
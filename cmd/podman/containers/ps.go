@@ -14,6 +14,7 @@ import (
 	"github.com/containers/podman/v6/cmd/podman/registry"
 	"github.com/containers/podman/v6/cmd/podman/utils"
 	"github.com/containers/podman/v6/cmd/podman/validate"
+	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/docker/go-units"
 	"github.com/sirupsen/logrus"
@@ -374,6 +375,9 @@ func (l psReporter) Status() string {
 	case "exited", "stopped":
 		t := units.HumanDuration(time.Since(time.Unix(l.ExitedAt, 0)))
 		state = fmt.Sprintf("Exited (%d) %s ago", l.ExitCode, t)
+	case define.ContainerStateBackoff:
+		t := units.HumanDuration(time.Until(time.Unix(l.RestartAt, 0)))
+		state = fmt.Sprintf("Backoff (%d) restarting in %s", l.ExitCode, t)
 	default:
 		// Need to capitalize the first letter to match Docker.
 
@@ -383,13 +387,32 @@ func (l psReporter) Status() string {
 		//nolint:staticcheck
 		state = strings.Title(l.ListContainer.State)
 	}
-	hc := l.ListContainer.Status
-	if hc != "" {
-		state += " (" + hc + ")"
+	var details []string
+	if hc := l.ListContainer.Status; hc != "" {
+		details = append(details, hc)
+	}
+	if l.ListContainer.Ready != nil && l.ListContainer.State == "running" {
+		details = append(details, l.Ready())
+	}
+	if len(details) > 0 {
+		state += " (" + strings.Join(details, ", ") + ")"
 	}
 	return state
 }
 
+// Ready returns the readiness of the container, or an empty string if it
+// has no readiness check.
+func (l psReporter) Ready() string {
+	switch {
+	case l.ListContainer.Ready == nil:
+		return ""
+	case *l.ListContainer.Ready:
+		return define.ReadinessCheckReady
+	default:
+		return define.ReadinessCheckNotReady
+	}
+}
+
 func (l psReporter) Restarts() string {
 	return strconv.Itoa(int(l.ListContainer.Restarts))
 }
@@ -64,7 +64,7 @@ func init() {
 	_ = restoreCommand.RegisterFlagCompletionFunc(nameFlagName, completion.AutocompleteNone)
 
 	importPreviousFlagName := "import-previous"
-	flags.StringVar(&restoreOptions.ImportPrevious, importPreviousFlagName, "", "Restore from exported pre-checkpoint archive (tar.gz)")
+	flags.StringArrayVar(&restoreOptions.ImportPrevious, importPreviousFlagName, nil, "Restore from exported pre-checkpoint archive (tar.gz), can be specified multiple times for incremental pre-checkpoints")
 	_ = restoreCommand.RegisterFlagCompletionFunc(importPreviousFlagName, completion.AutocompleteDefault)
 
 	flags.BoolVar(&restoreOptions.IgnoreRootFS, "ignore-rootfs", false, "Do not apply root file-system changes when importing from exported checkpoint")
@@ -125,7 +125,7 @@ func restore(cmd *cobra.Command, args []string) error {
 
 	notImport := !restoreOptions.CheckpointImage && restoreOptions.Import == ""
 
-	if notImport && restoreOptions.ImportPrevious != "" {
+	if notImport && len(restoreOptions.ImportPrevious) > 0 {
 		return fmt.Errorf("--import-previous can only be used with image or --import")
 	}
 	if notImport && restoreOptions.IgnoreRootFS {
@@ -0,0 +1,51 @@
+package containers
+
+import (
+	"errors"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+// rotateLogCommand is run by the log rotation timer of containers that use
+// the max-file log option.
+var rotateLogCommand = &cobra.Command{
+	Annotations: map[string]string{registry.EngineMode: registry.ABIMode},
+	Use:         "rotate-log [options] [CONTAINER]",
+	Short:       "Rotate the log file of a container",
+	Args: func(_ *cobra.Command, args []string) error {
+		switch {
+		case rotateLogOptions.All && len(args) > 0:
+			return errors.New("--all and a container cannot be used together")
+		case !rotateLogOptions.All && len(args) != 1:
+			return errors.New("requires exactly one container or --all")
+		}
+		return nil
+	},
+	Hidden:            true,
+	RunE:              rotateLog,
+	ValidArgsFunction: common.AutocompleteContainersRunning,
+	Example: `podman container rotate-log ctrID
+podman container rotate-log --all`,
+}
+
+var rotateLogOptions entities.ContainerRotateLogOptions
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: rotateLogCommand,
+		Parent:  containerCmd,
+	})
+	flags := rotateLogCommand.Flags()
+	flags.BoolVarP(&rotateLogOptions.All, "all", "a", false, "Rotate the log files of all containers registered for log rotation")
+}
+
+func rotateLog(_ *cobra.Command, args []string) error {
+	var nameOrID string
+	if len(args) > 0 {
+		nameOrID = args[0]
+	}
+	return registry.ContainerEngine().ContainerRotateLog(registry.Context(), nameOrID, rotateLogOptions)
+}
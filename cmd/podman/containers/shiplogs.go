@@ -0,0 +1,31 @@
+package containers
+
+import (
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/spf13/cobra"
+)
+
+// shipLogsCommand is started for containers that use the syslog, gelf or
+// fluentd log driver.
+var shipLogsCommand = &cobra.Command{
+	Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+	Use:               "ship-logs CONTAINER",
+	Short:             "Ship the logs of a container to its log server",
+	Args:              cobra.ExactArgs(1),
+	Hidden:            true,
+	RunE:              shipLogs,
+	ValidArgsFunction: common.AutocompleteContainers,
+	Example:           "podman container ship-logs ctrID",
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: shipLogsCommand,
+		Parent:  containerCmd,
+	})
+}
+
+func shipLogs(_ *cobra.Command, args []string) error {
+	return registry.ContainerEngine().ContainerShipLogs(registry.Context(), args[0])
+}
@@ -20,6 +20,7 @@ type buildOptions struct {
 	local        bool
 	platforms    []string
 	farm         string
+	pushOnly     bool
 }
 
 var (
@@ -57,6 +58,9 @@ func init() {
 	// Default for local is true
 	flags.BoolVarP(&buildOpts.local, localFlagName, "l", true, "Build image on local machine as well as on farm nodes")
 
+	pushOnlyFlagName := "push-only"
+	flags.BoolVar(&buildOpts.pushOnly, pushOnlyFlagName, false, "Push the manifest list straight to the registry without storing it locally")
+
 	platformsFlag := "platforms"
 	buildCommand.PersistentFlags().StringSliceVar(&buildOpts.platforms, platformsFlag, nil, "Build only on farm nodes that match the given platforms")
 	_ = buildCommand.RegisterFlagCompletionFunc(platformsFlag, completion.AutocompletePlatform)
@@ -104,6 +108,7 @@ func build(cmd *cobra.Command, args []string) error {
 		}()
 	}
 	opts.Cleanup = buildOpts.buildOptions.Cleanup
+	opts.PushOnly = buildOpts.pushOnly
 	iidFile, err := cmd.Flags().GetString("iidfile")
 	if err != nil {
 		return err
@@ -6,6 +6,7 @@ import (
 
 	"github.com/containers/podman/v6/cmd/podman/registry"
 	"github.com/containers/podman/v6/cmd/podman/validate"
+	"github.com/containers/podman/v6/pkg/farm"
 	"github.com/spf13/cobra"
 	"go.podman.io/common/pkg/completion"
 	"go.podman.io/common/pkg/config"
@@ -26,8 +27,17 @@ var (
 		PersistentPostRunE: validate.NoOp,
 		ValidArgsFunction:  completion.AutocompleteNone,
 		Example: `podman farm create myfarm connection1
-podman farm create myfarm`,
+podman farm create myfarm
+podman farm create --priority connection1=1 --weight connection2=3 myfarm connection1 connection2
+podman farm create --cache-repo quay.io/myuser/cache myfarm connection1 connection2`,
 	}
+
+	// Temporary struct to hold cli values.
+	createOpts = struct {
+		CacheRepo string
+		Priority  map[string]int
+		Weight    map[string]int
+	}{}
 )
 
 func init() {
@@ -35,12 +45,28 @@ func init() {
 		Command: createCommand,
 		Parent:  farmCmd,
 	})
+	flags := createCommand.Flags()
+
+	cacheRepoFlagName := "cache-repo"
+	flags.StringVar(&createOpts.CacheRepo, cacheRepoFlagName, "", "set the repository builds on the farm share their build cache through")
+	_ = createCommand.RegisterFlagCompletionFunc(cacheRepoFlagName, completion.AutocompleteNone)
+
+	priorityFlagName := "priority"
+	flags.StringToIntVar(&createOpts.Priority, priorityFlagName, nil, "set the scheduling priority of a connection in the farm (`CONNECTION=PRIORITY`)")
+	_ = createCommand.RegisterFlagCompletionFunc(priorityFlagName, completion.AutocompleteNone)
+	weightFlagName := "weight"
+	flags.StringToIntVar(&createOpts.Weight, weightFlagName, nil, "set the scheduling weight of a connection in the farm (`CONNECTION=WEIGHT`)")
+	_ = createCommand.RegisterFlagCompletionFunc(weightFlagName, completion.AutocompleteNone)
 }
 
 func create(_ *cobra.Command, args []string) error {
 	farmName := args[0]
 	connections := args[1:]
 
+	if err := validateCacheRepo(createOpts.CacheRepo); err != nil {
+		return err
+	}
+
 	err := config.EditConnectionConfig(func(cfg *config.ConnectionsFile) error {
 		if _, ok := cfg.Farm.List[farmName]; ok {
 			// if farm exists return an error
@@ -64,6 +90,10 @@ func create(_ *cobra.Command, args []string) error {
 			}
 		}
 
+		if err := validateNodes(cfg.Farm.List[farmName], farmName, createOpts.Priority, createOpts.Weight); err != nil {
+			return err
+		}
+
 		// If this is the first farm being created, set it as the default farm
 		if len(cfg.Farm.List) == 1 {
 			cfg.Farm.Default = farmName
@@ -74,6 +104,17 @@ func create(_ *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	err = farm.EditSettings(func(conf *farm.SettingsFile) error {
+		// Drop the settings left over from a farm removed before
+		// they were cleaned up.
+		settings := farm.Settings{Cache: createOpts.CacheRepo}
+		setNodes(&settings, createOpts.Priority, createOpts.Weight)
+		conf.Farms[farmName] = settings
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 	fmt.Printf("Farm %q created\n", farmName)
 	return nil
 }
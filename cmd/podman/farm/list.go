@@ -1,6 +1,7 @@
 package farm
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"sort"
@@ -8,6 +9,7 @@ import (
 	"github.com/containers/podman/v6/cmd/podman/common"
 	"github.com/containers/podman/v6/cmd/podman/registry"
 	"github.com/containers/podman/v6/cmd/podman/validate"
+	"github.com/containers/podman/v6/pkg/farm"
 	"github.com/spf13/cobra"
 	"go.podman.io/common/pkg/completion"
 	"go.podman.io/common/pkg/config"
@@ -37,6 +39,12 @@ and the output format can be changed to JSON or a user specified Go template.`
 	}{}
 )
 
+// listFarm is a farm with its podman specific settings.
+type listFarm struct {
+	config.Farm
+	farm.Settings
+}
+
 func init() {
 	registry.Commands = append(registry.Commands, registry.CliCommand{
 		Command: lsCommand,
@@ -46,7 +54,7 @@ func init() {
 
 	formatFlagName := "format"
 	flags.StringVar(&lsOpts.Format, formatFlagName, "", "Format farm output using Go template")
-	_ = lsCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&config.Farm{}))
+	_ = lsCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&listFarm{}))
 }
 
 func list(cmd *cobra.Command, args []string) error {
@@ -55,11 +63,19 @@ func list(cmd *cobra.Command, args []string) error {
 		format = "json"
 	}
 
-	farms, err := registry.PodmanConfig().ContainersConfDefaultsRO.GetAllFarms()
+	allFarms, err := registry.PodmanConfig().ContainersConfDefaultsRO.GetAllFarms()
 	if err != nil {
 		return err
 	}
 
+	farms := make([]listFarm, 0, len(allFarms))
+	for _, f := range allFarms {
+		settings, err := farm.GetSettings(f.Name)
+		if err != nil {
+			return err
+		}
+		farms = append(farms, listFarm{Farm: f, Settings: settings})
+	}
 	sort.Slice(farms, func(i, j int) bool {
 		return farms[i].Name < farms[j].Name
 	})
@@ -68,7 +84,7 @@ func list(cmd *cobra.Command, args []string) error {
 	defer rpt.Flush()
 
 	if report.IsJSON(format) {
-		buf, err := registry.JSONLibrary().MarshalIndent(farms, "", "    ")
+		buf, err := json.MarshalIndent(farms, "", "    ")
 		if err == nil {
 			fmt.Println(string(buf))
 		}
@@ -7,6 +7,7 @@ import (
 	"github.com/containers/podman/v6/cmd/podman/common"
 	"github.com/containers/podman/v6/cmd/podman/registry"
 	"github.com/containers/podman/v6/cmd/podman/validate"
+	"github.com/containers/podman/v6/pkg/farm"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"go.podman.io/common/pkg/config"
@@ -88,6 +89,18 @@ func rm(_ *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	err = farm.EditSettings(func(conf *farm.SettingsFile) error {
+		if rmOpts.All {
+			clear(conf.Farms)
+		}
+		for _, k := range deletedFarms {
+			delete(conf.Farms, k)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 	if rmOpts.All {
 		fmt.Println("All farms have been deleted")
 		return nil
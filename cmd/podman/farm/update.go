@@ -5,16 +5,18 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/containers/buildah/pkg/parse"
 	"github.com/containers/podman/v6/cmd/podman/common"
 	"github.com/containers/podman/v6/cmd/podman/registry"
 	"github.com/containers/podman/v6/cmd/podman/validate"
+	"github.com/containers/podman/v6/pkg/farm"
 	"github.com/spf13/cobra"
 	"go.podman.io/common/pkg/completion"
 	"go.podman.io/common/pkg/config"
 )
 
 var (
-	farmUpdateDescription = `Update an existing farm by adding a connection, removing a connection, or changing it to the default farm.`
+	farmUpdateDescription = `Update an existing farm by adding a connection, removing a connection, setting the scheduling priority or weight of a connection, setting the build cache repository, or changing it to the default farm.`
 	updateCommand         = &cobra.Command{
 		Use:                "update [options] FARM",
 		Short:              "Update an existing farm",
@@ -26,14 +28,19 @@ var (
 		ValidArgsFunction:  common.AutoCompleteFarms,
 		Example: `podman farm update --add con1 farm1
 podman farm update --remove con2 farm2
-podman farm update --default farm3`,
+podman farm update --default farm3
+podman farm update --priority con1=0 --priority con2=1 --weight con3=2 farm1
+podman farm update --cache-repo quay.io/myuser/cache farm1`,
 	}
 
 	// Temporary struct to hold cli values.
 	updateOpts = struct {
-		Add     []string
-		Remove  []string
-		Default bool
+		Add       []string
+		Remove    []string
+		Default   bool
+		Priority  map[string]int
+		Weight    map[string]int
+		CacheRepo string
 	}{}
 )
 
@@ -50,17 +57,33 @@ func init() {
 	removeFlagName := "remove"
 	flags.StringSliceVarP(&updateOpts.Remove, removeFlagName, "r", nil, "remove system connection(s) from farm")
 	_ = updateCommand.RegisterFlagCompletionFunc(removeFlagName, completion.AutocompleteNone)
+	cacheRepoFlagName := "cache-repo"
+	flags.StringVar(&updateOpts.CacheRepo, cacheRepoFlagName, "", "set the repository builds on the farm share their build cache through, \"\" to stop sharing it")
+	_ = updateCommand.RegisterFlagCompletionFunc(cacheRepoFlagName, completion.AutocompleteNone)
 	defaultFlagName := "default"
 	flags.BoolVarP(&updateOpts.Default, defaultFlagName, "d", false, "set the given farm as the default farm")
+	priorityFlagName := "priority"
+	flags.StringToIntVar(&updateOpts.Priority, priorityFlagName, nil, "set the scheduling priority of a connection in the farm (`CONNECTION=PRIORITY`)")
+	_ = updateCommand.RegisterFlagCompletionFunc(priorityFlagName, completion.AutocompleteNone)
+	weightFlagName := "weight"
+	flags.StringToIntVar(&updateOpts.Weight, weightFlagName, nil, "set the scheduling weight of a connection in the farm (`CONNECTION=WEIGHT`)")
+	_ = updateCommand.RegisterFlagCompletionFunc(weightFlagName, completion.AutocompleteNone)
 }
 
 func farmUpdate(cmd *cobra.Command, args []string) error {
 	farmName := args[0]
 
 	defChanged := cmd.Flags().Changed("default")
+	cacheChanged := cmd.Flags().Changed("cache-repo")
 
-	if len(updateOpts.Add) == 0 && len(updateOpts.Remove) == 0 && !defChanged {
-		return fmt.Errorf("nothing to update for farm %q, please use the --add, --remove, or --default flags to update a farm", farmName)
+	if len(updateOpts.Add) == 0 && len(updateOpts.Remove) == 0 && len(updateOpts.Priority) == 0 && len(updateOpts.Weight) == 0 && !defChanged && !cacheChanged {
+		return fmt.Errorf("nothing to update for farm %q, please use the --add, --remove, --priority, --weight, --cache-repo, or --default flags to update a farm", farmName)
+	}
+
+	if cacheChanged {
+		if err := validateCacheRepo(updateOpts.CacheRepo); err != nil {
+			return err
+		}
 	}
 
 	err := config.EditConnectionConfig(func(cfg *config.ConnectionsFile) error {
@@ -113,11 +136,79 @@ func farmUpdate(cmd *cobra.Command, args []string) error {
 			}
 			cfg.Farm.List[farmName] = updatedConnections
 		}
-		return nil
+		return validateNodes(cfg.Farm.List[farmName], farmName, updateOpts.Priority, updateOpts.Weight)
 	})
 	if err != nil {
 		return err
 	}
+	if len(updateOpts.Remove) > 0 || len(updateOpts.Priority) > 0 || len(updateOpts.Weight) > 0 || cacheChanged {
+		err = farm.EditSettings(func(conf *farm.SettingsFile) error {
+			settings := conf.Farms[farmName]
+			for _, c := range updateOpts.Remove {
+				delete(settings.Nodes, c)
+			}
+			setNodes(&settings, updateOpts.Priority, updateOpts.Weight)
+			if cacheChanged {
+				settings.Cache = updateOpts.CacheRepo
+			}
+			conf.Farms[farmName] = settings
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
 	fmt.Printf("Farm %q updated\n", farmName)
 	return nil
 }
+
+// validateNodes checks the scheduling priorities and weights of connections
+// in a farm with the given connections.
+func validateNodes(connections []string, farmName string, priorities, weights map[string]int) error {
+	for _, settings := range []map[string]int{priorities, weights} {
+		for c := range settings {
+			if !slices.Contains(connections, c) {
+				return fmt.Errorf("cannot set scheduling of %q, it is not a connection in farm %q", c, farmName)
+			}
+		}
+	}
+	for c, weight := range weights {
+		if weight < 0 {
+			return fmt.Errorf("invalid weight %d for %q: must not be negative", weight, c)
+		}
+	}
+	return nil
+}
+
+// setNodes sets the scheduling priority and weight of connections in the
+// settings of a farm.
+func setNodes(settings *farm.Settings, priorities, weights map[string]int) {
+	if len(priorities) == 0 && len(weights) == 0 {
+		return
+	}
+	if settings.Nodes == nil {
+		settings.Nodes = make(map[string]farm.Node)
+	}
+	for c, priority := range priorities {
+		node := settings.Nodes[c]
+		node.Priority = priority
+		settings.Nodes[c] = node
+	}
+	for c, weight := range weights {
+		node := settings.Nodes[c]
+		node.Weight = weight
+		settings.Nodes[c] = node
+	}
+}
+
+// validateCacheRepo checks the repository builds on a farm share their build
+// cache through, an empty repository stops sharing it.
+func validateCacheRepo(repo string) error {
+	if repo == "" {
+		return nil
+	}
+	if _, err := parse.RepoNamesToNamedReferences([]string{repo}); err != nil {
+		return fmt.Errorf("invalid cache repository: %w", err)
+	}
+	return nil
+}
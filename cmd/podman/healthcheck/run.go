@@ -21,7 +21,10 @@ var runCmd = &cobra.Command{
 	ValidArgsFunction: common.AutocompleteContainersRunning,
 }
 
-var ignoreResult bool
+var (
+	ignoreResult bool
+	readiness    bool
+)
 
 func init() {
 	registry.Commands = append(registry.Commands, registry.CliCommand{
@@ -32,15 +35,19 @@ func init() {
 	flags := runCmd.Flags()
 	flags.BoolVar(&ignoreResult, "ignore-result", false,
 		"Exit with code 0 regardless of healthcheck result or if the container is still in startup period")
+	// Used by the readiness check timer of containers created from a
+	// Kubernetes readinessProbe.
+	flags.BoolVar(&readiness, "readiness", false, "Run the readiness check instead of the healthcheck")
+	_ = flags.MarkHidden("readiness")
 }
 
 func run(_ *cobra.Command, args []string) error {
-	response, err := registry.ContainerEngine().HealthCheckRun(context.Background(), args[0], entities.HealthCheckOptions{})
+	response, err := registry.ContainerEngine().HealthCheckRun(context.Background(), args[0], entities.HealthCheckOptions{Readiness: readiness})
 	if err != nil {
 		return err
 	}
 	switch response.Status {
-	case define.HealthCheckUnhealthy, define.HealthCheckStarting, define.HealthCheckStopped:
+	case define.HealthCheckUnhealthy, define.HealthCheckStarting, define.HealthCheckStopped, define.ReadinessCheckNotReady:
 		if ignoreResult {
 			registry.SetExitCode(0)
 		} else {
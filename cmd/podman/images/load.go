@@ -65,11 +65,18 @@ func loadFlags(cmd *cobra.Command) {
 	if !registry.IsRemote() {
 		flags.StringVar(&loadOpts.SignaturePolicy, "signature-policy", "", "Pathname of signature policy file")
 		_ = flags.MarkHidden("signature-policy")
+		flags.BoolVar(&loadOpts.ReuseLayers, "reuse-layers", false, "Take layers missing from the archive from local storage")
+		_ = flags.MarkHidden("reuse-layers")
 	}
 }
 
 func load(_ *cobra.Command, _ []string) error {
 	if len(loadOpts.Input) > 0 {
+		// The archive is completed in place, only do that to the copy
+		// of stdin.
+		if loadOpts.ReuseLayers {
+			return errors.New("--reuse-layers cannot be used with --input")
+		}
 		// Download the input file if needed.
 		if strings.HasPrefix(loadOpts.Input, "https://") || strings.HasPrefix(loadOpts.Input, "http://") {
 			tmpdir, err := registry.PodmanConfig().ContainersConfDefaultsRO.ImageCopyTmpDir()
@@ -0,0 +1,84 @@
+package pods
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/storage/pkg/archive"
+)
+
+var (
+	podCheckpointDescription = `Checkpoints all containers of a pod and exports them together with the configuration of the pod.
+
+  The pod can be restored from the archive with podman pod restore.`
+
+	podCheckpointCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "checkpoint [options] POD",
+		Short:             "Checkpoint a pod",
+		Long:              podCheckpointDescription,
+		RunE:              podCheckpoint,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompletePodsRunning,
+		Example: `podman pod checkpoint --export pod.tar.zst mypod
+podman pod checkpoint --leave-running -e pod.tar.zst mypod`,
+	}
+)
+
+var podCheckpointOptions entities.PodCheckpointOptions
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: podCheckpointCommand,
+		Parent:  podCmd,
+	})
+	flags := podCheckpointCommand.Flags()
+
+	exportFlagName := "export"
+	flags.StringVarP(&podCheckpointOptions.Export, exportFlagName, "e", "", "Export the checkpoint of the pod to a tar archive")
+	_ = podCheckpointCommand.RegisterFlagCompletionFunc(exportFlagName, completion.AutocompleteDefault)
+
+	flags.StringP("compress", "c", "zstd", "Select compression algorithm (gzip, none, zstd) for checkpoint archive.")
+	_ = podCheckpointCommand.RegisterFlagCompletionFunc("compress", common.AutocompleteCheckpointCompressType)
+
+	flags.BoolVarP(&podCheckpointOptions.Keep, "keep", "k", false, "Keep all temporary checkpoint files")
+	flags.BoolVarP(&podCheckpointOptions.LeaveRunning, "leave-running", "R", false, "Leave the pod running after writing checkpoint to disk")
+	flags.BoolVar(&podCheckpointOptions.TCPEstablished, "tcp-established", false, "Checkpoint containers with established TCP connections")
+	flags.BoolVar(&podCheckpointOptions.FileLocks, "file-locks", false, "Checkpoint containers with file locks")
+	flags.BoolVar(&podCheckpointOptions.IgnoreRootFS, "ignore-rootfs", false, "Do not include root file-system changes when exporting")
+	flags.BoolVar(&podCheckpointOptions.IgnoreVolumes, "ignore-volumes", false, "Do not export volumes associated with the containers")
+}
+
+func podCheckpoint(cmd *cobra.Command, args []string) error {
+	if rootless.IsRootless() {
+		return errors.New("checkpointing a pod requires root")
+	}
+	if podCheckpointOptions.Export == "" {
+		return errors.New("checkpointing a pod requires --export")
+	}
+	compress, _ := cmd.Flags().GetString("compress")
+	switch strings.ToLower(compress) {
+	case "none":
+		podCheckpointOptions.Compression = archive.Uncompressed
+	case "gzip":
+		podCheckpointOptions.Compression = archive.Gzip
+	case "zstd":
+		podCheckpointOptions.Compression = archive.Zstd
+	default:
+		return fmt.Errorf("selected compression algorithm (%q) not supported. Please select one from: gzip, none, zstd", compress)
+	}
+
+	report, err := registry.ContainerEngine().PodCheckpoint(registry.Context(), args[0], podCheckpointOptions)
+	if err != nil {
+		return err
+	}
+	fmt.Println(report.Id)
+	return nil
+}
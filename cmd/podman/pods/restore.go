@@ -0,0 +1,71 @@
+package pods
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+)
+
+var (
+	podRestoreDescription = `Restores a pod and all its containers from an archive created by podman pod checkpoint.
+
+  The containers are restored in the order they are started in.`
+
+	podRestoreCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "restore [options]",
+		Short:             "Restore a pod from a checkpoint",
+		Long:              podRestoreDescription,
+		RunE:              podRestore,
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: completion.AutocompleteNone,
+		Example:           `podman pod restore --import pod.tar.zst`,
+	}
+)
+
+var podRestoreOptions entities.PodRestoreOptions
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: podRestoreCommand,
+		Parent:  podCmd,
+	})
+	flags := podRestoreCommand.Flags()
+
+	importFlagName := "import"
+	flags.StringVarP(&podRestoreOptions.Import, importFlagName, "i", "", "Restore from exported pod checkpoint archive")
+	_ = podRestoreCommand.RegisterFlagCompletionFunc(importFlagName, completion.AutocompleteDefault)
+
+	flags.BoolVarP(&podRestoreOptions.Keep, "keep", "k", false, "Keep all temporary checkpoint files")
+	flags.BoolVar(&podRestoreOptions.TCPEstablished, "tcp-established", false, "Restore containers with established TCP connections")
+	flags.BoolVar(&podRestoreOptions.FileLocks, "file-locks", false, "Restore containers with file locks")
+	flags.BoolVar(&podRestoreOptions.IgnoreRootFS, "ignore-rootfs", false, "Do not apply root file-system changes when importing from exported checkpoint")
+	flags.BoolVar(&podRestoreOptions.IgnoreStaticIP, "ignore-static-ip", false, "Ignore IP address set via --static-ip")
+	flags.BoolVar(&podRestoreOptions.IgnoreStaticMAC, "ignore-static-mac", false, "Ignore MAC address set via --mac-address")
+	flags.BoolVar(&podRestoreOptions.IgnoreVolumes, "ignore-volumes", false, "Do not export volumes associated with the containers")
+
+	publishFlagName := "publish"
+	flags.StringSliceVarP(&podRestoreOptions.PublishPorts, publishFlagName, "p", []string{}, "Publish a port or a range of ports of the pod to the host (default [])")
+	_ = podRestoreCommand.RegisterFlagCompletionFunc(publishFlagName, completion.AutocompleteNone)
+}
+
+func podRestore(_ *cobra.Command, _ []string) error {
+	if rootless.IsRootless() {
+		return errors.New("restoring a pod requires root")
+	}
+	if podRestoreOptions.Import == "" {
+		return errors.New("restoring a pod requires --import")
+	}
+
+	report, err := registry.ContainerEngine().PodRestore(registry.Context(), podRestoreOptions)
+	if err != nil {
+		return err
+	}
+	fmt.Println(report.Id)
+	return nil
+}
@@ -0,0 +1,56 @@
+package quadlet
+
+import (
+	"os"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+)
+
+var (
+	quadletLogsDescription = `Display the journal entries of the systemd service generated from a Quadlet.`
+
+	quadletLogsCmd = &cobra.Command{
+		Use:               "logs [options] QUADLET",
+		Short:             "Display the logs of a Quadlet",
+		Long:              quadletLogsDescription,
+		RunE:              logs,
+		ValidArgsFunction: common.AutocompleteQuadlets,
+		Args:              cobra.ExactArgs(1),
+		Example: `podman quadlet logs myapp.container
+podman quadlet logs --follow --tail 10 mypod.pod
+podman quadlet logs --since "1 hour ago" myimage.build`,
+	}
+
+	logsOptions entities.QuadletLogsOptions
+)
+
+func logsFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+
+	flags.BoolVarP(&logsOptions.Follow, "follow", "f", false, "Follow log output")
+
+	sinceFlagName := "since"
+	flags.StringVar(&logsOptions.Since, sinceFlagName, "", "Show logs since the given time, in any format understood by journalctl")
+	_ = cmd.RegisterFlagCompletionFunc(sinceFlagName, completion.AutocompleteNone)
+
+	tailFlagName := "tail"
+	flags.IntVar(&logsOptions.Tail, tailFlagName, -1, "Output the specified number of LINES at the end of the logs.  Defaults to -1, which prints all lines")
+	_ = cmd.RegisterFlagCompletionFunc(tailFlagName, completion.AutocompleteNone)
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: quadletLogsCmd,
+		Parent:  quadletCmd,
+	})
+	logsFlags(quadletLogsCmd)
+}
+
+func logs(_ *cobra.Command, args []string) error {
+	logsOptions.Writer = os.Stdout
+	return registry.ContainerEngine().QuadletLogs(registry.Context(), args[0], logsOptions)
+}
@@ -0,0 +1,37 @@
+package quadlet
+
+import (
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/spf13/cobra"
+)
+
+var (
+	quadletRestartDescription = `Restart the systemd services generated from one or more Quadlets.`
+
+	quadletRestartCmd = &cobra.Command{
+		Use:               "restart QUADLET [QUADLET...]",
+		Short:             "Restart Quadlets",
+		Long:              quadletRestartDescription,
+		RunE:              restart,
+		ValidArgsFunction: common.AutocompleteQuadlets,
+		Args:              cobra.MinimumNArgs(1),
+		Example: `podman quadlet restart myapp.container
+podman quadlet restart mypod.pod`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: quadletRestartCmd,
+		Parent:  quadletCmd,
+	})
+}
+
+func restart(_ *cobra.Command, args []string) error {
+	reports, err := registry.ContainerEngine().QuadletRestart(registry.Context(), args)
+	if err != nil {
+		return err
+	}
+	return printUnitReports(reports)
+}
@@ -0,0 +1,57 @@
+package quadlet
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/cmd/podman/utils"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	quadletStartDescription = `Start the systemd services generated from one or more Quadlets.
+
+  The Quadlet must have been generated by systemd, run "systemctl daemon-reload" after installing or changing it.`
+
+	quadletStartCmd = &cobra.Command{
+		Use:               "start QUADLET [QUADLET...]",
+		Short:             "Start Quadlets",
+		Long:              quadletStartDescription,
+		RunE:              start,
+		ValidArgsFunction: common.AutocompleteQuadlets,
+		Args:              cobra.MinimumNArgs(1),
+		Example: `podman quadlet start myapp.container
+podman quadlet start mypod.pod myimage.build`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: quadletStartCmd,
+		Parent:  quadletCmd,
+	})
+}
+
+func start(_ *cobra.Command, args []string) error {
+	reports, err := registry.ContainerEngine().QuadletStart(registry.Context(), args)
+	if err != nil {
+		return err
+	}
+	return printUnitReports(reports)
+}
+
+// printUnitReports prints the name of every Quadlet whose systemd service was
+// acted on, and the errors of the others.
+func printUnitReports(reports []*entities.QuadletUnitReport) error {
+	var errs utils.OutputErrors
+	for _, r := range reports {
+		if r.Err != nil {
+			errs = append(errs, r.Err)
+			continue
+		}
+		fmt.Println(r.Name)
+	}
+	return errs.PrintErrors()
+}
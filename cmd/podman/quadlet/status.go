@@ -0,0 +1,147 @@
+package quadlet
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/cmd/podman/utils"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/report"
+)
+
+var (
+	quadletStatusDescription = `Display the state of the systemd services generated from one or more Quadlets.`
+
+	quadletStatusCmd = &cobra.Command{
+		Use:               "status [options] QUADLET [QUADLET...]",
+		Short:             "Display the status of Quadlets",
+		Long:              quadletStatusDescription,
+		RunE:              status,
+		ValidArgsFunction: common.AutocompleteQuadlets,
+		Args:              cobra.MinimumNArgs(1),
+		Example: `podman quadlet status myapp.container
+podman quadlet status --format json mypod.pod`,
+	}
+
+	statusFormat string
+)
+
+func statusFlags(cmd *cobra.Command) {
+	formatFlagName := "format"
+	flags := cmd.Flags()
+
+	flags.StringVar(&statusFormat, formatFlagName, "{{range .}}{{.Name}}\t{{.UnitName}}\t{{.State}}\t{{.PID}}\t{{.Changed}}\n{{end -}}", "Pretty-print output to JSON or using a Go template")
+	_ = cmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&quadletStatusReporter{}))
+
+	flags.BoolP("noheading", "n", false, "Do not print headers")
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: quadletStatusCmd,
+		Parent:  quadletCmd,
+	})
+	statusFlags(quadletStatusCmd)
+}
+
+// quadletStatusReporter adds human-readable columns to a QuadletStatusReport.
+type quadletStatusReporter struct {
+	*entities.QuadletStatusReport
+}
+
+// State returns the active state and sub state of the service, as printed by
+// systemctl status.
+func (q quadletStatusReporter) State() string {
+	if q.LoadState != "loaded" {
+		return q.LoadState
+	}
+	return fmt.Sprintf("%s (%s)", q.ActiveState, q.SubState)
+}
+
+// PID returns the main PID of the service, or "-" if it is not running.
+func (q quadletStatusReporter) PID() string {
+	if q.MainPID == 0 {
+		return "-"
+	}
+	return strconv.FormatUint(uint64(q.MainPID), 10)
+}
+
+// Changed returns how long ago the service entered its current state.
+func (q quadletStatusReporter) Changed() string {
+	if q.Since.IsZero() {
+		return "-"
+	}
+	return units.HumanDuration(time.Since(q.Since)) + " ago"
+}
+
+func status(cmd *cobra.Command, args []string) error {
+	allReports, err := registry.ContainerEngine().QuadletStatus(registry.Context(), args)
+	if err != nil {
+		return err
+	}
+	// The status of the other Quadlets is printed before the errors.
+	var errs utils.OutputErrors
+	reports := make([]*entities.QuadletStatusReport, 0, len(allReports))
+	for _, r := range allReports {
+		if r.Err != nil {
+			errs = append(errs, r.Err)
+			continue
+		}
+		reports = append(reports, r)
+	}
+	if err := printStatusReports(cmd, reports); err != nil {
+		return err
+	}
+	return errs.PrintErrors()
+}
+
+// printStatusReports prints the status of the systemd services of Quadlets in
+// the requested format.
+func printStatusReports(cmd *cobra.Command, reports []*entities.QuadletStatusReport) error {
+
+	if report.IsJSON(statusFormat) {
+		b, err := json.MarshalIndent(reports, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	origin := report.OriginPodman
+	if cmd.Flag("format").Changed {
+		origin = report.OriginUser
+	}
+	rpt, err := rpt.Parse(origin, statusFormat)
+	if err != nil {
+		return err
+	}
+
+	if noHeading, _ := cmd.Flags().GetBool("noheading"); !noHeading && rpt.RenderHeaders {
+		headers := report.Headers(entities.QuadletStatusReport{}, map[string]string{
+			"Name":     "NAME",
+			"UnitName": "UNIT NAME",
+			"State":    "STATE",
+			"PID":      "MAIN PID",
+			"Changed":  "SINCE",
+		})
+		if err := rpt.Execute(headers); err != nil {
+			return fmt.Errorf("writing column headers: %w", err)
+		}
+	}
+
+	statuses := make([]quadletStatusReporter, 0, len(reports))
+	for _, r := range reports {
+		statuses = append(statuses, quadletStatusReporter{r})
+	}
+	return rpt.Execute(statuses)
+}
@@ -0,0 +1,37 @@
+package quadlet
+
+import (
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/spf13/cobra"
+)
+
+var (
+	quadletStopDescription = `Stop the systemd services generated from one or more Quadlets.`
+
+	quadletStopCmd = &cobra.Command{
+		Use:               "stop QUADLET [QUADLET...]",
+		Short:             "Stop Quadlets",
+		Long:              quadletStopDescription,
+		RunE:              stop,
+		ValidArgsFunction: common.AutocompleteQuadlets,
+		Args:              cobra.MinimumNArgs(1),
+		Example: `podman quadlet stop myapp.container
+podman quadlet stop mypod.pod`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: quadletStopCmd,
+		Parent:  quadletCmd,
+	})
+}
+
+func stop(_ *cobra.Command, args []string) error {
+	reports, err := registry.ContainerEngine().QuadletStop(registry.Context(), args)
+	if err != nil {
+		return err
+	}
+	return printUnitReports(reports)
+}
@@ -0,0 +1,100 @@
+package quadlet
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+	"go.podman.io/common/pkg/report"
+)
+
+var (
+	quadletValidateDescription = `Validate Quadlet files without installing them.
+
+  The Quadlets are converted the same way the systemd generator does, along with the installed Quadlets they may refer to. All errors and warnings found are reported, and the differences between the currently generated units and the new ones are shown.`
+
+	quadletValidateCmd = &cobra.Command{
+		Use:               "validate [options] PATH [PATH...]",
+		Short:             "Validate Quadlets",
+		Long:              quadletValidateDescription,
+		RunE:              validateQuadlets,
+		ValidArgsFunction: completion.AutocompleteDefault,
+		Args:              cobra.MinimumNArgs(1),
+		Example: `podman quadlet validate myapp.container
+podman quadlet validate --diff=false ./quadlets/
+podman quadlet validate --format json ./quadlets/`,
+	}
+
+	validateFormat string
+	validateDiff   bool
+)
+
+func validateFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+
+	formatFlagName := "format"
+	flags.StringVar(&validateFormat, formatFlagName, "", "Print the results in JSON format")
+	_ = cmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&entities.QuadletValidateReport{}))
+
+	flags.BoolVar(&validateDiff, "diff", true, "Show the differences to the currently generated units")
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: quadletValidateCmd,
+		Parent:  quadletCmd,
+	})
+	validateFlags(quadletValidateCmd)
+}
+
+func validateQuadlets(_ *cobra.Command, args []string) error {
+	if validateFormat != "" && !report.IsJSON(validateFormat) {
+		return fmt.Errorf("unsupported format %q, only json is supported", validateFormat)
+	}
+
+	validateReport, err := registry.ContainerEngine().QuadletValidate(registry.Context(), args)
+	if err != nil {
+		return err
+	}
+
+	errs := 0
+	for _, problem := range validateReport.Problems {
+		if problem.Severity == "error" {
+			errs++
+		}
+	}
+
+	if report.IsJSON(validateFormat) {
+		if !validateDiff {
+			for i := range validateReport.Units {
+				validateReport.Units[i].Diff = ""
+			}
+		}
+		b, err := json.MarshalIndent(validateReport, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+	} else {
+		for _, problem := range validateReport.Problems {
+			location := problem.File
+			if problem.Line > 0 {
+				location = fmt.Sprintf("%s:%d", location, problem.Line)
+			}
+			fmt.Printf("%s: %s: %s\n", location, problem.Severity, problem.Message)
+		}
+		if validateDiff {
+			for _, unit := range validateReport.Units {
+				fmt.Print(unit.Diff)
+			}
+		}
+	}
+
+	if errs > 0 {
+		return fmt.Errorf("found %d errors validating Quadlets", errs)
+	}
+	return nil
+}
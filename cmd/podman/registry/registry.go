@@ -85,6 +85,12 @@ func Context() context.Context {
 	return cliCtx
 }
 
+// SetContext replaces the context returned by Context, e.g. to carry the
+// trace span of the executed command.
+func SetContext(ctx context.Context) {
+	cliCtx = ctx
+}
+
 func DefaultAPIAddress() string {
 	if rootless.IsRootless() {
 		xdg, err := util.GetRootlessRuntimeDir()
@@ -19,11 +19,12 @@ import (
 var createCmd = &cobra.Command{
 	Use:   "create [options] NAME FILE|-",
 	Short: "Create a new secret",
-	Long:  "Create a secret. Input can be a path to a file or \"-\" (read from stdin). Secret drivers \"file\" (default), \"pass\", and \"shell\" are available.",
+	Long:  "Create a secret. Input can be a path to a file or \"-\" (read from stdin). Secret drivers \"file\" (default), \"pass\", \"shell\", and \"vault\" are available.",
 	RunE:  create,
-	Args:  cobra.ExactArgs(2),
+	Args:  cobra.RangeArgs(1, 2),
 	Example: `podman secret create mysecret /path/to/secret
-printf "secretdata" | podman secret create mysecret -`,
+printf "secretdata" | podman secret create mysecret -
+podman secret create --driver vault --driver-opts path=myapp/db,field=password dbpass`,
 	ValidArgsFunction: common.AutocompleteSecretCreate,
 }
 
@@ -44,7 +45,7 @@ func init() {
 
 	driverFlagName := "driver"
 	flags.StringVarP(&createOpts.Driver, driverFlagName, "d", cfg.ContainersConfDefaultsRO.Secrets.Driver, "Specify secret driver")
-	_ = createCmd.RegisterFlagCompletionFunc(driverFlagName, completion.AutocompleteNone)
+	_ = createCmd.RegisterFlagCompletionFunc(driverFlagName, cobra.FixedCompletions([]string{"file", "pass", "shell", "vault"}, cobra.ShellCompDirectiveNoFileComp))
 
 	optsFlagName := "driver-opts"
 	flags.StringToStringVar(&createOpts.DriverOpts, optsFlagName, cfg.ContainersConfDefaultsRO.Secrets.Opts, "Specify driver specific options")
@@ -71,20 +72,26 @@ func create(_ *cobra.Command, args []string) error {
 	}
 
 	var err error
-	path := args[1]
-
 	var reader io.Reader
 	switch {
+	case len(args) == 1:
+		// Secrets referenced by their vault path have no local data,
+		// the driver reads them from the server.
+		vaultPath := createOpts.DriverOpts["path"]
+		if createOpts.Driver != "vault" || vaultPath == "" {
+			return errors.New("secret data must be given as FILE or \"-\", unless the vault driver references a secret with the path driver option")
+		}
+		reader = strings.NewReader(vaultPath)
 	case env:
-		envValue := os.Getenv(path)
+		envValue := os.Getenv(args[1])
 		if envValue == "" {
-			return fmt.Errorf("cannot create store secret data: environment variable %s is not set", path)
+			return fmt.Errorf("cannot create store secret data: environment variable %s is not set", args[1])
 		}
 		reader = strings.NewReader(envValue)
-	case path == "-" || path == "/dev/stdin":
+	case args[1] == "-" || args[1] == "/dev/stdin":
 		reader = os.Stdin
 	default:
-		file, err := os.Open(path)
+		file, err := os.Open(args[1])
 		if err != nil {
 			return err
 		}
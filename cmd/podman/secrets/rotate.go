@@ -0,0 +1,99 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+)
+
+var rotateCmd = &cobra.Command{
+	Use:   "rotate [options] NAME [FILE|-]",
+	Short: "Rotate a secret",
+	Long: `Replace the data of a secret and update the containers mounting it as a file.
+
+Running containers see the new data right away. Optionally, a signal is sent to them or a command is run in them so they reload the secret.
+FILE may only be omitted for secrets referenced by their vault path, whose new data is read from the server.`,
+	RunE:              rotate,
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: common.AutocompleteSecretRotate,
+	Example: `podman secret rotate mysecret /path/to/secret
+printf "newdata" | podman secret rotate --signal SIGHUP mysecret -
+podman secret rotate --exec-hook "nginx -s reload" mysecret /path/to/secret`,
+}
+
+var (
+	rotateOpts = entities.SecretRotateOptions{}
+	rotateEnv  = false
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: rotateCmd,
+		Parent:  secretCmd,
+	})
+
+	flags := rotateCmd.Flags()
+
+	flags.BoolVar(&rotateEnv, "env", false, "Read secret data from environment variable")
+
+	signalFlagName := "signal"
+	flags.StringVar(&rotateOpts.Signal, signalFlagName, "", "Signal sent to the running containers using the secret")
+	_ = rotateCmd.RegisterFlagCompletionFunc(signalFlagName, common.AutocompleteStopSignal)
+
+	execHookFlagName := "exec-hook"
+	flags.StringVar(&rotateOpts.ExecHook, execHookFlagName, "", "Command run in the running containers using the secret")
+	_ = rotateCmd.RegisterFlagCompletionFunc(execHookFlagName, completion.AutocompleteNone)
+}
+
+func rotate(_ *cobra.Command, args []string) error {
+	var reader io.Reader
+	switch {
+	case len(args) == 1:
+		if rotateEnv {
+			return errors.New("--env requires the name of an environment variable")
+		}
+		reader = strings.NewReader("")
+	case rotateEnv:
+		envValue := os.Getenv(args[1])
+		if envValue == "" {
+			return fmt.Errorf("cannot rotate secret data: environment variable %s is not set", args[1])
+		}
+		reader = strings.NewReader(envValue)
+	case args[1] == "-" || args[1] == "/dev/stdin":
+		reader = os.Stdin
+	default:
+		file, err := os.Open(args[1])
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	report, err := registry.ContainerEngine().SecretRotate(context.Background(), args[0], reader, rotateOpts)
+	if err != nil {
+		return err
+	}
+	fmt.Println(report.ID)
+
+	failed := 0
+	for _, ctr := range report.Containers {
+		if ctr.Error != "" {
+			failed++
+			fmt.Fprintf(os.Stderr, "Error: updating container %s: %s\n", ctr.Name, ctr.Error)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("secret %s rotated, but %d of %d containers using it could not be updated", args[0], failed, len(report.Containers))
+	}
+	return nil
+}
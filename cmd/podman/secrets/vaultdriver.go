@@ -0,0 +1,80 @@
+package secrets
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/secrets/vaultdriver"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
+)
+
+// vaultDriverCmd runs the vault secret driver for the shell driver of the
+// secrets manager, see vaultdriver.ShellOptions.  The ID of the secret is
+// given in the SECRET_ID environment variable.
+var vaultDriverCmd = &cobra.Command{
+	Annotations: map[string]string{
+		registry.EngineMode:       registry.ABIMode,
+		registry.ParentNSRequired: "",
+	},
+	Use:               "vault-driver --options OPTIONS delete|list|lookup|store",
+	Short:             "Run the vault secret driver",
+	Args:              cobra.ExactArgs(1),
+	Hidden:            true,
+	RunE:              vaultDriver,
+	ValidArgsFunction: completion.AutocompleteNone,
+}
+
+var vaultDriverOptions string
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: vaultDriverCmd,
+		Parent:  secretCmd,
+	})
+	flags := vaultDriverCmd.Flags()
+	flags.StringVar(&vaultDriverOptions, "options", "", "Encoded options of the vault driver")
+}
+
+func vaultDriver(_ *cobra.Command, args []string) error {
+	opts, err := vaultdriver.DecodeOptions(vaultDriverOptions)
+	if err != nil {
+		return err
+	}
+	driver, err := vaultdriver.NewDriver(opts)
+	if err != nil {
+		return err
+	}
+
+	id := os.Getenv("SECRET_ID")
+	switch args[0] {
+	case vaultdriver.ActionDelete:
+		return driver.Delete(id)
+	case vaultdriver.ActionList:
+		ids, err := driver.List()
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			fmt.Println(id)
+		}
+		return nil
+	case vaultdriver.ActionLookup:
+		data, err := driver.Lookup(id)
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(data)
+		return err
+	case vaultdriver.ActionStore:
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		return driver.Store(id, data)
+	default:
+		return fmt.Errorf("unknown vault driver action %q", args[0])
+	}
+}
@@ -224,7 +224,7 @@ func printVerbose(cmd *cobra.Command, reports *entities.SystemDfReport) error {
 	hdrs = report.Headers(entities.SystemDfVolumeReport{}, map[string]string{
 		"VolumeName": "VOLUME NAME",
 	})
-	volumeRow := "{{range .}}{{.VolumeName}}\t{{.Links}}\t{{.Size}}\n{{end -}}"
+	volumeRow := "{{range .}}{{.VolumeName}}\t{{.Links}}\t{{.Size}}\t{{.Limit}}\n{{end -}}"
 	rpt, err = rpt.Parse(report.OriginPodman, volumeRow)
 	if err != nil {
 		return err
@@ -300,6 +300,13 @@ func (d *dfVolume) Size() string {
 	return units.HumanSize(float64(d.SystemDfVolumeReport.Size))
 }
 
+func (d *dfVolume) Limit() string {
+	if d.SystemDfVolumeReport.Limit == 0 {
+		return "-"
+	}
+	return units.HumanSize(float64(d.SystemDfVolumeReport.Limit))
+}
+
 type dfSummary struct {
 	Type           string
 	Total          int
@@ -45,6 +45,7 @@ podman system service --time=0 --tls-cert=tls.crt --tls-key=tls.key --tls-client
 	srvArgs = struct {
 		CorsHeaders     string
 		PProfAddr       string
+		MetricsAddr     string
 		Timeout         uint
 		TLSCertFile     string
 		TLSKeyFile      string
@@ -74,6 +75,11 @@ func init() {
 		"Binding network address for pprof profile endpoints, default: do not expose endpoints")
 	_ = flags.MarkHidden("pprof-address")
 
+	metricsAddrFlagName := "metrics-address"
+	flags.StringVarP(&srvArgs.MetricsAddr, metricsAddrFlagName, "", "",
+		"Binding network address for the Prometheus metrics endpoint, default: do not expose metrics")
+	_ = srvCmd.RegisterFlagCompletionFunc(metricsAddrFlagName, completion.AutocompleteNone)
+
 	flags.StringVarP(&srvArgs.TLSCertFile, "tls-cert", "", "",
 		"PEM file containing TLS serving certificate.")
 	_ = srvCmd.RegisterFlagCompletionFunc("tls-cert", completion.AutocompleteDefault)
@@ -125,6 +131,7 @@ func service(cmd *cobra.Command, args []string) error {
 	return restService(cmd.Flags(), registry.PodmanConfig(), entities.ServiceOptions{
 		CorsHeaders:     srvArgs.CorsHeaders,
 		PProfAddr:       srvArgs.PProfAddr,
+		MetricsAddr:     srvArgs.MetricsAddr,
 		Timeout:         time.Duration(srvArgs.Timeout) * time.Second,
 		URI:             apiURI,
 		TLSCertFile:     srvArgs.TLSCertFile,
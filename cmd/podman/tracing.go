@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/tracing"
+	"github.com/containers/podman/v6/version"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingShutdownTimeout bounds the time spent exporting the remaining spans
+// when podman exits.
+const tracingShutdownTimeout = 5 * time.Second
+
+var (
+	tracerProvider *sdktrace.TracerProvider
+	commandSpan    trace.Span
+)
+
+// tracingEnabled returns whether traces should be exported. Tracing is
+// enabled by the hidden --trace flag or by setting one of the standard
+// OpenTelemetry OTLP endpoint environment variables.
+func tracingEnabled() bool {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+	return registry.PodmanConfig().Trace ||
+		os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" ||
+		os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// startTracing installs a tracer provider exporting spans via OTLP/HTTP and
+// starts the span of the executed command. The exporter is configured by the
+// standard OTEL_EXPORTER_OTLP_* environment variables. A trace context set in
+// the TRACEPARENT environment variable is used as parent of the command span
+// so podman invocations can be correlated with the caller, e.g. a CI job.
+func startTracing(cmd *cobra.Command) error {
+	if tracerProvider != nil || !tracingEnabled() {
+		return nil
+	}
+	ctx := registry.Context()
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return err
+	}
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceName("podman"),
+			semconv.ServiceVersion(version.Version.String()),
+		),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithProcessPID(),
+	)
+	if err != nil && !errors.Is(err, resource.ErrPartialResource) {
+		return err
+	}
+	tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		logrus.Debugf("OpenTelemetry: %v", err)
+	}))
+
+	// Remote clients propagate the trace context of each API call, there is
+	// no command span as the connection is set up independently of it.
+	if registry.IsRemote() {
+		return nil
+	}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier{
+		"traceparent": os.Getenv("TRACEPARENT"),
+		"tracestate":  os.Getenv("TRACESTATE"),
+	})
+	ctx, commandSpan = tracing.Start(ctx, cmd.CommandPath())
+	registry.SetContext(ctx)
+	return nil
+}
+
+// stopTracing ends the command span and flushes all pending spans.
+func stopTracing(err error) {
+	if tracerProvider == nil {
+		return
+	}
+	if commandSpan != nil {
+		tracing.End(commandSpan, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+	if err := tracerProvider.Shutdown(ctx); err != nil {
+		logrus.Debugf("Failed to export traces: %v", err)
+	}
+}
@@ -5,12 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/containers/buildah/pkg/cli"
 	"github.com/containers/podman/v6/cmd/podman/common"
 	"github.com/containers/podman/v6/cmd/podman/registry"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/spf13/cobra"
 	"go.podman.io/common/pkg/completion"
+	"go.podman.io/storage/pkg/archive"
 	"golang.org/x/term"
 )
 
@@ -18,7 +21,8 @@ var (
 	volumeExportDescription = `
 podman volume export
 
-Allow content of volume to be exported into external tar.`
+Allow content of volume to be exported into external tar.  The tar can be
+compressed, encrypted and stored as an artifact in the local artifact store.`
 	exportCommand = &cobra.Command{
 		Use:               "export [options] VOLUME",
 		Short:             "Export volumes",
@@ -26,10 +30,17 @@ Allow content of volume to be exported into external tar.`
 		Long:              volumeExportDescription,
 		RunE:              export,
 		ValidArgsFunction: common.AutocompleteVolumes,
+		Example: `podman volume export myvol --output myvol.tar
+podman volume export --compress zstd --encryption-key jwe:/path/to/key.pem -o myvol.tar.zst myvol
+podman volume export --artifact quay.io/myuser/backups:myvol myvol`,
 	}
 )
 
-var targetPath string
+var (
+	targetPath     string
+	exportOpts     entities.VolumeExportOptions
+	encryptionKeys []string
+)
 
 func init() {
 	registry.Commands = append(registry.Commands, registry.CliCommand{
@@ -41,21 +52,56 @@ func init() {
 	outputFlagName := "output"
 	flags.StringVarP(&targetPath, outputFlagName, "o", "", "Write to a specified file (default: stdout, which must be redirected)")
 	_ = exportCommand.RegisterFlagCompletionFunc(outputFlagName, completion.AutocompleteDefault)
+
+	artifactFlagName := "artifact"
+	flags.StringVar(&exportOpts.Artifact, artifactFlagName, "", "Export the volume into an artifact in the local artifact store")
+	_ = exportCommand.RegisterFlagCompletionFunc(artifactFlagName, completion.AutocompleteNone)
+
+	compressFlagName := "compress"
+	flags.StringP(compressFlagName, "c", "none", "Select compression algorithm (gzip, none, zstd) for the tar")
+	_ = exportCommand.RegisterFlagCompletionFunc(compressFlagName, common.AutocompleteCheckpointCompressType)
+
+	encryptionKeysFlagName := "encryption-key"
+	flags.StringArrayVar(&encryptionKeys, encryptionKeysFlagName, nil, "Key with the encryption protocol to use to encrypt the tar (e.g. jwe:/path/to/key.pem)")
+	_ = exportCommand.RegisterFlagCompletionFunc(encryptionKeysFlagName, completion.AutocompleteDefault)
 }
 
 func export(cmd *cobra.Command, args []string) error {
 	containerEngine := registry.ContainerEngine()
 	ctx := context.Background()
-	exportOpts := entities.VolumeExportOptions{}
 
-	if targetPath != "" {
+	compress, _ := cmd.Flags().GetString("compress")
+	switch strings.ToLower(compress) {
+	case "none":
+		exportOpts.Compression = archive.Uncompressed
+	case "gzip":
+		exportOpts.Compression = archive.Gzip
+	case "zstd":
+		exportOpts.Compression = archive.Zstd
+	default:
+		return fmt.Errorf("selected compression algorithm (%q) not supported. Please select one from: gzip, none, zstd", compress)
+	}
+	if len(encryptionKeys) > 0 {
+		encConfig, _, err := cli.EncryptConfig(encryptionKeys, nil)
+		if err != nil {
+			return fmt.Errorf("unable to obtain encryption config: %w", err)
+		}
+		exportOpts.OciEncryptConfig = encConfig
+	}
+
+	switch {
+	case exportOpts.Artifact != "":
+		if cmd.Flag("output").Changed {
+			return errors.New("--artifact and --output cannot be used together")
+		}
+	case targetPath != "":
 		targetFile, err := os.Create(targetPath)
 		if err != nil {
 			return fmt.Errorf("unable to create target file path %q: %w", targetPath, err)
 		}
 		defer targetFile.Close()
 		exportOpts.Output = targetFile
-	} else {
+	default:
 		if cmd.Flag("output").Changed {
 			return errors.New("must provide valid path for file to write to")
 		}
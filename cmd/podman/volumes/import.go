@@ -2,44 +2,78 @@ package volumes
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 
+	"github.com/containers/buildah/pkg/cli"
 	"github.com/containers/podman/v6/cmd/podman/common"
 	"github.com/containers/podman/v6/cmd/podman/parse"
 	"github.com/containers/podman/v6/cmd/podman/registry"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/completion"
 )
 
 var (
-	importDescription = `Imports contents into a podman volume from specified tarball (.tar, .tar.gz, .tgz, .bzip, .tar.xz, .txz, .tar.zst).`
-	importCommand     = &cobra.Command{
+	importDescription = `Imports contents into a podman volume from specified tarball (.tar, .tar.gz, .tgz, .bzip, .tar.xz, .txz, .tar.zst).
+
+  Encrypted tarballs and artifacts created by podman volume export can be imported as well.`
+	importCommand = &cobra.Command{
 		Use:               "import VOLUME [SOURCE]",
 		Short:             "Import a tarball contents into a podman volume",
 		Long:              importDescription,
 		RunE:              importVol,
-		Args:              cobra.ExactArgs(2),
+		Args:              cobra.RangeArgs(1, 2),
 		ValidArgsFunction: common.AutocompleteVolumes,
 		Example: `podman volume import my_vol /home/user/import.tar
-cat ctr.tar | podman volume import my_vol -`,
+cat ctr.tar | podman volume import my_vol -
+podman volume import --decryption-key /path/to/key.pem my_vol /home/user/import.tar.zst
+podman volume import --artifact quay.io/myuser/backups:myvol my_vol`,
 	}
 )
 
+var (
+	importOpts     entities.VolumeImportOptions
+	decryptionKeys []string
+)
+
 func init() {
 	registry.Commands = append(registry.Commands, registry.CliCommand{
 		Command: importCommand,
 		Parent:  volumeCmd,
 	})
+	flags := importCommand.Flags()
+
+	artifactFlagName := "artifact"
+	flags.StringVar(&importOpts.Artifact, artifactFlagName, "", "Import the volume from an artifact in the local artifact store")
+	_ = importCommand.RegisterFlagCompletionFunc(artifactFlagName, common.AutocompleteArtifacts)
+
+	decryptionKeysFlagName := "decryption-key"
+	flags.StringArrayVar(&decryptionKeys, decryptionKeysFlagName, nil, "Key needed to decrypt the tar (e.g. /path/to/key.pem)")
+	_ = importCommand.RegisterFlagCompletionFunc(decryptionKeysFlagName, completion.AutocompleteDefault)
 }
 
 func importVol(_ *cobra.Command, args []string) error {
-	opts := entities.VolumeImportOptions{}
+	if len(decryptionKeys) > 0 {
+		decConfig, err := cli.DecryptConfig(decryptionKeys)
+		if err != nil {
+			return fmt.Errorf("unable to obtain decryption config: %w", err)
+		}
+		importOpts.OciDecryptConfig = decConfig
+	}
 
-	filepath := args[1]
-	if filepath == "-" {
-		opts.Input = os.Stdin
-	} else {
+	switch {
+	case importOpts.Artifact != "":
+		if len(args) > 1 {
+			return errors.New("--artifact and SOURCE cannot be used together")
+		}
+	case len(args) < 2:
+		return errors.New("must provide SOURCE or --artifact")
+	case args[1] == "-":
+		importOpts.Input = os.Stdin
+	default:
+		filepath := args[1]
 		if err := parse.ValidateFileName(filepath); err != nil {
 			return err
 		}
@@ -49,11 +83,11 @@ func importVol(_ *cobra.Command, args []string) error {
 			return fmt.Errorf("unable open input file: %w", err)
 		}
 		defer targetFile.Close()
-		opts.Input = targetFile
+		importOpts.Input = targetFile
 	}
 
 	containerEngine := registry.ContainerEngine()
 	ctx := context.Background()
 
-	return containerEngine.VolumeImport(ctx, args[0], opts)
+	return containerEngine.VolumeImport(ctx, args[0], importOpts)
 }
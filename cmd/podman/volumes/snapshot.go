@@ -0,0 +1,107 @@
+package volumes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/cmd/podman/utils"
+	"github.com/containers/podman/v6/cmd/podman/validate"
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Command: podman volume _snapshot_
+	snapshotCmd = &cobra.Command{
+		Annotations: map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:         "snapshot",
+		Short:       "Manage volume snapshots",
+		Long:        "Snapshots save the contents of a local volume and can be restored into the volume later",
+		RunE:        validate.SubCommandExists,
+	}
+
+	snapshotCreateCommand = &cobra.Command{
+		Annotations: map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:         "create VOLUME SNAPSHOT",
+		Short:       "Create a snapshot of a volume",
+		Long: `Copies the current contents of a local volume into a new snapshot.
+
+  Files are reflinked where the filesystem supports it and copied otherwise.`,
+		RunE: snapshotCreate,
+		Args: cobra.ExactArgs(2),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) == 0 {
+				return common.AutocompleteVolumes(cmd, args, toComplete)
+			}
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+		Example: `podman volume snapshot create mydb clean`,
+	}
+
+	snapshotRestoreCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "restore VOLUME SNAPSHOT",
+		Short:             "Restore a snapshot into a volume",
+		Long:              `Replaces the contents of a volume with the contents of one of its snapshots. The volume must not be used by running containers.`,
+		RunE:              snapshotRestore,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: common.AutocompleteVolumeSnapshots,
+		Example:           `podman volume snapshot restore mydb clean`,
+	}
+
+	snapshotRmCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "rm VOLUME SNAPSHOT [SNAPSHOT...]",
+		Aliases:           []string{"remove"},
+		Short:             "Remove snapshots of a volume",
+		Long:              `Removes one or more snapshots of a volume.`,
+		RunE:              snapshotRm,
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: common.AutocompleteVolumeSnapshots,
+		Example:           `podman volume snapshot rm mydb clean`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: snapshotCmd,
+		Parent:  volumeCmd,
+	})
+	for _, cmd := range []*cobra.Command{snapshotCreateCommand, snapshotRestoreCommand, snapshotRmCommand} {
+		registry.Commands = append(registry.Commands, registry.CliCommand{
+			Command: cmd,
+			Parent:  snapshotCmd,
+		})
+	}
+}
+
+func snapshotCreate(_ *cobra.Command, args []string) error {
+	report, err := registry.ContainerEngine().VolumeSnapshotCreate(context.Background(), args[0], args[1])
+	if err != nil {
+		return err
+	}
+	fmt.Println(report.Name)
+	return nil
+}
+
+func snapshotRestore(_ *cobra.Command, args []string) error {
+	return registry.ContainerEngine().VolumeSnapshotRestore(context.Background(), args[0], args[1])
+}
+
+func snapshotRm(_ *cobra.Command, args []string) error {
+	var errs utils.OutputErrors
+	for _, name := range args[1:] {
+		if err := registry.ContainerEngine().VolumeSnapshotRm(context.Background(), args[0], name); err != nil {
+			if errors.Is(err, define.ErrNoSuchVolume) {
+				return err
+			}
+			errs = append(errs, err)
+			continue
+		}
+		fmt.Println(name)
+	}
+	return errs.PrintErrors()
+}
@@ -0,0 +1,111 @@
+package volumes
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/containers/podman/v6/cmd/podman/common"
+	"github.com/containers/podman/v6/cmd/podman/registry"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+	"go.podman.io/common/pkg/report"
+)
+
+var (
+	snapshotLsCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "ls [options] [VOLUME...]",
+		Aliases:           []string{"list"},
+		Short:             "List volume snapshots",
+		Long:              `Lists the snapshots of the given volumes, or of all local volumes.`,
+		RunE:              snapshotLs,
+		ValidArgsFunction: common.AutocompleteVolumes,
+		Example: `podman volume snapshot ls
+podman volume snapshot ls --format "{{.Name}}" mydb`,
+	}
+
+	snapshotLsFormat string
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: snapshotLsCommand,
+		Parent:  snapshotCmd,
+	})
+	flags := snapshotLsCommand.Flags()
+
+	formatFlagName := "format"
+	flags.StringVar(&snapshotLsFormat, formatFlagName, "{{range .}}{{.Volume}}\t{{.Name}}\t{{.Created}}\t{{.Size}}\n{{end -}}", "Format snapshot output using JSON or a Go template")
+	_ = snapshotLsCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&volumeSnapshotReporter{}))
+
+	flags.BoolP("noheading", "n", false, "Do not print headers")
+}
+
+// volumeSnapshotReporter is a struct for volume snapshot ls output
+type volumeSnapshotReporter struct {
+	*entities.VolumeSnapshotReport
+}
+
+// Created returns a human readable created time
+func (s volumeSnapshotReporter) Created() string {
+	return units.HumanDuration(time.Since(s.VolumeSnapshotReport.Created)) + " ago"
+}
+
+// CreatedAt returns the created time
+func (s volumeSnapshotReporter) CreatedAt() string {
+	return s.VolumeSnapshotReport.Created.String()
+}
+
+// Size returns a human readable size
+func (s volumeSnapshotReporter) Size() string {
+	return units.HumanSizeWithPrecision(float64(s.VolumeSnapshotReport.Size), 3)
+}
+
+func snapshotLs(cmd *cobra.Command, args []string) error {
+	responses, err := registry.ContainerEngine().VolumeSnapshotList(context.Background(), args)
+	if err != nil {
+		return err
+	}
+
+	if report.IsJSON(snapshotLsFormat) {
+		b, err := json.MarshalIndent(responses, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+
+	snapshots := make([]volumeSnapshotReporter, 0, len(responses))
+	for _, r := range responses {
+		snapshots = append(snapshots, volumeSnapshotReporter{r})
+	}
+
+	headers := report.Headers(volumeSnapshotReporter{}, map[string]string{
+		"Name":   "SNAPSHOT",
+		"Volume": "VOLUME NAME",
+	})
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	origin := report.OriginPodman
+	if cmd.Flag("format").Changed {
+		origin = report.OriginUser
+	}
+	rpt, err = rpt.Parse(origin, snapshotLsFormat)
+	if err != nil {
+		return err
+	}
+
+	noHeading, _ := cmd.Flags().GetBool("noheading")
+	if rpt.RenderHeaders && !noHeading {
+		if err := rpt.Execute(headers); err != nil {
+			return fmt.Errorf("failed to write report column headers: %w", err)
+		}
+	}
+	return rpt.Execute(snapshots)
+}
@@ -7,9 +7,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
-	"sort"
 	"strings"
-	"unicode"
 
 	"github.com/containers/podman/v6/pkg/logiface"
 	"github.com/containers/podman/v6/pkg/systemd/parser"
@@ -126,73 +124,6 @@ func loadUnitsFromDir(sourcePath string) ([]*parser.UnitFile, error) {
 	return units, prevError
 }
 
-func loadUnitDropins(unit *parser.UnitFile, sourcePaths []string) error {
-	var prevError error
-	reportError := func(err error) {
-		if prevError != nil {
-			err = fmt.Errorf("%s\n%s", prevError, err)
-		}
-		prevError = err
-	}
-
-	unitDropinPaths := unit.GetUnitDropinPaths()
-	dropinDirs := make([]string, 0, len(unitDropinPaths))
-	for _, dropinPath := range unitDropinPaths {
-		for _, sourcePath := range sourcePaths {
-			dropinDirs = append(dropinDirs, path.Join(sourcePath, dropinPath))
-		}
-	}
-
-	dropinPaths := make(map[string]string)
-	for _, dropinDir := range dropinDirs {
-		dropinFiles, err := os.ReadDir(dropinDir)
-		if err != nil {
-			if !errors.Is(err, os.ErrNotExist) {
-				reportError(fmt.Errorf("error reading directory %q, %w", dropinDir, err))
-			}
-
-			continue
-		}
-
-		for _, dropinFile := range dropinFiles {
-			dropinName := dropinFile.Name()
-			if filepath.Ext(dropinName) != ".conf" {
-				continue // Only *.conf supported
-			}
-
-			if _, ok := dropinPaths[dropinName]; ok {
-				continue // We already saw this name
-			}
-
-			dropinPaths[dropinName] = path.Join(dropinDir, dropinName)
-		}
-	}
-
-	dropinFiles := make([]string, len(dropinPaths))
-	i := 0
-	for k := range dropinPaths {
-		dropinFiles[i] = k
-		i++
-	}
-
-	// Merge in alpha-numerical order
-	sort.Strings(dropinFiles)
-
-	for _, dropinFile := range dropinFiles {
-		dropinPath := dropinPaths[dropinFile]
-
-		Debugf("Loading source drop-in file %s", dropinPath)
-
-		if f, err := parser.ParseUnitFile(dropinPath); err != nil {
-			reportError(fmt.Errorf("error loading %q, %w", dropinPath, err))
-		} else {
-			unit.Merge(f)
-		}
-	}
-
-	return prevError
-}
-
 func generateServiceFile(service *parser.UnitFile) error {
 	Debugf("writing %q", service.Path)
 
@@ -288,135 +219,6 @@ func enableServiceFile(outputPath string, service *parser.UnitFile) {
 	}
 }
 
-func isImageID(imageName string) bool {
-	// All sha25:... names are assumed by podman to be fully specified
-	if strings.HasPrefix(imageName, "sha256:") {
-		return true
-	}
-
-	// However, podman also accepts image ids as pure hex strings,
-	// but only those of length 64 are unambiguous image ids
-	if len(imageName) != 64 {
-		return false
-	}
-
-	for _, c := range imageName {
-		if !unicode.Is(unicode.Hex_Digit, c) {
-			return false
-		}
-	}
-
-	return true
-}
-
-func isUnambiguousName(imageName string) bool {
-	// Fully specified image ids are unambiguous
-	if isImageID(imageName) {
-		return true
-	}
-
-	// Otherwise we require a fully qualified name
-	before, _, ok := strings.Cut(imageName, "/")
-	if !ok {
-		// No domain or path, not fully qualified
-		return false
-	}
-
-	// What is before the first slash can be a domain or a path
-	domain := before
-
-	// If its a domain (has dot or port or is "localhost") it is considered fq
-	if strings.ContainsAny(domain, ".:") || domain == "localhost" {
-		return true
-	}
-
-	return false
-}
-
-// warns if input is an ambiguous name, i.e. a partial image id or a short
-// name (i.e. is missing a registry)
-//
-// Examples:
-//   - short names: "image:tag", "library/fedora"
-//   - fully qualified names: "quay.io/image", "localhost/image:tag",
-//     "server.org:5000/lib/image", "sha256:..."
-//
-// We implement a simple version of this from scratch here to avoid
-// a huge dependency in the generator just for a warning.
-func warnIfAmbiguousName(unit *parser.UnitFile, group string) {
-	imageName, ok := unit.Lookup(group, quadlet.KeyImage)
-	if !ok {
-		return
-	}
-	if strings.HasSuffix(imageName, ".build") || strings.HasSuffix(imageName, ".image") {
-		return
-	}
-	if !isUnambiguousName(imageName) {
-		Logf("Warning: %s specifies the image \"%s\" which not a fully qualified image name. This is not ideal for performance and security reasons. See the podman-pull manpage discussion of short-name-aliases.conf for details.", unit.Filename, imageName)
-	}
-}
-
-// Warns if the unit has any properties defined in the Service group that are known to cause issues.
-// We want to warn instead of erroring to avoid breaking any existing users' units,
-// or to allow users to use these properties if they know what they are doing.
-// We implement this here instead of in quadlet.initServiceUnitFile to avoid
-// having to refactor a large amount of code in the generator just for a warning.
-func warnIfUnsupportedServiceKeys(unit *parser.UnitFile) {
-	for _, key := range quadlet.UnsupportedServiceKeys {
-		_, hasKey := unit.Lookup(quadlet.ServiceGroup, key)
-		if hasKey {
-			Logf("Warning: using key %s in the Service group is not supported - use at your own risk", key)
-		}
-	}
-}
-
-func generateUnitsInfoMap(units []*parser.UnitFile) map[string]*quadlet.UnitInfo {
-	unitsInfoMap := make(map[string]*quadlet.UnitInfo)
-	for _, unit := range units {
-		var serviceName string
-		var containers []string
-		var resourceName string
-		var err error
-
-		serviceName, err = quadlet.GetUnitServiceName(unit)
-		if err != nil {
-			Logf("Error obtaining service name: %v", err)
-		}
-
-		switch {
-		case strings.HasSuffix(unit.Filename, ".container"):
-			// Prefill resourceNames for .container files. This solves network reusing.
-			resourceName = quadlet.GetContainerResourceName(unit)
-		case strings.HasSuffix(unit.Filename, ".build"):
-			// Prefill resourceNames for .build files. This is significantly less complex than
-			// pre-computing all resourceNames for all Quadlet types (which is rather complex for a few
-			// types), but still breaks the dependency cycle between .volume and .build ([Volume] can
-			// have Image=some.build, and [Build] can have Volume=some.volume:/some-volume)
-			resourceName = quadlet.GetBuiltImageName(unit)
-		case strings.HasSuffix(unit.Filename, ".artifact"):
-			serviceName = quadlet.GetArtifactServiceName(unit)
-		case strings.HasSuffix(unit.Filename, ".pod"):
-			containers = make([]string, 0)
-			// Prefill resourceNames for .pod files.
-			// This is requires for referencing the pod from .container files
-			resourceName = quadlet.GetPodResourceName(unit)
-		case strings.HasSuffix(unit.Filename, ".volume"), strings.HasSuffix(unit.Filename, ".kube"), strings.HasSuffix(unit.Filename, ".network"), strings.HasSuffix(unit.Filename, ".image"):
-			// Do nothing for these case.
-		default:
-			Logf("Unsupported file type %q", unit.Filename)
-			continue
-		}
-
-		unitsInfoMap[unit.Filename] = &quadlet.UnitInfo{
-			ServiceName:       serviceName,
-			ContainersToStart: containers,
-			ResourceName:      resourceName,
-		}
-	}
-
-	return unitsInfoMap
-}
-
 // quadletLogger implements the logiface.Logger interface using quadlet's custom logging
 type quadletLogger struct{}
 
@@ -497,7 +299,7 @@ func process() bool {
 	}
 
 	for _, unit := range units {
-		if err := loadUnitDropins(unit, sourcePathsMap); err != nil {
+		if err := quadlet.LoadUnitDropins(unit, sourcePathsMap); err != nil {
 			reportError(err)
 		}
 	}
@@ -510,57 +312,16 @@ func process() bool {
 		}
 	}
 
-	// Sort unit files according to potential inter-dependencies, with Volume and Network units
-	// taking precedence over all others.
-	sort.Slice(units, func(i, j int) bool {
-		getOrder := func(i int) int {
-			ext := filepath.Ext(units[i].Filename)
-			order, ok := quadlet.SupportedExtensions[ext]
-			if !ok {
-				return 0
-			}
-			return order
-		}
-		return getOrder(i) < getOrder(j)
-	})
+	quadlet.SortUnits(units)
 
 	// Generate the PodsInfoMap to allow containers to link to their pods and add themselves to the pod's containers list
-	unitsInfoMap := generateUnitsInfoMap(units)
+	unitsInfoMap := quadlet.GenerateUnitsInfoMap(units)
 
 	for _, unit := range units {
-		var service *parser.UnitFile
-		var warnings, err error
-
-		warnIfUnsupportedServiceKeys(unit)
-
-		switch {
-		case strings.HasSuffix(unit.Filename, ".container"):
-			warnIfAmbiguousName(unit, quadlet.ContainerGroup)
-			service, warnings, err = quadlet.ConvertContainer(unit, unitsInfoMap, isUserFlag)
-		case strings.HasSuffix(unit.Filename, ".volume"):
-			warnIfAmbiguousName(unit, quadlet.VolumeGroup)
-			service, warnings, err = quadlet.ConvertVolume(unit, unitsInfoMap, isUserFlag)
-		case strings.HasSuffix(unit.Filename, ".kube"):
-			service, err = quadlet.ConvertKube(unit, unitsInfoMap, isUserFlag)
-		case strings.HasSuffix(unit.Filename, ".network"):
-			service, warnings, err = quadlet.ConvertNetwork(unit, unitsInfoMap, isUserFlag)
-		case strings.HasSuffix(unit.Filename, ".image"):
-			warnIfAmbiguousName(unit, quadlet.ImageGroup)
-			service, err = quadlet.ConvertImage(unit, unitsInfoMap, isUserFlag)
-		case strings.HasSuffix(unit.Filename, ".build"):
-			service, warnings, err = quadlet.ConvertBuild(unit, unitsInfoMap, isUserFlag)
-		case strings.HasSuffix(unit.Filename, ".artifact"):
-			warnIfAmbiguousName(unit, quadlet.ArtifactGroup)
-			service, err = quadlet.ConvertArtifact(unit, unitsInfoMap, isUserFlag)
-		case strings.HasSuffix(unit.Filename, ".pod"):
-			service, warnings, err = quadlet.ConvertPod(unit, unitsInfoMap, isUserFlag)
-		default:
-			Logf("Unsupported file type %q", unit.Filename)
-			continue
-		}
+		generated, warnings, err := quadlet.ConvertUnit(unit, unitsInfoMap, isUserFlag)
 
-		if warnings != nil {
-			Logf("%s", warnings.Error())
+		for _, warning := range quadlet.SplitKeyErrors(warnings) {
+			Logf("Warning: %s", warning)
 		}
 
 		if err != nil {
@@ -568,21 +329,23 @@ func process() bool {
 			continue
 		}
 
-		service.Path = path.Join(outputPath, service.Filename)
+		for _, service := range generated {
+			service.Path = path.Join(outputPath, service.Filename)
 
-		if dryRunFlag {
-			data, err := service.ToString()
-			if err != nil {
-				reportError(fmt.Errorf("parsing %s: %w", service.Path, err))
+			if dryRunFlag {
+				data, err := service.ToString()
+				if err != nil {
+					reportError(fmt.Errorf("parsing %s: %w", service.Path, err))
+					continue
+				}
+				fmt.Printf("---%s---\n%s\n", service.Path, data)
 				continue
 			}
-			fmt.Printf("---%s---\n%s\n", service.Path, data)
-			continue
-		}
-		if err := generateServiceFile(service); err != nil {
-			reportError(fmt.Errorf("generating service file %s: %w", service.Path, err))
+			if err := generateServiceFile(service); err != nil {
+				reportError(fmt.Errorf("generating service file %s: %w", service.Path, err))
+			}
+			enableServiceFile(outputPath, service)
 		}
-		enableServiceFile(outputPath, service)
 	}
 	return processErred
 }
@@ -199,6 +199,17 @@ type ContainerState struct {
 	// restart policy. This is NOT incremented by normal container restarts
 	// (only by restart policy).
 	RestartCount uint `json:"restartCount,omitempty"`
+	// RestartBackoffDelay is the delay applied before the last restart by
+	// the restart policy. It is used to compute the delay of the next
+	// restart.
+	RestartBackoffDelay time.Duration `json:"restartBackoffDelay,omitempty"`
+	// RestartBackoffUntil is the time a pending restart of the container
+	// happens. It is only set while waiting for the restart backoff to
+	// expire.
+	RestartBackoffUntil time.Time `json:"restartBackoffUntil,omitempty"`
+	// RestartBackoffUnit is the name of the transient systemd unit that
+	// restarts the container once its restart backoff expired.
+	RestartBackoffUnit string `json:"restartBackoffUnit,omitempty"`
 	// StartupHCPassed indicates that the startup healthcheck has
 	// succeeded and the main healthcheck can begin.
 	StartupHCPassed bool `json:"startupHCPassed,omitempty"`
@@ -210,6 +221,14 @@ type ContainerState struct {
 	// healthcheck. The container will be restarted if this exceed a set
 	// number in the startup HC config.
 	StartupHCFailureCount int `json:"startupHCFailureCount,omitempty"`
+	// Ready indicates that the readiness check has passed.
+	Ready bool `json:"ready,omitempty"`
+	// ReadinessSuccessCount indicates the number of consecutive successes
+	// of the readiness check.
+	ReadinessSuccessCount int `json:"readinessSuccessCount,omitempty"`
+	// ReadinessFailureCount indicates the number of consecutive failures
+	// of the readiness check.
+	ReadinessFailureCount int `json:"readinessFailureCount,omitempty"`
 	// HCUnitName records the name of the healthcheck unit.
 	// Automatically generated when the healthcheck is started.
 	HCUnitName string `json:"hcUnitName,omitempty"`
@@ -676,6 +695,22 @@ func (c *Container) LogSizeMax() int64 {
 	return c.runtime.config.Containers.LogSizeMax
 }
 
+// LogMaxFiles returns the maximum number of log files kept when the
+// container's log file is rotated, including the active one.
+func (c *Container) LogMaxFiles() uint {
+	return c.config.LogMaxFiles
+}
+
+// LogCompress returns whether rotated log files are compressed.
+func (c *Container) LogCompress() bool {
+	return c.config.LogCompress
+}
+
+// LogMaxAge returns the maximum age of rotated log files.
+func (c *Container) LogMaxAge() time.Duration {
+	return c.config.LogMaxAge
+}
+
 // LogLabels returns the labels added to the container's log file
 func (c *Container) LogLabels() map[string]string {
 	return c.config.LogLabels
@@ -828,6 +863,23 @@ func (c *Container) State() (define.ContainerStatus, error) {
 	return c.state.State, nil
 }
 
+// RestartBackoff returns the time the container is restarted if it is
+// waiting for its restart backoff to expire, or the zero time otherwise.
+func (c *Container) RestartBackoff() (time.Time, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return time.Time{}, err
+		}
+	}
+	if !c.inRestartBackoff() {
+		return time.Time{}, nil
+	}
+	return c.state.RestartBackoffUntil, nil
+}
+
 func (c *Container) RestartCount() (uint, error) {
 	if !c.batched {
 		c.lock.Lock()
@@ -1064,6 +1116,27 @@ func (c *Container) StoppedByUser() (bool, error) {
 	return c.state.StoppedByUser, nil
 }
 
+// HasReadinessCheck returns whether the container has a readiness check
+// configured.
+func (c *Container) HasReadinessCheck() bool {
+	return c.config.ReadinessCheckConfig != nil
+}
+
+// Ready returns whether the container's readiness check passed.
+// Containers without a readiness check are never ready.
+func (c *Container) Ready() (bool, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return false, err
+		}
+	}
+
+	return c.state.Ready, nil
+}
+
 // StartupHCPassed returns whether the container's startup healthcheck passed.
 func (c *Container) StartupHCPassed() (bool, error) {
 	if !c.batched {
@@ -1412,6 +1485,12 @@ func (c *Container) Secrets() []*ContainerSecret {
 	return c.config.Secrets
 }
 
+// EnvSecrets returns the secrets set as environment variables in the
+// container, by the name of the variable.
+func (c *Container) EnvSecrets() map[string]*secrets.Secret {
+	return c.config.EnvSecrets
+}
+
 // Networks gets all the networks this container is connected to.
 // Please do NOT use ctr.config.Networks, as this can be changed from those
 // values at runtime via network connect and disconnect.
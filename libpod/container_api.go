@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/containers/podman/v6/libpod/define"
@@ -57,7 +58,7 @@ func (c *Container) initUnlocked(ctx context.Context, recursive bool) error {
 		}
 	}
 
-	if err := c.prepare(); err != nil {
+	if err := c.prepare(ctx); err != nil {
 		if err2 := c.cleanup(ctx); err2 != nil {
 			logrus.Errorf("Cleaning up container %s: %v", c.ID(), err2)
 		}
@@ -379,6 +380,62 @@ func (c *Container) Kill(signal uint) error {
 	return c.save()
 }
 
+// UpdateSecret replaces the container's copy of the secret, which is mounted
+// into the container as a file, with the current data of the secret. As the
+// mount keeps showing the replaced file, the new copy is mounted over it in
+// running containers, so they see the new data immediately.
+// If the container is running and signal is not 0, the signal is sent to it
+// afterwards to let it reload the secret. Returns whether the container is
+// running.
+func (c *Container) UpdateSecret(name string, signal uint) (bool, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return false, err
+		}
+	}
+
+	running := c.ensureState(define.ContainerStateRunning, define.ContainerStatePaused)
+	found := false
+	for _, secr := range c.config.Secrets {
+		if secr.Name != name {
+			continue
+		}
+		found = true
+		dir := c.config.SecretsPath
+		if isOnDemandSecret(secr) {
+			if !running {
+				// On-demand secrets are looked up again on
+				// every start, only the copy in use needs
+				// updating.
+				continue
+			}
+			dir = c.onDemandSecretsPath()
+		}
+		if err := c.extractSecret(secr, dir); err != nil {
+			return running, err
+		}
+		if running {
+			if err := c.remountSecret(filepath.Join(dir, secr.Name)); err != nil {
+				return running, fmt.Errorf("mounting secret %s into container %s: %w", name, c.ID(), err)
+			}
+		}
+	}
+	if !found {
+		return running, fmt.Errorf("container %s does not mount secret %s: %w", c.ID(), name, define.ErrInvalidArg)
+	}
+
+	if running && signal != 0 {
+		// Unlike Kill, this is not a request to stop the container.
+		if err := c.ociRuntime.KillContainer(c, signal, false); err != nil {
+			return running, err
+		}
+	}
+	return running, nil
+}
+
 // HTTPAttach forwards an attach session over a hijacked HTTP session.
 // HTTPAttach will consume and close the included httpCon, which is expected to
 // be sourced from a hijacked HTTP connection.
@@ -749,6 +806,11 @@ func (c *Container) WaitForConditionWithInterval(ctx context.Context, waitTimeou
 				return -1, fmt.Errorf("cannot use condition %q: container %s has no healthcheck", rawCondition, c.ID())
 			}
 			wantedHealthStates[rawCondition] = true
+		case define.ReadinessCheckReady:
+			if !c.HasReadinessCheck() {
+				return -1, fmt.Errorf("cannot use condition %q: container %s has no readiness check", rawCondition, c.ID())
+			}
+			wantedHealthStates[rawCondition] = true
 		default:
 			condition, err := define.StringToContainerStatus(rawCondition)
 			if err != nil {
@@ -831,6 +893,17 @@ func (c *Container) WaitForConditionWithInterval(ctx context.Context, waitTimeou
 						trySend(-1, nil)
 						return
 					}
+					if wantedHealthStates[define.ReadinessCheckReady] {
+						ready, err := c.Ready()
+						if err != nil {
+							trySend(-1, err)
+							return
+						}
+						if ready {
+							trySend(-1, nil)
+							return
+						}
+					}
 					// wait for another waitTimeout interval to give the health check process some time
 					// to record the healthy status.
 					if stoppedCount > 1 {
@@ -1012,11 +1085,14 @@ type ContainerCheckpointOptions struct {
 	IgnoreVolumes bool
 	// Pre Checkpoint container and leave container running
 	PreCheckPoint bool
-	// Dump container with Pre Checkpoint images
+	// Dump container with Pre Checkpoint images. Together with
+	// PreCheckPoint, only the memory changed since the previous
+	// pre-checkpoint is dumped.
 	WithPrevious bool
-	// ImportPrevious tells the API to restore container with two
-	// images. One is TargetFile, the other is ImportPrevious.
-	ImportPrevious string
+	// ImportPrevious tells the API to restore container with
+	// TargetFile and the pre-checkpoint images in ImportPrevious,
+	// ordered from the oldest to the latest pre-checkpoint.
+	ImportPrevious []string
 	// CreateImage tells Podman to create an OCI image from container
 	// checkpoint in the local image store.
 	CreateImage string
@@ -1032,6 +1108,9 @@ type ContainerCheckpointOptions struct {
 	// in the infrastructure container, but without the infrastructure
 	// container no PID 1 will be in the namespace and that is not
 	// possible.
+	// On checkpoint with TargetFile, Pod is the pod the container is
+	// exported together with, which allows the container to depend on
+	// other containers of the pod.
 	Pod string
 	// PrintStats tells the API to fill out the statistics about
 	// how much time each component in the stack requires to
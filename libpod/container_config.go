@@ -373,8 +373,18 @@ type ContainerMiscConfig struct {
 	LogLabels map[string]string `json:"logLabels,omitempty"`
 	// LogSize is the maximum size of the container's log file
 	LogSize int64 `json:"logSize"`
+	// LogMaxFiles is the maximum number of log files kept when the log
+	// file is rotated, including the active one.
+	LogMaxFiles uint `json:"logMaxFiles,omitempty"`
+	// LogCompress compresses rotated log files.
+	LogCompress bool `json:"logCompress,omitempty"`
+	// LogMaxAge is the maximum age of rotated log files.
+	LogMaxAge time.Duration `json:"logMaxAge,omitempty"`
 	// LogDriver driver for logs
 	LogDriver string `json:"logDriver"`
+	// LogDriverOptions are the options of log drivers which ship logs to
+	// a remote log server.
+	LogDriverOptions map[string]string `json:"logDriverOptions,omitempty"`
 	// File containing the conmon PID
 	ConmonPidFile string `json:"conmonPidFile,omitempty"`
 	// RestartPolicy indicates what action the container will take upon
@@ -388,6 +398,15 @@ type ContainerMiscConfig struct {
 	// restart the container. Used only if RestartPolicy is set to
 	// "on-failure".
 	RestartRetries uint `json:"restart_retries,omitempty"`
+	// RestartDelay is the initial delay before the container is restarted
+	// by its restart policy. The delay doubles on every consecutive restart,
+	// up to RestartMaxDelay. If 0, the container is restarted immediately.
+	RestartDelay time.Duration `json:"restart_delay,omitempty"`
+	// RestartMaxDelay is the maximum delay between two restarts.
+	RestartMaxDelay time.Duration `json:"restart_max_delay,omitempty"`
+	// RestartResetWindow is the time the container must run before the
+	// restart delay is reset to RestartDelay.
+	RestartResetWindow time.Duration `json:"restart_reset_window,omitempty"`
 	// PostConfigureNetNS needed when a user namespace is created by an OCI runtime
 	// if the network namespace is created before the user namespace it will be
 	// owned by the wrong user namespace.
@@ -428,6 +447,10 @@ type ContainerMiscConfig struct {
 	// healthcheck for the container. This will run before the regular HC
 	// runs, and when it passes the regular HC will be activated.
 	StartupHealthCheckConfig *define.StartupHealthCheck `json:"startupHealthCheck,omitempty"`
+	// ReadinessCheckConfig is the configuration of the readiness check
+	// for the container. It runs on its own timer, independent of the
+	// regular HC, and determines whether the container is ready.
+	ReadinessCheckConfig *define.ReadinessCheck `json:"readinessCheck,omitempty"`
 	// PreserveFDs is a number of additional file descriptors (in addition
 	// to 0, 1, 2) that will be passed to the executed process. The total FDs
 	// passed will be 3 + PreserveFDs.
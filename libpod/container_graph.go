@@ -6,6 +6,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"sync"
 
@@ -44,6 +46,29 @@ func (cg *ContainerGraph) DependencyMap() (dependencies map[*Container][]*Contai
 	return dependencies
 }
 
+// StartOrder returns the containers of the graph in the order they can be
+// started in, every container after all the containers it depends on.
+func (cg *ContainerGraph) StartOrder() []*Container {
+	ctrs := make([]*Container, 0, len(cg.nodes))
+	visited := make(map[string]bool, len(cg.nodes))
+	var visit func(node *containerNode)
+	visit = func(node *containerNode) {
+		if visited[node.id] {
+			return
+		}
+		visited[node.id] = true
+		for _, dep := range node.dependsOn {
+			visit(dep)
+		}
+		ctrs = append(ctrs, node.container)
+	}
+	// The nodes are kept in a map, sort them for a stable order.
+	for _, id := range slices.Sorted(maps.Keys(cg.nodes)) {
+		visit(cg.nodes[id])
+	}
+	return ctrs
+}
+
 // BuildContainerGraph builds a dependency graph based on the container slice.
 func BuildContainerGraph(ctrs []*Container) (*ContainerGraph, error) {
 	graph := new(ContainerGraph)
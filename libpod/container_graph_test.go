@@ -311,3 +311,30 @@ func TestBuildContainerGraphFourContainersNoneInCycle(t *testing.T) {
 	assert.Equal(t, 2, len(graph.noDepNodes))
 	assert.Equal(t, 2, len(graph.notDependedOnNodes))
 }
+
+func TestContainerGraphStartOrder(t *testing.T) {
+	manager, err := lock.NewInMemoryManager(16)
+	if err != nil {
+		t.Fatalf("Error setting up locks: %v", err)
+	}
+
+	ctr1, err := getTestCtr1(manager)
+	assert.NoError(t, err)
+	ctr2, err := getTestCtr2(manager)
+	assert.NoError(t, err)
+	ctr3, err := getTestCtrN("3", manager)
+	assert.NoError(t, err)
+	ctr4, err := getTestCtrN("4", manager)
+	assert.NoError(t, err)
+
+	ctr1.config.IPCNsCtr = ctr2.config.ID
+	ctr1.config.NetNsCtr = ctr3.config.ID
+	ctr2.config.UserNsCtr = ctr3.config.ID
+	ctr4.config.Dependencies = []string{ctr1.config.ID}
+
+	graph, err := BuildContainerGraph([]*Container{ctr1, ctr2, ctr3, ctr4})
+	assert.NoError(t, err)
+
+	order := graph.StartOrder()
+	assert.Equal(t, []*Container{ctr3, ctr2, ctr1, ctr4}, order)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"strconv"
 	"strings"
 
 	"github.com/containers/podman/v6/libpod/define"
@@ -205,6 +206,19 @@ func (c *Container) getContainerInspectData(size bool, driverData *define.Driver
 		data.State.Health = nil
 	}
 
+	if c.HasReadinessCheck() {
+		ready := c.state.Ready
+		data.State.Ready = &ready
+	}
+
+	if c.inRestartBackoff() {
+		data.State.Status = define.ContainerStateBackoff
+		data.State.Backoff = &define.InspectBackoff{
+			Delay:     c.state.RestartBackoffDelay.String(),
+			RestartAt: c.state.RestartBackoffUntil,
+		}
+	}
+
 	networkConfig, err := c.getContainerNetworkInfo()
 	if err != nil {
 		return nil, err
@@ -423,6 +437,7 @@ func (c *Container) generateInspectContainerConfig(spec *spec.Spec) *define.Insp
 	// TODO: should JSON deep copy this to ensure internal pointers don't
 	// leak.
 	ctrConfig.StartupHealthCheck = c.config.StartupHealthCheckConfig
+	ctrConfig.ReadinessCheck = c.config.ReadinessCheckConfig
 
 	ctrConfig.Healthcheck = c.config.HealthCheckConfig
 
@@ -492,6 +507,19 @@ func (c *Container) generateInspectContainerHostConfig(ctrSpec *spec.Spec, named
 	logConfig.Path = c.config.LogPath
 	logConfig.Size = units.HumanSize(float64(c.LogSizeMax()))
 	logConfig.Tag = c.config.LogTag
+	if len(c.config.LogDriverOptions) > 0 {
+		logConfig.Config = maps.Clone(c.config.LogDriverOptions)
+	}
+	if c.config.LogMaxFiles > 0 {
+		if logConfig.Config == nil {
+			logConfig.Config = make(map[string]string)
+		}
+		logConfig.Config["max-file"] = strconv.FormatUint(uint64(c.config.LogMaxFiles), 10)
+		logConfig.Config["compress"] = strconv.FormatBool(c.config.LogCompress)
+		if c.config.LogMaxAge > 0 {
+			logConfig.Config["max-age"] = c.config.LogMaxAge.String()
+		}
+	}
 
 	hostConfig.LogConfig = logConfig
 
@@ -501,6 +529,11 @@ func (c *Container) generateInspectContainerHostConfig(ctrSpec *spec.Spec, named
 		restartPolicy.Name = define.RestartPolicyNo
 	}
 	restartPolicy.MaximumRetryCount = c.config.RestartRetries
+	if c.config.RestartDelay > 0 {
+		restartPolicy.Delay = c.config.RestartDelay.String()
+		restartPolicy.MaxDelay = c.config.RestartMaxDelay.String()
+		restartPolicy.ResetWindow = c.config.RestartResetWindow.String()
+	}
 	hostConfig.RestartPolicy = restartPolicy
 	if c.config.NoCgroups {
 		hostConfig.Cgroups = "disabled"
@@ -31,8 +31,10 @@ import (
 	envLib "github.com/containers/podman/v6/pkg/env"
 	"github.com/containers/podman/v6/pkg/lookup"
 	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/containers/podman/v6/pkg/secrets/vaultdriver"
 	"github.com/containers/podman/v6/pkg/selinux"
 	"github.com/containers/podman/v6/pkg/systemd/notifyproxy"
+	"github.com/containers/podman/v6/pkg/tracing"
 	"github.com/containers/podman/v6/pkg/util"
 	"github.com/coreos/go-systemd/v22/daemon"
 	securejoin "github.com/cyphar/filepath-securejoin"
@@ -142,6 +144,43 @@ func (c *Container) PreCheckPointPath() string {
 	return filepath.Join(c.bundlePath(), preCheckpointDir)
 }
 
+// preCheckpointChainDir returns the name of the directory of the n-th
+// previous pre-checkpoint an incremental pre-checkpoint is based on
+func preCheckpointChainDir(n int) string {
+	return fmt.Sprintf("%s.%d", preCheckpointDir, n)
+}
+
+// preCheckpointChainLength returns the number of previous pre-checkpoints
+// the current pre-checkpoint is based on
+func (c *Container) preCheckpointChainLength() int {
+	n := 0
+	for fileutils.Exists(filepath.Join(c.bundlePath(), preCheckpointChainDir(n+1))) == nil {
+		n++
+	}
+	return n
+}
+
+// pushPreCheckpoint moves the current pre-checkpoint to the end of the chain
+// of previous pre-checkpoints and returns the name of its new directory
+func (c *Container) pushPreCheckpoint() (string, error) {
+	parent := preCheckpointChainDir(c.preCheckpointChainLength() + 1)
+	if err := os.Rename(c.PreCheckPointPath(), filepath.Join(c.bundlePath(), parent)); err != nil {
+		return "", fmt.Errorf("moving previous pre-checkpoint: %w", err)
+	}
+	return parent, nil
+}
+
+// removePreCheckpoints removes the pre-checkpoint and all previous
+// pre-checkpoints it is based on
+func (c *Container) removePreCheckpoints() error {
+	for n := c.preCheckpointChainLength(); n > 0; n-- {
+		if err := os.RemoveAll(filepath.Join(c.bundlePath(), preCheckpointChainDir(n))); err != nil {
+			return err
+		}
+	}
+	return os.RemoveAll(c.PreCheckPointPath())
+}
+
 // AttachSocketPath retrieves the path of the container's attach socket
 func (c *Container) AttachSocketPath() (string, error) {
 	return c.ociRuntime.AttachSocketPath(c)
@@ -287,6 +326,9 @@ func (c *Container) handleRestartPolicy(ctx context.Context) (_ bool, retErr err
 			return false, err
 		}
 	}
+	if err := c.removeReadinessTimer(ctx); err != nil {
+		return false, err
+	}
 
 	// Is the container running again?
 	// If so, we don't have to do anything
@@ -296,6 +338,13 @@ func (c *Container) handleRestartPolicy(ctx context.Context) (_ bool, retErr err
 		return false, fmt.Errorf("invalid container state encountered in restart attempt: %w", define.ErrInternal)
 	}
 
+	if c.config.RestartDelay > 0 {
+		restart, err := c.handleRestartBackoff(ctx)
+		if err != nil || !restart {
+			return false, err
+		}
+	}
+
 	c.newContainerEvent(events.Restart)
 
 	// Increment restart count
@@ -326,7 +375,7 @@ func (c *Container) handleRestartPolicy(ctx context.Context) (_ bool, retErr err
 		return false, err
 	}
 
-	if err := c.prepare(); err != nil {
+	if err := c.prepare(ctx); err != nil {
 		return false, err
 	}
 
@@ -347,6 +396,107 @@ func (c *Container) handleRestartPolicy(ctx context.Context) (_ bool, retErr err
 	return true, c.waitForHealthy(ctx)
 }
 
+// handleRestartBackoff delays the restart of a container with a restart
+// backoff. The delay starts at RestartDelay and doubles on every consecutive
+// restart up to RestartMaxDelay; it is reset once the container ran for at
+// least RestartResetWindow.
+// The first call after the container exited schedules a timer that runs the
+// cleanup of the container again once the delay expired, and returns false.
+// The call from the timer then restarts the container.
+// Returns whether the container should be restarted now.
+func (c *Container) handleRestartBackoff(ctx context.Context) (bool, error) {
+	if !c.state.RestartBackoffUntil.IsZero() {
+		// The timer fires after the backoff expired; an earlier cleanup,
+		// e.g. a manual one, must not restart the container.
+		if time.Now().Before(c.state.RestartBackoffUntil) {
+			return false, nil
+		}
+		c.state.RestartBackoffUntil = time.Time{}
+		c.state.RestartBackoffUnit = ""
+		if err := c.save(); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	delay := c.config.RestartDelay
+	ran := c.state.FinishedTime.Sub(c.state.StartedTime)
+	if c.state.RestartBackoffDelay > 0 && ran < c.config.RestartResetWindow {
+		delay = min(2*c.state.RestartBackoffDelay, c.config.RestartMaxDelay)
+	}
+	c.state.RestartBackoffDelay = delay
+	c.state.RestartBackoffUntil = time.Now().Add(delay)
+	logrus.Debugf("Container %s is in restart backoff, restarting in %s", c.ID(), delay)
+
+	if restartBackoffTimerSupported() {
+		unitName, err := c.createRestartBackoffTimer(delay)
+		if err != nil {
+			return false, fmt.Errorf("scheduling restart of container %s: %w", c.ID(), err)
+		}
+		c.state.RestartBackoffUnit = unitName
+		return false, c.save()
+	}
+	if err := c.save(); err != nil {
+		return false, err
+	}
+	return c.waitForRestartBackoff(ctx, delay)
+}
+
+// waitForRestartBackoff waits for the restart backoff to expire when no
+// timer can be scheduled. The container lock is released while waiting so
+// the container can be stopped, started or removed in the meantime.
+// Returns whether the container should still be restarted.
+func (c *Container) waitForRestartBackoff(ctx context.Context, delay time.Duration) (bool, error) {
+	if !c.batched {
+		c.lock.Unlock()
+	}
+	timer := time.NewTimer(delay)
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+	timer.Stop()
+	if !c.batched {
+		c.lock.Lock()
+		if err := c.syncContainer(); err != nil {
+			return false, err
+		}
+	}
+
+	// Another process may have cleared the backoff while we were waiting.
+	pending := !c.state.RestartBackoffUntil.IsZero()
+	c.state.RestartBackoffUntil = time.Time{}
+	if err := c.save(); err != nil {
+		return false, err
+	}
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	// The container may have been stopped by the user or started again.
+	return pending && c.ensureState(define.ContainerStateStopped) && c.shouldRestart(), nil
+}
+
+// cancelRestartBackoff cancels a pending restart of the container's restart
+// backoff and removes its timer.
+// NOTE: The caller must lock and sync the container and save its state.
+func (c *Container) cancelRestartBackoff() {
+	c.state.RestartBackoffUntil = time.Time{}
+	if c.state.RestartBackoffUnit == "" {
+		return
+	}
+	if err := removeRestartBackoffTimer(context.Background(), c.state.RestartBackoffUnit); err != nil {
+		logrus.Errorf("Removing restart timer of container %s: %v", c.ID(), err)
+	}
+	c.state.RestartBackoffUnit = ""
+}
+
+// inRestartBackoff returns whether the container is stopped and waiting for
+// its restart backoff to expire.
+func (c *Container) inRestartBackoff() bool {
+	return c.ensureState(define.ContainerStateStopped, define.ContainerStateExited) && time.Now().Before(c.state.RestartBackoffUntil)
+}
+
 // Ensure that the container is in a specific state or state.
 // Returns true if the container is in one of the given states,
 // or false otherwise.
@@ -640,6 +790,9 @@ func resetContainerState(state *ContainerState) {
 	state.BindMounts = make(map[string]string)
 	state.RestartPolicyMatch = false
 	state.RestartCount = 0
+	// Transient restart timers do not survive a reboot.
+	state.RestartBackoffUntil = time.Time{}
+	state.RestartBackoffUnit = ""
 	state.Checkpointed = false
 	state.Restored = false
 	state.CheckpointedTime = time.Time{}
@@ -650,6 +803,9 @@ func resetContainerState(state *ContainerState) {
 	state.StartupHCPassed = false
 	state.StartupHCSuccessCount = 0
 	state.StartupHCFailureCount = 0
+	state.Ready = false
+	state.ReadinessSuccessCount = 0
+	state.ReadinessFailureCount = 0
 	state.HCUnitName = ""
 	state.NetNS = ""
 	state.NetworkStatus = nil
@@ -844,7 +1000,7 @@ func (c *Container) prepareToStart(ctx context.Context, recursive bool) (retErr
 		}
 	}()
 
-	if err := c.prepare(); err != nil {
+	if err := c.prepare(ctx); err != nil {
 		return err
 	}
 
@@ -1051,6 +1207,11 @@ func (c *Container) init(ctx context.Context, retainRetries bool) error {
 		}
 	}
 
+	// Rotate the log file before conmon opens it.
+	if err := c.rotateLog(); err != nil {
+		logrus.Errorf("Rotating log file: %v", err)
+	}
+
 	// To ensure that we don't lose track of Conmon if hit by a SIGTERM
 	// in the middle of setting up the container, inhibit shutdown signals
 	// until after we save Conmon's PID to the state.
@@ -1072,7 +1233,10 @@ func (c *Container) init(ctx context.Context, retainRetries bool) error {
 	}
 
 	// With the spec complete, do an OCI create
-	if _, err = c.ociRuntime.CreateContainer(c, nil); err != nil {
+	_, span := tracing.Start(ctx, "libpod.oci.create", tracing.ContainerIDKey.String(c.ID()), tracing.OCIRuntimeKey.String(c.ociRuntime.Name()))
+	_, err = c.ociRuntime.CreateContainer(c, nil)
+	tracing.End(span, err)
+	if err != nil {
 		return err
 	}
 
@@ -1104,6 +1268,9 @@ func (c *Container) init(ctx context.Context, retainRetries bool) error {
 	c.state.StartupHCFailureCount = 0
 	c.state.StartupHCSuccessCount = 0
 	c.state.StartupHCPassed = false
+	c.state.Ready = false
+	c.state.ReadinessSuccessCount = 0
+	c.state.ReadinessFailureCount = 0
 
 	if !retainRetries {
 		c.state.RestartCount = 0
@@ -1226,7 +1393,7 @@ func (c *Container) initAndStart(ctx context.Context) (retErr error) {
 		}
 	}()
 
-	if err := c.prepare(); err != nil {
+	if err := c.prepare(ctx); err != nil {
 		return err
 	}
 
@@ -1255,6 +1422,9 @@ func (c *Container) initAndStart(ctx context.Context) (retErr error) {
 // Please note that this DOES take the container lock.
 // Intended to be used in pod-related functions.
 func (c *Container) startNoPodLock(ctx context.Context, recursive bool) (finalErr error) {
+	ctx, span := tracing.Start(ctx, "libpod.container.start", tracing.ContainerIDKey.String(c.ID()))
+	defer func() { tracing.End(span, finalErr) }()
+
 	if !c.batched {
 		c.lock.Lock()
 		defer c.lock.Unlock()
@@ -1297,6 +1467,8 @@ func (c *Container) start() error {
 	logrus.Debugf("Started container %s", c.ID())
 
 	c.state.State = define.ContainerStateRunning
+	// Starting the container cancels a pending restart of its restart backoff.
+	c.cancelRestartBackoff()
 
 	// Unless being ignored, set the MAINPID to conmon.
 	if c.config.SdNotifyMode != define.SdNotifyModeIgnore {
@@ -1321,6 +1493,17 @@ func (c *Container) start() error {
 			return fmt.Errorf("start healthcheck: %w", err)
 		}
 	}
+	if err := c.startReadinessTimer(); err != nil {
+		return fmt.Errorf("start readiness check: %w", err)
+	}
+
+	if err := c.registerLogRotation(); err != nil {
+		logrus.Errorf("Registering container %s for log rotation: %v", c.ID(), err)
+	}
+
+	if err := c.startLogShipper(); err != nil {
+		logrus.Errorf("Shipping logs of container %s: %v", c.ID(), err)
+	}
 
 	c.newContainerEvent(events.Start)
 
@@ -1328,7 +1511,8 @@ func (c *Container) start() error {
 }
 
 // waitForHealthy, when sdNotifyMode == SdNotifyModeHealthy, waits up to the DefaultWaitInterval
-// for the container to get into the healthy state and reports the status to the notify socket.
+// for the container to get into the healthy state, and the ready state if it has a readiness
+// check, and reports the status to the notify socket.
 // The function unlocks the container lock, so it must be called from the same thread that locks
 // the container.
 func (c *Container) waitForHealthy(ctx context.Context) error {
@@ -1351,6 +1535,16 @@ func (c *Container) waitForHealthy(ctx context.Context) error {
 		return err
 	}
 
+	// A container with a readiness check must be ready as well.
+	if c.HasReadinessCheck() {
+		if _, err := c.WaitForConditionWithInterval(ctx, DefaultWaitInterval, define.ReadinessCheckReady); err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) {
+				return nil
+			}
+			return err
+		}
+	}
+
 	if err := notifyproxy.SendMessage(c.config.SdNotifySocket, daemon.SdNotifyReady); err != nil {
 		logrus.Errorf("Sending READY message after turning healthy: %s", err.Error())
 	} else {
@@ -1405,6 +1599,8 @@ func (c *Container) stopInternal(timeout uint, stoppedByUser bool) error {
 
 	if stoppedByUser {
 		c.state.StoppedByUser = true
+		// Do not restart a container waiting for its restart backoff.
+		c.cancelRestartBackoff()
 	}
 
 	if cannotStopErr == nil {
@@ -1566,6 +1762,9 @@ func (c *Container) pause() error {
 			return fmt.Errorf("failed to remove HealthCheck timer: %v", err)
 		}
 	}
+	if err := c.removeReadinessTimer(context.Background()); err != nil {
+		return fmt.Errorf("failed to remove readiness check timer: %v", err)
+	}
 
 	if err := c.ociRuntime.PauseContainer(c); err != nil {
 		// TODO when using docker-py there is some sort of race/incompatibility here
@@ -1612,6 +1811,9 @@ func (c *Container) unpause() error {
 			return err
 		}
 	}
+	if err := c.startReadinessTimer(); err != nil {
+		return err
+	}
 
 	logrus.Debugf("Unpaused container %s", c.ID())
 
@@ -1649,6 +1851,9 @@ func (c *Container) restartWithTimeout(ctx context.Context, timeout uint) (retEr
 				logrus.Error(err.Error())
 			}
 		}
+		if err := c.removeReadinessTimer(context.Background()); err != nil {
+			logrus.Error(err.Error())
+		}
 		// Ensure we tear down the container network so it will be
 		// recreated - otherwise, behavior of restart differs from stop
 		// and start
@@ -1663,7 +1868,7 @@ func (c *Container) restartWithTimeout(ctx context.Context, timeout uint) (retEr
 			}
 		}
 	}()
-	if err := c.prepare(); err != nil {
+	if err := c.prepare(ctx); err != nil {
 		return err
 	}
 
@@ -2122,6 +2327,13 @@ func (c *Container) fullCleanup(ctx context.Context, onlyStopped bool) error {
 
 	// if the container was not created in the oci runtime or was already cleaned up, then do nothing
 	if c.ensureState(define.ContainerStateConfigured, define.ContainerStateExited) {
+		// A container in restart backoff is restarted by the cleanup
+		// run from its restart timer.
+		if !c.state.RestartBackoffUntil.IsZero() {
+			if _, err := c.handleRestartPolicy(ctx); err != nil {
+				return err
+			}
+		}
 		return nil
 	}
 
@@ -2135,6 +2347,11 @@ func (c *Container) fullCleanup(ctx context.Context, onlyStopped bool) error {
 	if didRestart {
 		return nil
 	}
+	// The container may have been started again while waiting for its
+	// restart backoff.
+	if c.ensureState(define.ContainerStateRunning, define.ContainerStatePaused) {
+		return nil
+	}
 
 	// If we didn't restart, we perform a normal cleanup
 
@@ -2185,6 +2402,15 @@ func (c *Container) cleanup(ctx context.Context) error {
 			logrus.Errorf("Removing timer for container %s healthcheck: %v", c.ID(), err)
 		}
 	}
+	if err := c.removeReadinessTimer(ctx); err != nil {
+		logrus.Errorf("Removing timer for container %s readiness check: %v", c.ID(), err)
+	}
+
+	if err := c.unregisterLogRotation(); err != nil {
+		logrus.Errorf("Unregistering container %s from log rotation: %v", c.ID(), err)
+	}
+
+	c.superviseLogShipper()
 
 	// Clean up network namespace, if present
 	if err := c.cleanupNetwork(); err != nil {
@@ -2747,36 +2973,85 @@ func (c *Container) hasNamespace(namespace spec.LinuxNamespaceType) bool {
 	return false
 }
 
+// isOnDemandSecret returns whether the data of the secret is looked up on
+// every start instead of being copied to the container's static dir once.
+// Vault secrets are fetched from a remote store, they are kept in the
+// container's run dir so the data is never written to disk and rotated
+// secrets are picked up by the next start.
+func isOnDemandSecret(secr *ContainerSecret) bool {
+	if secr.Secret == nil {
+		return false
+	}
+	_, ok := vaultdriver.FromShellOptions(secr.Driver, secr.DriverOptions)
+	return ok
+}
+
+// onDemandSecretsPath returns the directory holding the data of on-demand
+// secrets while the container runs.
+func (c *Container) onDemandSecretsPath() string {
+	return filepath.Join(c.state.RunDir, "secrets-data")
+}
+
 // extractSecretToCtrStorage copies a secret's data from the secrets manager to the container's static dir
 func (c *Container) extractSecretToCtrStorage(secr *ContainerSecret) error {
+	return c.extractSecret(secr, c.config.SecretsPath)
+}
+
+// extractSecret copies a secret's data from the secrets manager to dir
+func (c *Container) extractSecret(secr *ContainerSecret, dir string) error {
 	manager, err := c.runtime.SecretsManager()
 	if err != nil {
 		return err
 	}
-	_, data, err := manager.LookupSecretData(secr.Name)
+	var data []byte
+	secret, err := manager.Lookup(secr.Name)
 	if err != nil {
 		return err
 	}
-	secretFile := filepath.Join(c.config.SecretsPath, secr.Name)
+	if opts, ok := vaultdriver.FromShellOptions(secret.Driver, secret.DriverOptions); ok {
+		// Look vault secrets up in-process rather than through the
+		// shell driver, so long running processes cache them.
+		driver, err := vaultdriver.NewDriver(opts)
+		if err != nil {
+			return err
+		}
+		if data, err = driver.Lookup(secret.ID); err != nil {
+			return err
+		}
+	} else if _, data, err = manager.LookupSecretData(secr.Name); err != nil {
+		return err
+	}
+	secretFile := filepath.Join(dir, secr.Name)
 
 	hostUID, hostGID, err := butil.GetHostIDs(util.IDtoolsToRuntimeSpec(c.config.IDMappings.UIDMap), util.IDtoolsToRuntimeSpec(c.config.IDMappings.GIDMap), secr.UID, secr.GID)
 	if err != nil {
 		return fmt.Errorf("unable to extract secret: %w", err)
 	}
-	err = os.WriteFile(secretFile, data, 0o644)
+	// Write the data to a temporary file that replaces the secret file once
+	// it is complete, so the secret file never holds partial data.
+	tmpFile, err := os.CreateTemp(dir, "."+secr.Name+"-")
+	if err != nil {
+		return fmt.Errorf("unable to create %s: %w", secretFile, err)
+	}
+	tmpName := tmpFile.Name()
+	defer os.Remove(tmpName)
+	_, err = tmpFile.Write(data)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
 	if err != nil {
 		return fmt.Errorf("unable to create %s: %w", secretFile, err)
 	}
-	if err := idtools.SafeLchown(secretFile, int(hostUID), int(hostGID)); err != nil {
+	if err := idtools.SafeLchown(tmpName, int(hostUID), int(hostGID)); err != nil {
 		return err
 	}
-	if err := os.Chmod(secretFile, os.FileMode(secr.Mode)); err != nil {
+	if err := os.Chmod(tmpName, os.FileMode(secr.Mode)); err != nil {
 		return err
 	}
-	if err := c.relabel(secretFile, c.config.MountLabel, false); err != nil {
+	if err := c.relabel(tmpName, c.config.MountLabel, false); err != nil {
 		return err
 	}
-	return nil
+	return os.Rename(tmpName, secretFile)
 }
 
 // Update a container's resources or restart policy after creation.
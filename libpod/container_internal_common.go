@@ -1121,7 +1121,22 @@ func (c *Container) createCheckpointImage(ctx context.Context, options Container
 }
 
 func (c *Container) exportCheckpoint(options ContainerCheckpointOptions) error {
-	if len(c.Dependencies()) == 1 {
+	if options.Pod != "" {
+		// The container is checkpointed together with its pod, so it
+		// may depend on any other container of the pod.
+		if c.config.Pod != options.Pod {
+			return fmt.Errorf("container %s is not part of pod %s", c.ID(), options.Pod)
+		}
+		for _, dep := range c.Dependencies() {
+			depCtr, err := c.runtime.state.Container(dep)
+			if err != nil {
+				return fmt.Errorf("retrieving dependency %s of container %s: %w", dep, c.ID(), err)
+			}
+			if depCtr.config.Pod != c.config.Pod {
+				return fmt.Errorf("cannot export checkpoint of container %s with dependency %s outside of pod %s", c.ID(), dep, c.config.Pod)
+			}
+		}
+	} else if len(c.Dependencies()) == 1 {
 		// Check if the dependency is an infra container. If it is we can checkpoint
 		// the container out of the Pod.
 		if c.config.Pod == "" {
@@ -1139,8 +1154,7 @@ func (c *Container) exportCheckpoint(options ContainerCheckpointOptions) error {
 		if c.Dependencies()[0] != infraID {
 			return errors.New("cannot export checkpoints of containers with dependencies")
 		}
-	}
-	if len(c.Dependencies()) > 1 {
+	} else if len(c.Dependencies()) > 1 {
 		return errors.New("cannot export checkpoints of containers with dependencies")
 	}
 	logrus.Debugf("Exporting checkpoint image of container %q to %q", c.ID(), options.TargetFile)
@@ -1299,8 +1313,33 @@ func (c *Container) checkpoint(ctx context.Context, options ContainerCheckpointO
 	c.state.CheckpointLog = path.Join(c.bundlePath(), "dump.log")
 	c.state.CheckpointPath = c.CheckpointPath()
 
+	// An incremental pre-checkpoint only contains the memory pages changed
+	// since the previous pre-checkpoint, which is kept in the chain the new
+	// one refers to.  A new full pre-checkpoint starts a new chain.
+	parentDir := preCheckpointDir
+	switch {
+	case options.PreCheckPoint && options.WithPrevious:
+		parent, err := c.pushPreCheckpoint()
+		if err != nil {
+			return nil, 0, err
+		}
+		parentDir = parent
+	case options.PreCheckPoint:
+		if err := c.removePreCheckpoints(); err != nil {
+			return nil, 0, fmt.Errorf("removing previous pre-checkpoints: %w", err)
+		}
+	}
+
 	runtimeCheckpointDuration, err := c.ociRuntime.CheckpointContainer(c, options)
 	if err != nil {
+		if parentDir != preCheckpointDir {
+			// Restore the previous pre-checkpoint to allow trying again.
+			if err := os.RemoveAll(c.PreCheckPointPath()); err != nil {
+				logrus.Errorf("Removing failed pre-checkpoint of container %s: %v", c.ID(), err)
+			} else if err := os.Rename(filepath.Join(c.bundlePath(), parentDir), c.PreCheckPointPath()); err != nil {
+				logrus.Errorf("Restoring previous pre-checkpoint of container %s: %v", c.ID(), err)
+			}
+		}
 		return nil, 0, err
 	}
 
@@ -1340,8 +1379,12 @@ func (c *Container) checkpoint(ctx context.Context, options ContainerCheckpointO
 	// There is a bug from criu: https://github.com/checkpoint-restore/criu/issues/116
 	// We have to change the symbolic link from absolute path to relative path
 	if options.WithPrevious {
-		os.Remove(path.Join(c.CheckpointPath(), "parent"))
-		if err := os.Symlink("../pre-checkpoint", path.Join(c.CheckpointPath(), "parent")); err != nil {
+		imagePath := c.CheckpointPath()
+		if options.PreCheckPoint {
+			imagePath = c.PreCheckPointPath()
+		}
+		os.Remove(path.Join(imagePath, "parent"))
+		if err := os.Symlink(path.Join("..", parentDir), path.Join(imagePath, "parent")); err != nil {
 			return nil, 0, err
 		}
 	}
@@ -1490,6 +1533,14 @@ func (c *Container) importPreCheckpoint(input string) error {
 
 	defer archiveFile.Close()
 
+	// Pre-checkpoints are imported oldest first, an incremental one refers
+	// to the previously imported one in the chain.
+	if err := fileutils.Exists(c.PreCheckPointPath()); err == nil {
+		if _, err := c.pushPreCheckpoint(); err != nil {
+			return err
+		}
+	}
+
 	err = archive.Untar(archiveFile, c.bundlePath(), nil)
 	if err != nil {
 		return fmt.Errorf("unpacking of pre-checkpoint archive %s failed: %w", input, err)
@@ -1516,8 +1567,8 @@ func (c *Container) restore(ctx context.Context, options ContainerCheckpointOpti
 		return nil, 0, fmt.Errorf("container %s is running or paused, cannot restore: %w", c.ID(), define.ErrCtrStateInvalid)
 	}
 
-	if options.ImportPrevious != "" {
-		if err := c.importPreCheckpoint(options.ImportPrevious); err != nil {
+	for _, previous := range options.ImportPrevious {
+		if err := c.importPreCheckpoint(previous); err != nil {
 			return nil, 0, err
 		}
 	}
@@ -1617,7 +1668,7 @@ func (c *Container) restore(ctx context.Context, options ContainerCheckpointOpti
 		}
 	}()
 
-	if err := c.prepare(); err != nil {
+	if err := c.prepare(ctx); err != nil {
 		return nil, 0, err
 	}
 
@@ -1868,7 +1919,7 @@ func (c *Container) restore(ctx context.Context, options ContainerCheckpointOpti
 			logrus.Debugf("Non-fatal: removal of checkpoint directory (%s) failed: %v", c.CheckpointPath(), err)
 		}
 		c.state.CheckpointPath = ""
-		err = os.RemoveAll(c.PreCheckPointPath())
+		err = c.removePreCheckpoints()
 		if err != nil {
 			logrus.Debugf("Non-fatal: removal of pre-checkpoint directory (%s) failed: %v", c.PreCheckPointPath(), err)
 		}
@@ -2131,7 +2182,8 @@ rootless=%d
 	}
 
 	// Secrets are mounted by getting the secret data from the secrets manager,
-	// copying the data into the container's static dir,
+	// copying the data into the container's static dir, or for on-demand
+	// secrets into the run dir on every start,
 	// then mounting the copied dir into /run/secrets.
 	// The secrets mounting must come after subscription mounts, since subscription mounts
 	// creates the /run/secrets dir in the container where we mount as well.
@@ -2151,6 +2203,15 @@ rootless=%d
 				}
 			}
 			src := filepath.Join(c.config.SecretsPath, secret.Name)
+			if isOnDemandSecret(secret) {
+				if err := os.MkdirAll(c.onDemandSecretsPath(), 0o755); err != nil {
+					return fmt.Errorf("creating secrets directory: %w", err)
+				}
+				if err := c.extractSecret(secret, c.onDemandSecretsPath()); err != nil {
+					return fmt.Errorf("looking up secret %s: %w", secret.Name, err)
+				}
+				src = filepath.Join(c.onDemandSecretsPath(), secret.Name)
+			}
 			dest := filepath.Join(base, secretFileName)
 			c.state.BindMounts[dest] = src
 		}
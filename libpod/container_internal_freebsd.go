@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/containers/podman/v6/pkg/tracing"
 	securejoin "github.com/cyphar/filepath-securejoin"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/opencontainers/runtime-tools/generate"
@@ -33,7 +34,7 @@ func (c *Container) unmountSHM(_ string) error {
 
 // prepare mounts the container and sets up other required resources like net
 // namespaces
-func (c *Container) prepare() error {
+func (c *Container) prepare(ctx context.Context) error {
 	var (
 		wg                              sync.WaitGroup
 		ctrNS                           string
@@ -54,7 +55,9 @@ func (c *Container) prepare() error {
 			if createNetNSErr != nil {
 				return
 			}
+			_, span := tracing.Start(ctx, "libpod.network.setup", tracing.ContainerIDKey.String(c.ID()))
 			ctrNS, networkStatus, createNetNSErr = c.runtime.createNetNS(c)
+			tracing.End(span, createNetNSErr)
 			if createNetNSErr != nil {
 				return
 			}
@@ -70,7 +73,9 @@ func (c *Container) prepare() error {
 	// Mount storage if not mounted
 	go func() {
 		defer wg.Done()
+		_, span := tracing.Start(ctx, "libpod.storage.mount", tracing.ContainerIDKey.String(c.ID()))
 		mountPoint, mountStorageErr = c.mountStorage()
+		tracing.End(span, mountStorageErr)
 
 		if mountStorageErr != nil {
 			return
@@ -3,6 +3,7 @@
 package libpod
 
 import (
+	"context"
 	"fmt"
 	"io/fs"
 	"os"
@@ -16,6 +17,7 @@ import (
 	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/libpod/shutdown"
 	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/containers/podman/v6/pkg/tracing"
 	"github.com/cyphar/filepath-securejoin/pathrs-lite"
 	"github.com/moby/sys/capability"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
@@ -57,7 +59,7 @@ func (c *Container) unmountSHM(mount string) error {
 
 // prepare mounts the container and sets up other required resources like net
 // namespaces
-func (c *Container) prepare() error {
+func (c *Container) prepare(ctx context.Context) error {
 	var (
 		wg                              sync.WaitGroup
 		netNS                           string
@@ -90,7 +92,9 @@ func (c *Container) prepare() error {
 				return
 			}
 
+			_, span := tracing.Start(ctx, "libpod.network.setup", tracing.ContainerIDKey.String(c.ID()))
 			netNS, networkStatus, createNetNSErr = c.runtime.createNetNS(c)
+			tracing.End(span, createNetNSErr)
 			if createNetNSErr != nil {
 				return
 			}
@@ -106,7 +110,9 @@ func (c *Container) prepare() error {
 	// Mount storage if not mounted
 	go func() {
 		defer wg.Done()
+		_, span := tracing.Start(ctx, "libpod.storage.mount", tracing.ContainerIDKey.String(c.ID()))
 		mountPoint, mountStorageErr = c.mountStorage()
+		tracing.End(span, mountStorageErr)
 
 		if mountStorageErr != nil {
 			return
@@ -3,10 +3,12 @@
 package libpod
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"os"
+	"text/template"
 	"time"
 
 	"github.com/containers/podman/v6/libpod/define"
@@ -21,7 +23,7 @@ import (
 var logDrivers []string
 
 func init() {
-	logDrivers = append(logDrivers, define.KubernetesLogging, define.NoLogging, define.PassthroughLogging)
+	logDrivers = append(logDrivers, define.KubernetesLogging, define.NoLogging, define.PassthroughLogging, define.SyslogLogging, define.GELFLogging, define.FluentdLogging)
 }
 
 // Log is a runtime function that can read one or more container logs.
@@ -51,6 +53,9 @@ func (c *Container) ReadLog(ctx context.Context, options *logs.LogOptions, logCh
 		// TODO provide a separate implementation of this when Conmon
 		// has support.
 		fallthrough
+	case define.SyslogLogging, define.GELFLogging, define.FluentdLogging:
+		// Shipping log drivers keep a local copy of the log.
+		fallthrough
 	case define.KubernetesLogging, "":
 		return c.readFromLogFile(ctx, options, logChannel, colorID)
 	default:
@@ -87,6 +92,30 @@ func (c *Container) readFromLogFile(ctx context.Context, options *logs.LogOption
 			}
 		}
 		defer options.WaitGroup.Done()
+		if options.Tail < 0 {
+			err := logs.ReadSegments(c.LogPath(), options.Since, func(nll *logs.LogLine) error {
+				nll.CID = c.ID()
+				nll.CName = c.Name()
+				nll.ColorID = colorID
+				if !nll.Since(options.Since) || !nll.Until(options.Until) {
+					return nil
+				}
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case logChannel <- nll:
+					return nil
+				}
+			})
+			if err != nil {
+				if ctx.Err() != nil {
+					// the consumer has cancelled
+					t.Kill(errors.New("hangup by client"))
+					return
+				}
+				logrus.Errorf("Reading rotated log files of container %s: %v", c.ID(), err)
+			}
+		}
 		var line *tail.Line
 		var ok bool
 		for {
@@ -153,3 +182,52 @@ func (c *Container) readFromLogFile(ctx context.Context, options *logs.LogOption
 	}
 	return nil
 }
+
+// logTagAndLabels returns the log tag and labels of the container with their
+// templates expanded.  The container must be locked.
+func (c *Container) logTagAndLabels() (string, map[string]string, error) {
+	logTag := c.LogTag()
+	logLabels := c.LogLabels()
+
+	// inspectLocked is expensive, skip it if possible
+	if logTag == "" && len(logLabels) == 0 {
+		return "", nil, nil
+	}
+
+	data, err := c.inspectLocked(false)
+	if err != nil {
+		// FIXME: this error should probably be returned
+		return "", nil, nil //nolint: nilerr
+	}
+
+	var parsedLogTag string
+	if logTag != "" {
+		tmpl, err := template.New("container").Parse(logTag)
+		if err != nil {
+			return "", nil, fmt.Errorf("template parsing error %s: %w", logTag, err)
+		}
+		var b bytes.Buffer
+		err = tmpl.Execute(&b, data)
+		if err != nil {
+			return "", nil, err
+		}
+		parsedLogTag = b.String()
+	}
+
+	parsedLogLabels := make(map[string]string)
+	for labelKey, labelValue := range logLabels {
+		tmpl, err := template.New("container").Parse(labelValue)
+		if err != nil {
+			return "", nil, fmt.Errorf("template parsing error %s (label %s): %w", labelValue, labelKey, err)
+		}
+
+		var b bytes.Buffer
+		err = tmpl.Execute(&b, data)
+		if err != nil {
+			return "", nil, err
+		}
+		parsedLogLabels[labelKey] = b.String()
+	}
+
+	return parsedLogTag, parsedLogLabels, nil
+}
@@ -0,0 +1,210 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/libpod/logs"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/storage/pkg/ioutils"
+	"go.podman.io/storage/pkg/lockfile"
+)
+
+// logRotationInterval is how often the log files of running containers are
+// checked for rotation.
+const logRotationInterval = "30s"
+
+// logRotationThreshold is the percentage of the maximum log size at which the
+// log file is rotated.  Conmon truncates the log file once it reaches the
+// maximum size, the log file is rotated before that happens.
+const logRotationThreshold = 90
+
+// rotatesLog returns whether Podman rotates the container's log file, keeping
+// rotated files around.
+func (c *Container) rotatesLog() bool {
+	switch c.config.LogDriver {
+	case define.KubernetesLogging, define.JSONLogging, "":
+	default:
+		return false
+	}
+	return c.config.LogMaxFiles > 1 && c.LogSizeMax() > 0
+}
+
+// RotateLog rotates the container's log file if it reached its maximum size
+// and removes rotated log files according to the container's log options.
+func (c *Container) RotateLog() error {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+
+	if !c.rotatesLog() {
+		return fmt.Errorf("container %s does not use log rotation: %w", c.ID(), define.ErrInvalidArg)
+	}
+	return c.rotateLog()
+}
+
+// rotateLog rotates the container's log file once it reached the rotation
+// threshold and makes conmon reopen it if the container is running.
+func (c *Container) rotateLog() error {
+	if !c.rotatesLog() {
+		return nil
+	}
+	rotated, err := logs.Rotate(c.LogPath(), logs.RotateOptions{
+		MaxSize:  c.LogSizeMax() * logRotationThreshold / 100,
+		MaxFiles: c.config.LogMaxFiles,
+		Compress: c.config.LogCompress,
+		MaxAge:   c.config.LogMaxAge,
+	})
+	if err != nil {
+		return fmt.Errorf("rotating log file of container %s: %w", c.ID(), err)
+	}
+	if !rotated || !c.ensureState(define.ContainerStateRunning, define.ContainerStatePaused) {
+		return nil
+	}
+	return c.ociRuntime.ReopenContainerLog(c)
+}
+
+// registerLogRotation adds the container to the containers whose log files
+// are rotated by the shared log rotation timer and makes sure the timer runs.
+func (c *Container) registerLogRotation() error {
+	if !c.rotatesLog() || !logRotationTimerSupported() {
+		return nil
+	}
+	return c.runtime.updateLogRotation(func(ids map[string]struct{}) {
+		ids[c.ID()] = struct{}{}
+	})
+}
+
+// unregisterLogRotation removes the container from the containers whose log
+// files are rotated by the shared log rotation timer.  The timer is stopped
+// once no container is left.
+func (c *Container) unregisterLogRotation() error {
+	if !c.rotatesLog() || !logRotationTimerSupported() {
+		return nil
+	}
+	return c.runtime.updateLogRotation(func(ids map[string]struct{}) {
+		delete(ids, c.ID())
+	})
+}
+
+// RotateLogs rotates the log files of all containers registered for log
+// rotation.  Containers that no longer exist or are no longer running are
+// removed from the registry.
+func (r *Runtime) RotateLogs() error {
+	if !r.valid {
+		return define.ErrRuntimeStopped
+	}
+
+	// The registry is not locked while rotating, rotating takes the
+	// container locks.
+	ids, err := readLogRotationRegistry(r.logRotationRegistryPath())
+	if err != nil {
+		return err
+	}
+
+	stale := make(map[string]struct{})
+	for id := range ids {
+		ctr, err := r.state.Container(id)
+		if err != nil {
+			if !errors.Is(err, define.ErrNoSuchCtr) {
+				logrus.Errorf("Looking up container %s for log rotation: %v", id, err)
+				continue
+			}
+			stale[id] = struct{}{}
+			continue
+		}
+		state, err := ctr.State()
+		if err != nil {
+			logrus.Errorf("Getting state of container %s: %v", id, err)
+			continue
+		}
+		if state != define.ContainerStateRunning && state != define.ContainerStatePaused {
+			stale[id] = struct{}{}
+			continue
+		}
+		if err := ctr.RotateLog(); err != nil {
+			logrus.Errorf("Rotating log file of container %s: %v", id, err)
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+
+	return r.updateLogRotation(func(registered map[string]struct{}) {
+		for id := range stale {
+			delete(registered, id)
+		}
+	})
+}
+
+// logRotationRegistryPath returns the path of the file listing the IDs of
+// the containers whose log files are rotated by the shared timer.
+func (r *Runtime) logRotationRegistryPath() string {
+	return filepath.Join(r.config.Engine.TmpDir, "log-rotate")
+}
+
+// readLogRotationRegistry returns the IDs of the containers listed in the
+// log rotation registry at path.  The registry is written atomically and can
+// be read without holding its lock.
+func readLogRotationRegistry(path string) (map[string]struct{}, error) {
+	ids := make(map[string]struct{})
+	content, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("reading log rotation registry: %w", err)
+	}
+	for _, id := range strings.Fields(string(content)) {
+		ids[id] = struct{}{}
+	}
+	return ids, nil
+}
+
+// updateLogRotation calls update with the IDs of the containers registered
+// for log rotation and writes the updated registry.  The shared log rotation
+// timer is started if containers are registered and stopped otherwise.  The
+// registry is locked until the timer is updated so that concurrent updates
+// do not race.
+func (r *Runtime) updateLogRotation(update func(ids map[string]struct{})) error {
+	path := r.logRotationRegistryPath()
+	lock, err := lockfile.GetLockFile(path + ".lock")
+	if err != nil {
+		return fmt.Errorf("getting log rotation lock: %w", err)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	ids, err := readLogRotationRegistry(path)
+	if err != nil {
+		return err
+	}
+	before := len(ids)
+
+	update(ids)
+
+	var b strings.Builder
+	for id := range ids {
+		b.WriteString(id)
+		b.WriteByte('\n')
+	}
+	if err := ioutils.AtomicWriteFile(path, []byte(b.String()), 0o600); err != nil {
+		return fmt.Errorf("writing log rotation registry: %w", err)
+	}
+
+	if len(ids) > 0 {
+		return ensureLogRotationTimer()
+	}
+	if before > 0 {
+		return stopLogRotationTimer()
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+//go:build !remote && (linux || freebsd) && !systemd
+
+package libpod
+
+// logRotationTimerSupported returns whether the log files of running
+// containers can be rotated by a systemd timer.
+func logRotationTimerSupported() bool {
+	return false
+}
+
+// ensureLogRotationTimer creates and starts the shared log rotation timer
+// unless it is already active.
+func ensureLogRotationTimer() error {
+	return nil
+}
+
+// stopLogRotationTimer stops the shared log rotation timer.
+func stopLogRotationTimer() error {
+	return nil
+}
@@ -0,0 +1,78 @@
+//go:build !remote && systemd
+
+package libpod
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman/v6/pkg/systemd"
+	systemdCommon "go.podman.io/common/pkg/systemd"
+)
+
+// logRotationUnitName is the name of the systemd timer and service that
+// rotate the log files of all running containers.
+const logRotationUnitName = "podman-log-rotate"
+
+// logRotationTimerSupported returns whether the log files of running
+// containers can be rotated by a systemd timer.
+func logRotationTimerSupported() bool {
+	return systemdCommon.RunsOnSystemd()
+}
+
+// ensureLogRotationTimer creates and starts the shared log rotation timer
+// unless it is already active.
+func ensureLogRotationTimer() error {
+	active, err := logRotationTimerActive()
+	if err != nil || active {
+		return err
+	}
+	timerOptions := []string{"--on-active=" + logRotationInterval, "--on-unit-inactive=" + logRotationInterval}
+	return createTransientTimer(logRotationUnitName, timerOptions, "container", "rotate-log", "--all")
+}
+
+// logRotationTimerActive returns whether the shared log rotation timer is
+// active.
+func logRotationTimerActive() (bool, error) {
+	conn, err := systemd.ConnectToDBUS()
+	if err != nil {
+		return false, fmt.Errorf("unable to get systemd connection for log rotation timer: %w", err)
+	}
+	defer conn.Close()
+
+	units, err := conn.ListUnitsByNamesContext(context.Background(), []string{logRotationUnitName + ".timer"})
+	if err != nil {
+		return false, fmt.Errorf("listing log rotation timer: %w", err)
+	}
+	for _, unit := range units {
+		if unit.ActiveState == "active" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// stopLogRotationTimer stops the shared log rotation timer.  Only the timer is
+// stopped: the service may be the caller if it removed the last container
+// from the registry.
+func stopLogRotationTimer() error {
+	conn, err := systemd.ConnectToDBUS()
+	if err != nil {
+		return fmt.Errorf("unable to get systemd connection for log rotation timer: %w", err)
+	}
+	defer conn.Close()
+
+	unit := logRotationUnitName + ".timer"
+	stopChan := make(chan string)
+	if _, err := conn.StopUnitContext(context.Background(), unit, "ignore-dependencies", stopChan); err != nil {
+		if strings.HasSuffix(err.Error(), " not loaded.") {
+			return nil
+		}
+		return fmt.Errorf("removing unit %q: %w", unit, err)
+	}
+	if err := systemdOpSuccessful(stopChan); err != nil {
+		return fmt.Errorf("stopping unit %q: %w", unit, err)
+	}
+	return nil
+}
@@ -0,0 +1,297 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/libpod/events"
+	"github.com/containers/podman/v6/libpod/logs"
+	"github.com/containers/podman/v6/libpod/logs/shipper"
+	"github.com/containers/podman/v6/pkg/specgenutil"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/storage/pkg/lockfile"
+)
+
+const (
+	// logShipBatchSize is the maximum number of messages shipped at once.
+	logShipBatchSize = 100
+	// logShipMaxRetryDelay is the maximum delay between two attempts to
+	// ship logs to an unreachable log server.
+	logShipMaxRetryDelay = time.Minute
+)
+
+// logShipLock returns the lock held by the process shipping the container's
+// logs.
+func (c *Container) logShipLock() (*lockfile.LockFile, error) {
+	return lockfile.GetLockFile(filepath.Join(c.config.StaticDir, "logship.lock"))
+}
+
+// logShipCursorPath returns the path of the file recording the time of the
+// last shipped message.
+func (c *Container) logShipCursorPath() string {
+	return filepath.Join(c.config.StaticDir, "logship.cursor")
+}
+
+// logShipperRunning returns whether a process is shipping the container's
+// logs.
+func (c *Container) logShipperRunning() (bool, error) {
+	shipLock, err := c.logShipLock()
+	if err != nil {
+		return false, err
+	}
+	// The lock is held by the running shipper.
+	if shipLock.TryLock() != nil {
+		return true, nil
+	}
+	shipLock.Unlock()
+	return false, nil
+}
+
+// startLogShipper starts a process that ships the container's logs to the
+// remote log server of its log driver.  The process runs in the background
+// until the container exits and all logs are shipped.  It is supervised by
+// the cleanup process of the container, see superviseLogShipper, and exits
+// once the container was removed.
+func (c *Container) startLogShipper() error {
+	if !shipper.IsShippingDriver(c.LogDriver()) {
+		return nil
+	}
+	if err := c.execLogShipper(); err != nil {
+		c.newContainerErrorEvent(events.LogShipError, err)
+		return err
+	}
+	return nil
+}
+
+// execLogShipper executes the process shipping the container's logs.
+func (c *Container) execLogShipper() error {
+	// Reuse the global options of the exit command so that the process
+	// finds the container.
+	exitCommand, err := specgenutil.CreateExitCommandArgs(c.runtime.storageConfig, c.runtime.config, c.runtime.syslog || logrus.IsLevelEnabled(logrus.DebugLevel), false, false, false)
+	if err != nil {
+		return fmt.Errorf("creating log shipper command: %w", err)
+	}
+	args := exitCommand[:slices.Index(exitCommand, "container")]
+	args = append(args, "container", "ship-logs", c.ID())
+
+	logrus.Debugf("Starting log shipper for container %s: %s", c.ID(), args)
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("starting log shipper: %w", err)
+	}
+	// Reap the process if we are a long running service.
+	go func() {
+		_ = cmd.Wait()
+	}()
+	return nil
+}
+
+// superviseLogShipper restarts the process shipping the container's logs if
+// it exited before all logs were shipped, e.g. because it was killed.  It is
+// called when the container is cleaned up after it exited.
+func (c *Container) superviseLogShipper() {
+	if !shipper.IsShippingDriver(c.LogDriver()) {
+		return
+	}
+	running, err := c.logShipperRunning()
+	if err != nil {
+		logrus.Errorf("Checking log shipper of container %s: %v", c.ID(), err)
+		return
+	}
+	if running {
+		return
+	}
+	if shipped, err := c.logsShipped(); err != nil || shipped {
+		return
+	}
+	logrus.Warnf("Log shipper of container %s exited before shipping all logs, restarting it", c.ID())
+	if err := c.startLogShipper(); err != nil {
+		logrus.Errorf("Shipping logs of container %s: %v", c.ID(), err)
+	}
+}
+
+// logsShipped returns whether the log file was not written to after the last
+// shipped message.
+func (c *Container) logsShipped() (bool, error) {
+	info, err := os.Stat(c.LogPath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return true, nil
+		}
+		return false, err
+	}
+	content, err := os.ReadFile(c.logShipCursorPath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return info.Size() == 0, nil
+		}
+		return false, err
+	}
+	cursor, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(string(content)))
+	if err != nil {
+		return false, nil //nolint:nilerr // An invalid cursor ships all logs.
+	}
+	return !info.ModTime().After(cursor), nil
+}
+
+// ShipLogs ships the container's logs to the remote log server of its log
+// driver.  The logs are read from the container's local log file, which
+// buffers them while the log server is unreachable.  The time of the last
+// shipped message is recorded so that shipping resumes where it stopped.  It
+// returns once the container exited and all logs were shipped.  Failures are
+// reported as log-ship-error events.
+func (c *Container) ShipLogs(ctx context.Context) error {
+	if !shipper.IsShippingDriver(c.LogDriver()) {
+		return fmt.Errorf("container %s log driver %q does not ship logs: %w", c.ID(), c.LogDriver(), define.ErrInvalidArg)
+	}
+	if err := c.shipLogs(ctx); err != nil {
+		c.newContainerErrorEvent(events.LogShipError, err)
+		return err
+	}
+	return nil
+}
+
+func (c *Container) shipLogs(ctx context.Context) error {
+	// Only ship logs once, a shipper of a previous run might still be
+	// waiting for the log server.
+	shipLock, err := c.logShipLock()
+	if err != nil {
+		return err
+	}
+	shipLock.Lock()
+	defer shipLock.Unlock()
+
+	c.lock.Lock()
+	if err := c.syncContainer(); err != nil {
+		c.lock.Unlock()
+		return err
+	}
+	tag, labels, err := c.logTagAndLabels()
+	c.lock.Unlock()
+	if err != nil {
+		return err
+	}
+	if tag == "" {
+		tag = c.ID()[:12]
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		logrus.Debugf("Failed to get hostname: %v", err)
+	}
+	_, imageName := c.Image()
+	s, err := shipper.New(c.LogDriver(), c.config.LogDriverOptions, shipper.Info{
+		ID:        c.ID(),
+		Name:      c.Name(),
+		ImageName: imageName,
+		Hostname:  hostname,
+		Tag:       tag,
+		Labels:    labels,
+	})
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	cursorPath := c.logShipCursorPath()
+	var since time.Time
+	if content, err := os.ReadFile(cursorPath); err == nil {
+		if since, err = time.Parse(time.RFC3339Nano, strings.TrimSpace(string(content))); err != nil {
+			logrus.Warnf("Ignoring invalid log shipping cursor %s: %v", cursorPath, err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	logChannel := make(chan *logs.LogLine, logShipBatchSize)
+	options := &logs.LogOptions{Follow: true, Since: since, Tail: -1, WaitGroup: &wg}
+	if err := c.ReadLog(ctx, options, logChannel, 0); err != nil {
+		return err
+	}
+	go func() {
+		wg.Wait()
+		close(logChannel)
+	}()
+
+	var (
+		partial strings.Builder
+		msgs    []shipper.Message
+		last    time.Time
+	)
+	addLine := func(line *logs.LogLine) {
+		partial.WriteString(line.Msg)
+		if line.Partial() {
+			return
+		}
+		msgs = append(msgs, shipper.Message{Time: line.Time, Stream: line.Device, Msg: partial.String()})
+		partial.Reset()
+		last = line.Time
+	}
+	for line := range logChannel {
+		addLine(line)
+	drain:
+		for len(msgs) < logShipBatchSize {
+			select {
+			case line, ok := <-logChannel:
+				if !ok {
+					break drain
+				}
+				addLine(line)
+			default:
+				break drain
+			}
+		}
+		if len(msgs) == 0 {
+			continue
+		}
+		if err := c.shipLogMessages(ctx, s, msgs); err != nil {
+			return err
+		}
+		msgs = msgs[:0]
+		if err := os.WriteFile(cursorPath, []byte(last.Format(time.RFC3339Nano)+"\n"), 0o600); err != nil && !errors.Is(err, os.ErrNotExist) {
+			logrus.Errorf("Recording shipped logs of container %s: %v", c.ID(), err)
+		}
+	}
+	if partial.Len() > 0 {
+		// The log ended with a partial line.
+		return c.shipLogMessages(ctx, s, []shipper.Message{{Time: time.Now(), Stream: "stdout", Msg: partial.String()}})
+	}
+	return nil
+}
+
+// shipLogMessages ships the messages, retrying until the log server is
+// reachable or the container was removed.  The first failure of an attempt is
+// reported as a log-ship-error event.
+func (c *Container) shipLogMessages(ctx context.Context, s shipper.Shipper, msgs []shipper.Message) error {
+	delay := time.Second
+	for {
+		err := s.Ship(msgs)
+		if err == nil {
+			return nil
+		}
+		if exists, _ := c.runtime.state.HasContainer(c.ID()); !exists {
+			return fmt.Errorf("container %s was removed, dropping %d log messages: %w", c.ID(), len(msgs), err)
+		}
+		if delay == time.Second {
+			c.newContainerErrorEvent(events.LogShipError, fmt.Errorf("shipping logs, retrying: %w", err))
+		}
+		logrus.Warnf("Shipping logs of container %s, retrying in %s: %v", c.ID(), delay, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, logShipMaxRetryDelay)
+	}
+}
@@ -0,0 +1,27 @@
+//go:build !remote && (linux || freebsd) && !systemd
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// restartBackoffTimerSupported returns whether the restart of a container in
+// restart backoff can be scheduled with a systemd timer.
+func restartBackoffTimerSupported() bool {
+	return false
+}
+
+// createRestartBackoffTimer creates a transient systemd timer that cleans up,
+// and thereby restarts, the container once the delay expired.
+func (c *Container) createRestartBackoffTimer(_ time.Duration) (string, error) {
+	return "", errors.New("restart timers require systemd")
+}
+
+// removeRestartBackoffTimer stops and removes the restart timer of a
+// container.
+func removeRestartBackoffTimer(_ context.Context, _ string) error {
+	return nil
+}
@@ -0,0 +1,40 @@
+//go:build !remote && systemd
+
+package libpod
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	systemdCommon "go.podman.io/common/pkg/systemd"
+)
+
+// restartBackoffTimerSupported returns whether the restart of a container in
+// restart backoff can be scheduled with a systemd timer.
+func restartBackoffTimerSupported() bool {
+	return systemdCommon.RunsOnSystemd()
+}
+
+// createRestartBackoffTimer creates a transient systemd timer that cleans up,
+// and thereby restarts, the container once the delay expired. It returns the
+// name of the timer unit.
+func (c *Container) createRestartBackoffTimer(delay time.Duration) (string, error) {
+	// The unit of the previous backoff may still be running when the
+	// container exits again, so use a unique name.
+	unitName := fmt.Sprintf("%s-restart-%x", c.ID(), rand.Int())
+	// The timer elapses at the earliest after the delay, so the backoff
+	// has expired when the cleanup runs.
+	timerOptions := []string{"--on-active=" + delay.String(), "--timer-property=AccuracySec=1s"}
+	if err := createTransientTimer(unitName, timerOptions, "container", "cleanup", c.ID()); err != nil {
+		return "", err
+	}
+	return unitName, nil
+}
+
+// removeRestartBackoffTimer stops and removes the restart timer of a
+// container.
+func removeRestartBackoffTimer(ctx context.Context, unitName string) error {
+	return removeTransientTimer(ctx, unitName)
+}
@@ -0,0 +1,20 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v6/libpod/define"
+)
+
+// remountSecret mounts the secret file src over the mounts of the secret in
+// the running container.
+func (c *Container) remountSecret(src string) error {
+	for _, source := range c.state.BindMounts {
+		if source == src {
+			return fmt.Errorf("updating secrets of running containers: %w", define.ErrNotImplemented)
+		}
+	}
+	return nil
+}
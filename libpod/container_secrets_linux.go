@@ -0,0 +1,87 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	"golang.org/x/sys/unix"
+)
+
+// remountSecret mounts the secret file src over the mounts of the secret in
+// the running container. The mounts keep showing the file src replaced, so
+// without this the container would not see the new data.
+func (c *Container) remountSecret(src string) error {
+	var dests []string
+	for dest, source := range c.state.BindMounts {
+		if source == src {
+			dests = append(dests, dest)
+		}
+	}
+	if len(dests) == 0 {
+		return nil
+	}
+
+	attr := &unix.MountAttr{}
+	if c.IsReadOnly() {
+		attr.Attr_set = unix.MOUNT_ATTR_RDONLY | unix.MOUNT_ATTR_NOSUID | unix.MOUNT_ATTR_NOEXEC | unix.MOUNT_ATTR_NODEV
+	}
+	// Clone the mounts in our mount namespace, where src is visible, and
+	// move them into the container's mount namespace.
+	treeFDs := make([]int, 0, len(dests))
+	defer func() {
+		for _, fd := range treeFDs {
+			unix.Close(fd)
+		}
+	}()
+	for range dests {
+		fd, err := unix.OpenTree(unix.AT_FDCWD, src, unix.OPEN_TREE_CLONE|unix.OPEN_TREE_CLOEXEC)
+		if err != nil {
+			return fmt.Errorf("cloning mount of %s: %w", src, err)
+		}
+		treeFDs = append(treeFDs, fd)
+		if attr.Attr_set != 0 {
+			if err := unix.MountSetattr(fd, "", unix.AT_EMPTY_PATH, attr); err != nil {
+				return fmt.Errorf("setting mount attributes of %s: %w", src, err)
+			}
+		}
+	}
+
+	nsPath, err := c.namespacePath(MountNS)
+	if err != nil {
+		return err
+	}
+	mountFD, err := os.Open(nsPath)
+	if err != nil {
+		return err
+	}
+	defer mountFD.Close()
+
+	errChan := make(chan error)
+	go func() {
+		// The thread is left in the container's mount namespace, so
+		// it must not be reused.
+		runtime.LockOSThread()
+
+		if err := unix.Unshare(unix.CLONE_FS); err != nil {
+			errChan <- err
+			return
+		}
+		if err := unix.Setns(int(mountFD.Fd()), unix.CLONE_NEWNS); err != nil {
+			errChan <- err
+			return
+		}
+		for i, dest := range dests {
+			// The replaced mount stays below the new one, it
+			// goes away with the container's mount namespace.
+			if err := unix.MoveMount(treeFDs[i], "", unix.AT_FDCWD, dest, unix.MOVE_MOUNT_F_EMPTY_PATH); err != nil {
+				errChan <- fmt.Errorf("mounting %s on %s: %w", src, dest, err)
+				return
+			}
+		}
+		errChan <- nil
+	}()
+	return <-errChan
+}
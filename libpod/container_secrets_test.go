@@ -6,6 +6,7 @@ import (
 	"slices"
 	"testing"
 
+	"github.com/containers/podman/v6/pkg/secrets/vaultdriver"
 	"github.com/opencontainers/runtime-tools/generate"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -102,3 +103,22 @@ func TestInjectEnvSecrets(t *testing.T) {
 		})
 	}
 }
+
+func TestVaultSecretsOnDemand(t *testing.T) {
+	secretsDir := t.TempDir()
+	manager, err := secrets.NewManager(secretsDir)
+	require.NoError(t, err)
+
+	// Vault secrets are stored through the shell driver running the
+	// vault driver helper, true stands in for podman here.
+	shellOpts, err := vaultdriver.ShellOptions("true", map[string]string{"address": "http://vault:8200", "path": "myapp/db"})
+	require.NoError(t, err)
+	_, err = manager.Store("db", []byte("myapp/db"), "shell", secrets.StoreOptions{DriverOpts: shellOpts})
+	require.NoError(t, err)
+	secret, err := manager.Lookup("db")
+	require.NoError(t, err)
+	assert.True(t, isOnDemandSecret(&ContainerSecret{Secret: secret}))
+
+	assert.False(t, isOnDemandSecret(&ContainerSecret{Secret: &secrets.Secret{Driver: "file"}}))
+	assert.False(t, isOnDemandSecret(&ContainerSecret{Secret: &secrets.Secret{Driver: "shell", DriverOptions: map[string]string{"lookup": "cat /tmp/secret"}}}))
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/libpod/logs/shipper"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"go.podman.io/common/pkg/libartifact"
 	"go.podman.io/image/v5/docker"
@@ -179,8 +180,27 @@ func (c *Container) validate() error {
 		return fmt.Errorf("default rootfs-based infra container is set for non-infra container")
 	}
 
-	if c.config.LogTag != "" && c.config.LogDriver != define.JournaldLogging {
-		return fmt.Errorf("log tags can only be used with the journald log driver but driver is %q: %w", c.config.LogDriver, define.ErrInvalidArg)
+	if c.config.LogTag != "" && c.config.LogDriver != define.JournaldLogging && !shipper.IsShippingDriver(c.config.LogDriver) {
+		return fmt.Errorf("log tags can only be used with the journald, syslog, gelf and fluentd log drivers but driver is %q: %w", c.config.LogDriver, define.ErrInvalidArg)
+	}
+
+	if shipper.IsShippingDriver(c.config.LogDriver) {
+		if err := shipper.ValidateOptions(c.config.LogDriver, c.config.LogDriverOptions); err != nil {
+			return fmt.Errorf("%w: %w", err, define.ErrInvalidArg)
+		}
+	} else if len(c.config.LogDriverOptions) > 0 {
+		return fmt.Errorf("log driver %q does not support the log options of remote log drivers: %w", c.config.LogDriver, define.ErrInvalidArg)
+	}
+
+	if c.config.LogMaxFiles > 0 || c.config.LogCompress || c.config.LogMaxAge > 0 {
+		switch c.config.LogDriver {
+		case define.KubernetesLogging, define.JSONLogging, "":
+		default:
+			return fmt.Errorf("log rotation can only be used with the %s and %s log drivers but driver is %q: %w", define.KubernetesLogging, define.JSONLogging, c.config.LogDriver, define.ErrInvalidArg)
+		}
+		if c.LogSizeMax() <= 0 {
+			return fmt.Errorf("log rotation requires a maximum log size: %w", define.ErrInvalidArg)
+		}
 	}
 
 	if len(c.config.ArtifactVolumes) > 0 {
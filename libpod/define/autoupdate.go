@@ -7,3 +7,19 @@ const AutoUpdateLabel = "io.containers.autoupdate"
 // AutoUpdateAuthfileLabel denotes the container label key to specify authfile
 // in container labels.
 const AutoUpdateAuthfileLabel = "io.containers.autoupdate.authfile"
+
+// AutoUpdateHealthTimeoutLabel denotes the container label key to specify
+// how long to wait for an updated container to become healthy.
+const AutoUpdateHealthTimeoutLabel = "io.containers.autoupdate.health-timeout"
+
+// AutoUpdateStageLabel denotes the container label key to specify the stage
+// in which the container's systemd unit gets updated.
+const AutoUpdateStageLabel = "io.containers.autoupdate.stage"
+
+// AutoUpdateSignaturePolicyLabel denotes the container label key to specify
+// the signature policy (policy.json) new images must satisfy.
+const AutoUpdateSignaturePolicyLabel = "io.containers.autoupdate.signature-policy"
+
+// AutoUpdateSemverLabel denotes the container label key to specify a semantic
+// version range, e.g. 1.4.x, to update the image to the highest matching tag.
+const AutoUpdateSemverLabel = "io.containers.autoupdate.semver"
@@ -41,6 +41,14 @@ const VolumeDriverLocal = "local"
 // uses volumes backed by an image.
 const VolumeDriverImage = "image"
 
+// VolumeQuotaProject limits the size of a local volume with a project quota
+// on the filesystem holding the volumes. Setting project quotas requires root.
+const VolumeQuotaProject = "project"
+
+// VolumeQuotaLoop limits the size of a local volume by backing it with a
+// filesystem image of the given size. It is available to rootless users.
+const VolumeQuotaLoop = "loop"
+
 const (
 	OCIManifestDir  = "oci-dir"
 	OCIArchive      = "oci-archive"
@@ -85,6 +93,15 @@ const PassthroughLogging = "passthrough"
 // PassthroughTTYLogging is the string conmon expects when specifying to use the passthrough driver even on a tty.
 const PassthroughTTYLogging = "passthrough-tty"
 
+// SyslogLogging is the log driver that ships logs to a syslog server
+const SyslogLogging = "syslog"
+
+// GELFLogging is the log driver that ships logs to a Graylog server
+const GELFLogging = "gelf"
+
+// FluentdLogging is the log driver that ships logs to a Fluentd server
+const FluentdLogging = "fluentd"
+
 // DefaultRlimitValue is the value set by default for nofile and nproc
 const RLimitDefaultValue = uint64(1048576)
 
@@ -2,6 +2,7 @@ package define
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -43,6 +44,19 @@ func ValidateRestartPolicy(policy string) error {
 	}
 }
 
+// Restart backoff defaults and status.
+const (
+	// DefaultRestartMaxDelay is the default upper bound of the delay
+	// between two restarts of a container with a restart backoff.
+	DefaultRestartMaxDelay = 5 * time.Minute
+	// DefaultRestartResetWindow is the default time a container with a
+	// restart backoff must run before its backoff is reset.
+	DefaultRestartResetWindow = 10 * time.Minute
+	// ContainerStateBackoff is the status reported for a stopped container
+	// waiting for its restart backoff to expire.
+	ContainerStateBackoff = "backoff"
+)
+
 // InitContainerTypes
 const (
 	// AlwaysInitContainer is an init container that runs on each
@@ -65,6 +79,10 @@ const (
 	K8sKindDaemonSet = "daemonset"
 	// a Job kube yaml spec
 	K8sKindJob = "job"
+	// A StatefulSet kube yaml spec
+	K8sKindStatefulSet = "statefulset"
+	// A CronJob kube yaml spec
+	K8sKindCronJob = "cronjob"
 )
 
 type WeightDevice struct {
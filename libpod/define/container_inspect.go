@@ -61,6 +61,8 @@ type InspectContainerConfig struct {
 	StartupHealthCheck *StartupHealthCheck `json:"StartupHealthCheck,omitempty"`
 	// Configured healthcheck for the container
 	Healthcheck *manifest.Schema2HealthConfig `json:"Healthcheck,omitempty"`
+	// Configured readiness check for the container
+	ReadinessCheck *ReadinessCheck `json:"ReadinessCheck,omitempty"`
 	// HealthcheckOnFailureAction defines an action to take once the container turns unhealthy.
 	HealthcheckOnFailureAction string `json:"HealthcheckOnFailureAction,omitempty"`
 	// HealthLogDestination defines the destination where the log is stored
@@ -188,6 +190,15 @@ type InspectRestartPolicy struct {
 	// "on-failure" restart policy is in use. Not used if "on-failure" is
 	// not set.
 	MaximumRetryCount uint `json:"MaximumRetryCount"`
+	// Delay is the initial delay before the container is restarted. It
+	// doubles on every consecutive restart, up to MaxDelay. Not set if the
+	// container is restarted immediately.
+	Delay string `json:"Delay,omitempty"`
+	// MaxDelay is the maximum delay between two restarts.
+	MaxDelay string `json:"MaxDelay,omitempty"`
+	// ResetWindow is the time the container must run before the delay is
+	// reset to its initial value.
+	ResetWindow string `json:"ResetWindow,omitempty"`
 }
 
 // InspectLogConfig holds information about a container's configured log driver
@@ -310,6 +321,8 @@ type InspectContainerState struct {
 	StartedAt      time.Time           `json:"StartedAt"`
 	FinishedAt     time.Time           `json:"FinishedAt"`
 	Health         *HealthCheckResults `json:"Health,omitempty"`
+	Ready          *bool               `json:"Ready,omitempty"`
+	Backoff        *InspectBackoff     `json:"Backoff,omitempty"`
 	Checkpointed   bool                `json:"Checkpointed,omitempty"`
 	CgroupPath     string              `json:"CgroupPath,omitempty"`
 	CheckpointedAt time.Time           `json:"CheckpointedAt"`
@@ -321,6 +334,15 @@ type InspectContainerState struct {
 	StoppedByUser  bool                `json:"StoppedByUser,omitempty"`
 }
 
+// InspectBackoff describes a pending restart of a container that is waiting
+// for its restart backoff to expire.
+type InspectBackoff struct {
+	// Delay is the delay applied before the pending restart.
+	Delay string `json:"Delay"`
+	// RestartAt is the time the container is restarted.
+	RestartAt time.Time `json:"RestartAt"`
+}
+
 // Healthcheck returns the HealthCheckResults. This is used for old podman compat
 // to make the "Healthcheck" key available in the go template.
 func (s *InspectContainerState) Healthcheck() *HealthCheckResults {
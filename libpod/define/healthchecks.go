@@ -23,6 +23,11 @@ const (
 	// HealthCheckStopped describes the time when container was stopped during HealthCheck
 	// and HealthCheck was terminated
 	HealthCheckStopped string = "stopped"
+	// ReadinessCheckReady describes a container whose readiness check passed
+	ReadinessCheckReady string = "ready"
+	// ReadinessCheckNotReady describes a container whose readiness check has
+	// not passed (yet)
+	ReadinessCheckNotReady string = "not ready"
 )
 
 // HealthCheckStatus represents the current state of a container
@@ -174,6 +179,18 @@ type StartupHealthCheck struct {
 	Successes int `json:",omitempty"`
 }
 
+// ReadinessCheck is the configuration of a readiness check.  A readiness
+// check runs on its own interval, independent of the regular healthcheck,
+// and decides whether the container is ready to serve without affecting
+// its health status.
+type ReadinessCheck struct {
+	manifest.Schema2HealthConfig
+	// Successes are the number of consecutive successes required to mark
+	// the container as ready.
+	// If set to 0, a single success will mark the container as ready.
+	Successes int `json:",omitempty"`
+}
+
 type UpdateHealthCheckConfig struct {
 	// HealthLogDestination set the destination of the HealthCheck log.
 	// Directory path, local or events_logger (local use container state file)
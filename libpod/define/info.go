@@ -166,4 +166,10 @@ type CPUUsage struct {
 	UserPercent   float64 `json:"userPercent"`
 	SystemPercent float64 `json:"systemPercent"`
 	IdlePercent   float64 `json:"idlePercent"`
+	// The ticks of CPU time spent in user mode, in system mode and idle
+	// since boot. The utilization over an interval is computed from two
+	// samples of them.
+	UserTicks   uint64 `json:"userTicks,omitempty"`
+	SystemTicks uint64 `json:"systemTicks,omitempty"`
+	IdleTicks   uint64 `json:"idleTicks,omitempty"`
 }
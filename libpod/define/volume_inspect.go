@@ -63,6 +63,18 @@ type InspectVolumeData struct {
 	StorageID string `json:"StorageID,omitempty"`
 	// LockNumber is the number of the volume's Libpod lock.
 	LockNumber uint32
+	// Usage is the disk usage of the volume. It is only set for volumes
+	// with a size limit.
+	Usage *VolumeUsage `json:"Usage,omitempty"`
+}
+
+// VolumeUsage is the disk usage of a volume.
+type VolumeUsage struct {
+	// Used is the number of bytes used by the contents of the volume.
+	Used uint64 `json:"Used"`
+	// Limit is the maximum size of the volume in bytes, 0 if the size of
+	// the volume is not limited.
+	Limit uint64 `json:"Limit"`
 }
 
 type VolumeReload struct {
@@ -5,11 +5,16 @@ package libpod
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/libpod/events"
+	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/containers/podman/v6/pkg/util"
 	"github.com/sirupsen/logrus"
+	"go.podman.io/storage/pkg/fileutils"
 )
 
 // newEventer returns an eventer that can be used to read/write events
@@ -23,9 +28,42 @@ func (r *Runtime) newEventer() (events.Eventer, error) {
 		LogFilePath:    r.config.Engine.EventsLogFilePath,
 		LogFileMaxSize: r.config.Engine.EventsLogMaxSize(),
 	}
+	if events.EventerType(r.config.Engine.EventsLogger) == events.Webhook {
+		path, err := webhookConfigPath()
+		if err != nil {
+			return nil, err
+		}
+		if options.Webhook, err = events.ReadWebhookOptions(path); err != nil {
+			return nil, err
+		}
+	}
 	return events.NewEventer(options)
 }
 
+// webhookConfigPath returns the path of the webhook events backend
+// configuration.  The CONTAINERS_EVENTS_WEBHOOK_CONF environment variable
+// takes precedence over the user and the system configuration.
+func webhookConfigPath() (string, error) {
+	if path, ok := os.LookupEnv("CONTAINERS_EVENTS_WEBHOOK_CONF"); ok {
+		return path, nil
+	}
+	paths := []string{}
+	if rootless.IsRootless() {
+		configHome, err := util.GetRootlessConfigHomeDir()
+		if err != nil {
+			return "", err
+		}
+		paths = append(paths, filepath.Join(configHome, "containers", events.WebhookConfigFile))
+	}
+	paths = append(paths, filepath.Join("/etc/containers", events.WebhookConfigFile))
+	for _, path := range paths {
+		if err := fileutils.Exists(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("the webhook events backend requires a configuration file, none of %s exists", strings.Join(paths, ", "))
+}
+
 // newContainerEvent creates a new event based on a container
 func (c *Container) newContainerEvent(status events.Status) {
 	if err := c.newContainerEventWithInspectData(status, define.HealthCheckResults{}, false); err != nil {
@@ -40,6 +78,24 @@ func (c *Container) newContainerHealthCheckEvent(healthCheckResult define.Health
 	}
 }
 
+// newContainerErrorEvent creates a new event with the given status that
+// reports an error of the container.
+func (c *Container) newContainerErrorEvent(status events.Status, err error) {
+	e := events.NewEvent(status)
+	e.ID = c.ID()
+	e.Name = c.Name()
+	e.Image = c.config.RootfsImageName
+	e.Type = events.Container
+	e.Error = err.Error()
+	e.Details = events.Details{
+		PodID:      c.PodID(),
+		Attributes: c.Labels(),
+	}
+	if err := c.runtime.eventer.Write(e); err != nil {
+		logrus.Errorf("Unable to write container event: %v", err)
+	}
+}
+
 // newContainerEventWithInspectData creates a new event and sets the
 // ContainerInspectData field if inspectData is set.
 func (c *Container) newContainerEventWithInspectData(status events.Status, healthCheckResult define.HealthCheckResults, inspectData bool) error {
@@ -17,8 +17,15 @@ const (
 	Journald EventerType = "journald"
 	// Null is a no-op events logger. It does not read or write events.
 	Null EventerType = "none"
+	// Webhook indicates events will be written to the log file and
+	// forwarded to an HTTP endpoint
+	Webhook EventerType = "webhook"
 )
 
+// WebhookConfigFile is the name of the configuration file of the webhook
+// events backend.
+const WebhookConfigFile = "events-webhook.conf"
+
 // Event describes the attributes of a libpod event
 type Event struct {
 	// ContainerExitCode is for storing the exit code of a container which can
@@ -76,9 +83,39 @@ type EventerOptions struct {
 	LogFilePath string
 	// LogFileMaxSize is the default limit used for rotating the log file
 	LogFileMaxSize uint64
+	// Webhook is the configuration of the webhook logger
+	Webhook *WebhookOptions
+}
+
+// WebhookOptions is the configuration of the webhook events backend as read
+// from the webhook configuration file.
+type WebhookOptions struct {
+	// URL is the HTTP(S) endpoint events are posted to.
+	URL string `toml:"url"`
+	// Format is the payload format, either "json" or "cloudevents".
+	Format string `toml:"format"`
+	// Headers are added to every request, e.g. for authentication.
+	Headers map[string]string `toml:"headers"`
+	// Filters select the events that are sent, using the syntax of
+	// podman events --filter.
+	Filters []string `toml:"filters"`
+	// BatchSize is the maximum number of events sent in one request.
+	BatchSize int `toml:"batch_size"`
+	// Timeout of a single request.
+	Timeout string `toml:"timeout"`
+	// RetryDelay is the delay before a failed delivery is retried.  It is
+	// doubled after each consecutive failure up to MaxRetryDelay.
+	RetryDelay string `toml:"retry_delay"`
+	// MaxRetryDelay caps the delay between retries.
+	MaxRetryDelay string `toml:"max_retry_delay"`
+	// SpoolPath is the file undelivered events are kept in.
+	SpoolPath string `toml:"spool_path"`
+	// SpoolMaxEvents is the maximum number of undelivered events to keep,
+	// the oldest events are dropped first.
+	SpoolMaxEvents int `toml:"spool_max_events"`
 }
 
-// Eventer is the interface for journald or file event logging
+// Eventer is the interface for journald, file or webhook event logging
 type Eventer interface {
 	// Write an event to a backend
 	Write(event Event) error
@@ -172,6 +209,9 @@ const (
 	Kill Status = "kill"
 	// LoadFromArchive ...
 	LoadFromArchive Status = "loadfromarchive"
+	// LogShipError indicates that the logs of a container could not be
+	// shipped to the log server of its log driver.
+	LogShipError Status = "log-ship-error"
 	// Mount ...
 	Mount Status = "mount"
 	// NetworkConnect
@@ -206,6 +246,9 @@ const (
 	Rotate Status = "log-rotation"
 	// Save ...
 	Save Status = "save"
+	// SecretRotate indicates that the data of a secret was replaced and
+	// pushed to the containers using it.
+	SecretRotate Status = "rotate"
 	// Start ...
 	Start Status = "start"
 	// Stop ...
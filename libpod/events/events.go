@@ -21,7 +21,7 @@ func (et EventerType) String() string {
 // IsValidEventer checks if the given string is a valid eventer type.
 func IsValidEventer(eventer string) bool {
 	switch EventerType(eventer) {
-	case LogFile, Journald, Null:
+	case LogFile, Journald, Null, Webhook:
 		return true
 	default:
 		return false
@@ -170,6 +170,8 @@ func StringToStatus(name string) (Status, error) {
 		return Kill, nil
 	case LoadFromArchive.String():
 		return LoadFromArchive, nil
+	case LogShipError.String():
+		return LogShipError, nil
 	case Mount.String():
 		return Mount, nil
 	case NetworkConnect.String():
@@ -202,6 +204,8 @@ func StringToStatus(name string) (Status, error) {
 		return Rotate, nil
 	case Save.String():
 		return Save, nil
+	case SecretRotate.String():
+		return SecretRotate, nil
 	case Start.String():
 		return Start, nil
 	case Stop.String():
@@ -20,6 +20,8 @@ func NewEventer(options EventerOptions) (Eventer, error) {
 		return newLogFileEventer(options)
 	case Null:
 		return newNullEventer(), nil
+	case Webhook:
+		return newWebhookEventer(options)
 	default:
 		return nil, fmt.Errorf("unknown event logger type: %s", strings.ToLower(options.EventerType))
 	}
@@ -26,6 +26,11 @@ func generateEventFilter(filter, filterValue string) (func(e *Event) bool, error
 		if filterValue == "die" { // Docker compat
 			filterValue = "died"
 		}
+		if filterValue == "oom" { // Docker compat, match died events of OOM killed containers
+			return func(e *Event) bool {
+				return e.Status == Exited && e.OOMKilled != nil && *e.OOMKilled
+			}, nil
+		}
 		return func(e *Event) bool {
 			return string(e.Status) == filterValue
 		}, nil
@@ -0,0 +1,553 @@
+//go:build linux || freebsd
+
+package events
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/storage/pkg/lockfile"
+)
+
+const (
+	// WebhookFormatJSON posts events as a JSON array.
+	WebhookFormatJSON = "json"
+	// WebhookFormatCloudEvents posts events as a batch of CloudEvents.
+	WebhookFormatCloudEvents = "cloudevents"
+
+	defaultWebhookBatchSize     = 100
+	defaultWebhookTimeout       = 2 * time.Second
+	defaultWebhookRetryDelay    = time.Second
+	defaultWebhookMaxRetryDelay = 5 * time.Minute
+	defaultWebhookSpoolMax      = 10000
+)
+
+// ReadWebhookOptions reads the webhook configuration file at path.
+func ReadWebhookOptions(path string) (*WebhookOptions, error) {
+	options := new(WebhookOptions)
+	meta, err := toml.DecodeFile(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("reading webhook events configuration: %w", err)
+	}
+	if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+		return nil, fmt.Errorf("unknown key %q in webhook events configuration %s", undecoded[0].String(), path)
+	}
+	return options, nil
+}
+
+// EventWebhook is an eventer that writes events to the events log file and
+// forwards them to an HTTP endpoint.  Events are appended to a spool on disk
+// and delivered in batches by a background goroutine, which also retries
+// failed deliveries.
+type EventWebhook struct {
+	EventLogFile
+	url           string
+	format        string
+	headers       map[string]string
+	filters       map[string][]EventFilter
+	batchSize     int
+	timeout       time.Duration
+	retryDelay    time.Duration
+	maxRetryDelay time.Duration
+	spoolPath     string
+	spoolMax      int
+	source        string
+
+	// wake signals the delivery goroutine that events were spooled.
+	wake chan struct{}
+	// stop tells the delivery goroutine to deliver pending events once
+	// more and exit, done is closed when it exited.
+	stop      chan struct{}
+	done      chan struct{}
+	startOnce sync.Once
+	closeOnce sync.Once
+}
+
+// webhookSpoolState is the state of the spool shared by all processes
+// writing to it.  It is stored next to the spool and protected by the spool
+// lock.
+type webhookSpoolState struct {
+	// Failures is the number of consecutive failed deliveries.
+	Failures uint `json:"failures,omitempty"`
+	// Next is the time of the next delivery attempt after a failure.
+	Next time.Time `json:"next,omitzero"`
+	// Count is the number of events in the spool.
+	Count int `json:"count"`
+	// Removed is the total number of events removed from the head of the
+	// spool, either delivered or dropped.  It allows a delivery to remove
+	// only the events that are still in the spool after it finished.
+	Removed uint64 `json:"removed,omitempty"`
+}
+
+// newWebhookEventer creates a new EventWebhook eventer
+func newWebhookEventer(options EventerOptions) (*EventWebhook, error) {
+	if options.Webhook == nil {
+		return nil, errors.New("the webhook events backend requires a configuration file")
+	}
+	logFile, err := newLogFileEventer(options)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Webhook
+	e := &EventWebhook{
+		EventLogFile:  *logFile,
+		url:           opts.URL,
+		format:        opts.Format,
+		headers:       opts.Headers,
+		batchSize:     opts.BatchSize,
+		timeout:       defaultWebhookTimeout,
+		retryDelay:    defaultWebhookRetryDelay,
+		maxRetryDelay: defaultWebhookMaxRetryDelay,
+		spoolPath:     opts.SpoolPath,
+		spoolMax:      opts.SpoolMaxEvents,
+		wake:          make(chan struct{}, 1),
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+
+	u, err := url.Parse(e.url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid webhook url %q: scheme must be http or https", e.url)
+	}
+	switch e.format {
+	case "":
+		e.format = WebhookFormatJSON
+	case WebhookFormatJSON, WebhookFormatCloudEvents:
+	default:
+		return nil, fmt.Errorf("invalid webhook format %q: must be %q or %q", e.format, WebhookFormatJSON, WebhookFormatCloudEvents)
+	}
+	if e.batchSize <= 0 {
+		e.batchSize = defaultWebhookBatchSize
+	}
+	if e.spoolMax <= 0 {
+		e.spoolMax = defaultWebhookSpoolMax
+	}
+	if e.spoolPath == "" {
+		e.spoolPath = filepath.Join(filepath.Dir(options.LogFilePath), "webhook-spool.log")
+	}
+	for _, d := range []struct {
+		name  string
+		value string
+		dest  *time.Duration
+	}{
+		{"timeout", opts.Timeout, &e.timeout},
+		{"retry_delay", opts.RetryDelay, &e.retryDelay},
+		{"max_retry_delay", opts.MaxRetryDelay, &e.maxRetryDelay},
+	} {
+		if d.value == "" {
+			continue
+		}
+		if *d.dest, err = time.ParseDuration(d.value); err != nil || *d.dest <= 0 {
+			return nil, fmt.Errorf("invalid webhook %s %q", d.name, d.value)
+		}
+	}
+	if e.filters, err = generateEventFilters(opts.Filters, "", ""); err != nil {
+		return nil, fmt.Errorf("invalid webhook filters: %w", err)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+	e.source = "podman://" + hostname
+
+	if err := os.MkdirAll(filepath.Dir(e.spoolPath), 0o700); err != nil {
+		return nil, fmt.Errorf("creating webhook spool dir: %w", err)
+	}
+	return e, nil
+}
+
+// Write writes the event to the log file and appends it to the spool.  The
+// spooled events are delivered asynchronously.
+func (e *EventWebhook) Write(ee Event) error {
+	if err := e.EventLogFile.Write(ee); err != nil {
+		return err
+	}
+	if !applyFilters(&ee, e.filters) {
+		return nil
+	}
+
+	eventJSONString, err := ee.ToJSONString()
+	if err != nil {
+		return err
+	}
+	if err := e.spool(eventJSONString); err != nil {
+		return fmt.Errorf("spooling event for webhook: %w", err)
+	}
+
+	e.startOnce.Do(func() { go e.deliver() })
+	select {
+	case e.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Close gives the delivery goroutine up to the request timeout to deliver
+// the spooled events.  Events that are not delivered by then remain in the
+// spool and are delivered by the next Podman process writing an event.
+func (e *EventWebhook) Close() error {
+	started := true
+	e.startOnce.Do(func() { started = false })
+	if !started {
+		return nil
+	}
+	e.closeOnce.Do(func() { close(e.stop) })
+	select {
+	case <-e.done:
+	case <-time.After(e.timeout):
+		logrus.Debugf("Timed out delivering events to webhook %s", e.url)
+	}
+	return nil
+}
+
+// deliver delivers the spooled events whenever new events are spooled and
+// retries failed deliveries.  While the spool is empty it is checked every
+// max retry delay, for events left behind by other processes.
+func (e *EventWebhook) deliver() {
+	defer close(e.done)
+	timer := time.NewTimer(e.maxRetryDelay)
+	defer timer.Stop()
+	for {
+		stopping := false
+		select {
+		case <-e.wake:
+		case <-timer.C:
+		case <-e.stop:
+			stopping = true
+		}
+
+		next := e.tryFlush()
+		if stopping {
+			return
+		}
+		wait := e.maxRetryDelay
+		if !next.IsZero() {
+			wait = max(time.Until(next), 0)
+		}
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(wait)
+	}
+}
+
+// tryFlush delivers the spooled events unless a previous delivery failed and
+// the retry delay has not yet passed.  It returns the time of the next retry
+// or the zero time if there is nothing to retry.
+func (e *EventWebhook) tryFlush() time.Time {
+	lock, err := lockfile.GetLockFile(e.spoolPath + ".lock")
+	if err != nil {
+		logrus.Errorf("Locking webhook event spool: %v", err)
+		return time.Now().Add(e.retryDelay)
+	}
+
+	lock.Lock()
+	state, err := e.readState()
+	lock.Unlock()
+	if err != nil {
+		logrus.Errorf("Reading webhook event spool state: %v", err)
+		return time.Now().Add(e.retryDelay)
+	}
+	if state.Count == 0 {
+		return time.Time{}
+	}
+	if time.Now().Before(state.Next) {
+		return state.Next
+	}
+
+	err = e.flush(lock)
+
+	lock.Lock()
+	defer lock.Unlock()
+	state, serr := e.readState()
+	if serr != nil {
+		logrus.Errorf("Reading webhook event spool state: %v", serr)
+		return time.Now().Add(e.retryDelay)
+	}
+	if err != nil {
+		logrus.Warnf("Delivering events to webhook %s: %v", e.url, err)
+		delay := min(e.retryDelay<<min(state.Failures, 16), e.maxRetryDelay)
+		state.Failures++
+		state.Next = time.Now().Add(delay)
+	} else {
+		state.Failures = 0
+		state.Next = time.Time{}
+	}
+	if err := e.writeState(state); err != nil {
+		logrus.Errorf("Writing webhook event spool state: %v", err)
+	}
+	return state.Next
+}
+
+// spool appends the event to the spool.  If the spool is full, the oldest
+// tenth of the events is dropped so that the spool is not rewritten for
+// every event.
+func (e *EventWebhook) spool(event string) error {
+	lock, err := lockfile.GetLockFile(e.spoolPath + ".lock")
+	if err != nil {
+		return err
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	state, err := e.readState()
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(e.spoolPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if err := writeToFile(event, f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	state.Count++
+
+	if state.Count > e.spoolMax {
+		lines, err := e.readSpool()
+		if err != nil {
+			return err
+		}
+		keep := e.spoolMax - e.spoolMax/10
+		dropped := max(len(lines)-keep, 0)
+		logrus.Warnf("Webhook event spool %s is full, dropping %d undelivered events", e.spoolPath, dropped)
+		if err := e.writeSpool(lines[dropped:]); err != nil {
+			return err
+		}
+		state.Count = len(lines) - dropped
+		state.Removed += uint64(dropped)
+	}
+	return e.writeState(state)
+}
+
+// flush delivers the spooled events in batches and removes the delivered
+// events from the spool.  The spool lock is only held while reading and
+// updating the spool, not while the events are sent.
+func (e *EventWebhook) flush(lock *lockfile.LockFile) error {
+	lock.Lock()
+	lines, err := e.readSpool()
+	if err != nil {
+		lock.Unlock()
+		return err
+	}
+	state, err := e.readState()
+	lock.Unlock()
+	if err != nil {
+		return err
+	}
+	removedAtRead := state.Removed
+
+	delivered := 0
+	var sendErr error
+	for delivered < len(lines) {
+		n := min(len(lines)-delivered, e.batchSize)
+		if sendErr = e.send(lines[delivered : delivered+n]); sendErr != nil {
+			break
+		}
+		delivered += n
+	}
+	if delivered == 0 {
+		return sendErr
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	state, err = e.readState()
+	if err != nil {
+		return err
+	}
+	// Events may have been dropped from the head of the spool while the
+	// events were sent.
+	remove := delivered - int(state.Removed-removedAtRead)
+	if remove > 0 {
+		current, err := e.readSpool()
+		if err != nil {
+			return err
+		}
+		remove = min(remove, len(current))
+		if err := e.writeSpool(current[remove:]); err != nil {
+			return err
+		}
+		state.Count = len(current) - remove
+		state.Removed += uint64(remove)
+		if err := e.writeState(state); err != nil {
+			return err
+		}
+	}
+	return sendErr
+}
+
+// send posts a batch of events.
+func (e *EventWebhook) send(lines []string) error {
+	body, contentType, err := e.payload(lines)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response %s", resp.Status)
+	}
+	return nil
+}
+
+// cloudEvent is an event in the CloudEvents 1.0 JSON format.
+type cloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// payload encodes a batch of spooled events in the configured format.
+func (e *EventWebhook) payload(lines []string) ([]byte, string, error) {
+	if e.format == WebhookFormatJSON {
+		return []byte("[" + strings.Join(lines, ",") + "]"), "application/json", nil
+	}
+
+	batch := make([]cloudEvent, 0, len(lines))
+	for _, line := range lines {
+		event, err := newEventFromJSONString(line)
+		if err != nil {
+			return nil, "", err
+		}
+		// Derive the ID from the event so that retried deliveries can be
+		// deduplicated by the receiver.
+		sum := sha256.Sum256([]byte(line))
+		subject := event.Name
+		if subject == "" {
+			subject = event.ID
+		}
+		batch = append(batch, cloudEvent{
+			SpecVersion:     "1.0",
+			ID:              hex.EncodeToString(sum[:16]),
+			Source:          e.source,
+			Type:            "io.podman.events." + event.Type.String() + "." + event.Status.String(),
+			Subject:         subject,
+			Time:            event.Time,
+			DataContentType: "application/json",
+			Data:            json.RawMessage(line),
+		})
+	}
+	body, err := json.Marshal(batch)
+	return body, "application/cloudevents-batch+json", err
+}
+
+func (e *EventWebhook) readSpool() ([]string, error) {
+	f, err := os.Open(e.spoolPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+func (e *EventWebhook) writeSpool(lines []string) error {
+	content := ""
+	if len(lines) > 0 {
+		content = strings.Join(lines, "\n") + "\n"
+	}
+	tmp := e.spoolPath + ".tmp"
+	if err := os.WriteFile(tmp, []byte(content), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, e.spoolPath)
+}
+
+// readState reads the state of the spool.  Must be called with the spool
+// lock held.
+func (e *EventWebhook) readState() (*webhookSpoolState, error) {
+	state := new(webhookSpoolState)
+	content, err := os.ReadFile(e.spoolPath + ".state")
+	if err == nil {
+		if err := json.Unmarshal(content, state); err == nil {
+			return state, nil
+		}
+		logrus.Warnf("Ignoring invalid webhook event spool state %s.state", e.spoolPath)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	// Without a state, count the spooled events once.
+	lines, err := e.readSpool()
+	if err != nil {
+		return nil, err
+	}
+	state.Count = len(lines)
+	return state, nil
+}
+
+// writeState writes the state of the spool.  Must be called with the spool
+// lock held.
+func (e *EventWebhook) writeState(state *webhookSpoolState) error {
+	content, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	tmp := e.spoolPath + ".state.tmp"
+	if err := os.WriteFile(tmp, content, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, e.spoolPath+".state")
+}
+
+// String returns a string representation of the logger
+func (e *EventWebhook) String() string {
+	return Webhook.String()
+}
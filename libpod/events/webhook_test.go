@@ -0,0 +1,207 @@
+//go:build linux || freebsd
+
+package events
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// webhookReceiver records the bodies of the requests it receives and fails
+// them while failing is set.
+type webhookReceiver struct {
+	mu          sync.Mutex
+	failing     bool
+	contentType string
+	bodies      [][]byte
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failing {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	body, _ := io.ReadAll(req.Body)
+	r.bodies = append(r.bodies, body)
+	r.contentType = req.Header.Get("Content-Type")
+}
+
+func (r *webhookReceiver) setFailing(failing bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failing = failing
+}
+
+// received returns the content type and bodies of the received requests.
+func (r *webhookReceiver) received() (string, [][]byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.contentType, r.bodies
+}
+
+func newTestWebhook(t *testing.T, url string, opts WebhookOptions) *EventWebhook {
+	opts.URL = url
+	if opts.RetryDelay == "" {
+		opts.RetryDelay = "10ms"
+	}
+	e, err := newWebhookEventer(EventerOptions{
+		LogFilePath: filepath.Join(t.TempDir(), "events", "events.log"),
+		Webhook:     &opts,
+	})
+	require.NoError(t, err)
+	return e
+}
+
+func TestWebhookJSON(t *testing.T) {
+	receiver := &webhookReceiver{}
+	srv := httptest.NewServer(receiver)
+	defer srv.Close()
+
+	e := newTestWebhook(t, srv.URL, WebhookOptions{Filters: []string{"event=died"}})
+	start := NewEvent(Start)
+	start.Type = Container
+	require.NoError(t, e.Write(start))
+	died := NewEvent(Exited)
+	died.Type = Container
+	died.Name = "web"
+	require.NoError(t, e.Write(died))
+	// Close waits for the asynchronous delivery.
+	require.NoError(t, e.Close())
+
+	contentType, bodies := receiver.received()
+	require.Len(t, bodies, 1, "only the died event matches the filters")
+	assert.Equal(t, "application/json", contentType)
+	var events []Event
+	require.NoError(t, json.Unmarshal(bodies[0], &events))
+	require.Len(t, events, 1)
+	assert.Equal(t, Exited, events[0].Status)
+	assert.Equal(t, "web", events[0].Name)
+
+	// Both events are still written to the log file.
+	content, err := os.ReadFile(e.options.LogFilePath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"Status":"start"`)
+	assert.Contains(t, string(content), `"Status":"died"`)
+}
+
+func TestWebhookCloudEventsSpoolAndRetry(t *testing.T) {
+	receiver := &webhookReceiver{failing: true}
+	srv := httptest.NewServer(receiver)
+	defer srv.Close()
+
+	e := newTestWebhook(t, srv.URL, WebhookOptions{Format: WebhookFormatCloudEvents, BatchSize: 2})
+	defer e.Close()
+	for _, name := range []string{"a", "b", "c"} {
+		ev := NewEvent(HealthStatus)
+		ev.Type = Container
+		ev.Name = name
+		require.NoError(t, e.Write(ev), "failed deliveries must not fail the write")
+	}
+	require.Eventually(t, func() bool {
+		state, err := e.readState()
+		return err == nil && state.Failures > 0
+	}, 5*time.Second, 10*time.Millisecond, "delivery is attempted in the background")
+	lines, err := e.readSpool()
+	require.NoError(t, err)
+	assert.Len(t, lines, 3)
+
+	// The spooled events are retried without a new event being written.
+	receiver.setFailing(false)
+	require.Eventually(t, func() bool {
+		state, err := e.readState()
+		return err == nil && state.Count == 0 && state.Failures == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The three spooled events are delivered in two batches.
+	contentType, bodies := receiver.received()
+	require.Len(t, bodies, 2)
+	assert.Equal(t, "application/cloudevents-batch+json", contentType)
+	var batch []cloudEvent
+	require.NoError(t, json.Unmarshal(bodies[0], &batch))
+	require.Len(t, batch, 2)
+	assert.Equal(t, "1.0", batch[0].SpecVersion)
+	assert.Equal(t, "io.podman.events.container.health_status", batch[0].Type)
+	assert.Equal(t, "a", batch[0].Subject)
+	assert.NotEmpty(t, batch[0].ID)
+	assert.NotEqual(t, batch[0].ID, batch[1].ID)
+
+	lines, err = e.readSpool()
+	require.NoError(t, err)
+	assert.Empty(t, lines)
+}
+
+func TestWebhookSpoolMaxEvents(t *testing.T) {
+	e := newTestWebhook(t, "http://127.0.0.1:1", WebhookOptions{SpoolMaxEvents: 2, RetryDelay: "1h"})
+	defer e.Close()
+	for _, name := range []string{"a", "b", "c"} {
+		ev := NewEvent(Create)
+		ev.Type = Container
+		ev.Name = name
+		require.NoError(t, e.Write(ev))
+	}
+	lines, err := e.readSpool()
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"Name":"b"`)
+	assert.Contains(t, lines[1], `"Name":"c"`)
+}
+
+func TestWebhookOptions(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, WebhookConfigFile)
+	require.NoError(t, os.WriteFile(path, []byte(`
+url = "https://example.com/hook"
+format = "cloudevents"
+filters = ["event=oom"]
+timeout = "5s"
+
+[headers]
+Authorization = "Bearer token"
+`), 0o600))
+	opts, err := ReadWebhookOptions(path)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/hook", opts.URL)
+	assert.Equal(t, WebhookFormatCloudEvents, opts.Format)
+	assert.Equal(t, []string{"event=oom"}, opts.Filters)
+	assert.Equal(t, "Bearer token", opts.Headers["Authorization"])
+
+	require.NoError(t, os.WriteFile(path, []byte(`url = "https://example.com"`+"\nbatchsize = 1\n"), 0o600))
+	_, err = ReadWebhookOptions(path)
+	assert.ErrorContains(t, err, `unknown key "batchsize"`)
+
+	for _, opts := range []WebhookOptions{
+		{URL: "ftp://example.com"},
+		{URL: "https://example.com", Format: "xml"},
+		{URL: "https://example.com", Timeout: "soon"},
+		{URL: "https://example.com", Filters: []string{"nope"}},
+	} {
+		_, err := newWebhookEventer(EventerOptions{
+			LogFilePath: filepath.Join(dir, "events.log"),
+			Webhook:     &opts,
+		})
+		assert.Error(t, err, "%+v", opts)
+	}
+}
+
+func TestOOMFilter(t *testing.T) {
+	filter, err := generateEventFilter("event", "oom")
+	require.NoError(t, err)
+	oom := true
+	notOOM := false
+	assert.True(t, filter(&Event{Status: Exited, OOMKilled: &oom}))
+	assert.False(t, filter(&Event{Status: Exited, OOMKilled: &notOOM}))
+	assert.False(t, filter(&Event{Status: Exited}))
+	assert.False(t, filter(&Event{Status: Start, OOMKilled: &oom}))
+}
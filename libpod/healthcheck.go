@@ -51,6 +51,114 @@ func (r *Runtime) HealthCheck(ctx context.Context, name string) (define.HealthCh
 	return hcStatus, err
 }
 
+// ReadinessCheck executes the readiness check of the container and returns
+// whether the container is ready.
+func (r *Runtime) ReadinessCheck(_ context.Context, name string) (bool, error) {
+	container, err := r.LookupContainer(name)
+	if err != nil {
+		return false, fmt.Errorf("unable to look up %s to perform a readiness check: %w", name, err)
+	}
+	if !container.HasReadinessCheck() {
+		return false, fmt.Errorf("container %s has no defined readiness check", container.ID())
+	}
+	state, err := container.State()
+	if err != nil {
+		return false, err
+	}
+	if state != define.ContainerStateRunning {
+		return false, fmt.Errorf("container %s is not running", container.ID())
+	}
+	if err := container.runReadinessCheck(); err != nil {
+		return false, err
+	}
+	return container.Ready()
+}
+
+// healthCheckCommand converts the Test field of a healthcheck configuration
+// into the command to execute in the container.
+func healthCheckCommand(test []string) []string {
+	if len(test) < 1 {
+		return nil
+	}
+	switch test[0] {
+	case "", define.HealthConfigTestNone:
+		return nil
+	case define.HealthConfigTestCmd:
+		return test[1:]
+	case define.HealthConfigTestCmdShell:
+		// TODO: SHELL command from image not available in Container - use Docker default
+		return []string{"/bin/sh", "-c", strings.Join(test[1:], " ")}
+	default:
+		// command supplied on command line - pass as-is
+		return test
+	}
+}
+
+// runReadinessCheck executes the readiness check of the container and
+// updates the ready state once the configured number of consecutive
+// successes or failures has been reached.
+func (c *Container) runReadinessCheck() error {
+	config := c.config.ReadinessCheckConfig
+	if config.StartPeriod > 0 {
+		started, err := c.StartedTime()
+		if err != nil {
+			return err
+		}
+		if time.Now().Before(started.Add(config.StartPeriod)) {
+			logrus.Debugf("Readiness check for %s is in its start period", c.ID())
+			return nil
+		}
+	}
+
+	command := healthCheckCommand(config.Test)
+	if len(command) < 1 || command[0] == "" {
+		return fmt.Errorf("container %s has no defined readiness check", c.ID())
+	}
+
+	streams := new(define.AttachStreams)
+	output := &bytes.Buffer{}
+	streams.OutputStream = output
+	streams.ErrorStream = output
+	streams.AttachOutput = true
+	streams.AttachError = true
+
+	logrus.Debugf("executing readiness check command %s for %s", strings.Join(command, " "), c.ID())
+	execConfig := new(ExecConfig)
+	execConfig.Command = command
+	exitCode, execErr := c.healthCheckExec(execConfig, config.Timeout, streams)
+	passed := execErr == nil && exitCode == 0
+
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+	if !c.ensureState(define.ContainerStateRunning) {
+		return nil
+	}
+
+	wasReady := c.state.Ready
+	if passed {
+		c.state.ReadinessFailureCount = 0
+		c.state.ReadinessSuccessCount++
+		if config.Successes == 0 || c.state.ReadinessSuccessCount >= config.Successes {
+			c.state.Ready = true
+		}
+	} else {
+		c.state.ReadinessSuccessCount = 0
+		c.state.ReadinessFailureCount++
+		if config.Retries == 0 || c.state.ReadinessFailureCount >= config.Retries {
+			c.state.Ready = false
+		}
+	}
+	if wasReady != c.state.Ready {
+		logrus.Infof("Container %s readiness changed to %t", c.ID(), c.state.Ready)
+	}
+	return c.save()
+}
+
 func (c *Container) runHealthCheck(ctx context.Context, isStartup bool) (define.HealthCheckStatus, string, error) {
 	var (
 		newCommand    []string
@@ -81,21 +189,7 @@ func (c *Container) runHealthCheck(ctx context.Context, isStartup bool) (define.
 		logrus.Debugf("Running startup healthcheck for container %s", c.ID())
 		hcCommand = c.config.StartupHealthCheckConfig.Test
 	}
-	if len(hcCommand) < 1 {
-		return define.HealthCheckNotDefined, "", fmt.Errorf("container %s has no defined healthcheck", c.ID())
-	}
-	switch hcCommand[0] {
-	case "", define.HealthConfigTestNone:
-		return define.HealthCheckNotDefined, "", fmt.Errorf("container %s has no defined healthcheck", c.ID())
-	case define.HealthConfigTestCmd:
-		newCommand = hcCommand[1:]
-	case define.HealthConfigTestCmdShell:
-		// TODO: SHELL command from image not available in Container - use Docker default
-		newCommand = []string{"/bin/sh", "-c", strings.Join(hcCommand[1:], " ")}
-	default:
-		// command supplied on command line - pass as-is
-		newCommand = hcCommand
-	}
+	newCommand = healthCheckCommand(hcCommand)
 	if len(newCommand) < 1 || newCommand[0] == "" {
 		return define.HealthCheckNotDefined, "", fmt.Errorf("container %s has no defined healthcheck", c.ID())
 	}
@@ -0,0 +1,18 @@
+//go:build !remote && (linux || freebsd) && !systemd
+
+package libpod
+
+import (
+	"context"
+)
+
+// startReadinessTimer creates and starts a transient systemd timer that
+// runs the container's readiness check every readiness interval.
+func (c *Container) startReadinessTimer() error {
+	return nil
+}
+
+// removeReadinessTimer stops and removes the container's readiness timer.
+func (c *Container) removeReadinessTimer(_ context.Context) error {
+	return nil
+}
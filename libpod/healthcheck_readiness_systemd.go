@@ -0,0 +1,46 @@
+//go:build !remote && systemd
+
+package libpod
+
+import (
+	"context"
+	"os"
+
+	systemdCommon "go.podman.io/common/pkg/systemd"
+)
+
+func (c *Container) readinessUnitName() string {
+	return c.ID() + "-readiness"
+}
+
+// readinessTimerSupported returns whether the readiness check of the
+// container can be run by a systemd timer.
+func (c *Container) readinessTimerSupported() bool {
+	if c.config.ReadinessCheckConfig == nil || c.config.ReadinessCheckConfig.Interval == 0 {
+		return false
+	}
+	return systemdCommon.RunsOnSystemd() && os.Getenv("DISABLE_HC_SYSTEMD") != "true"
+}
+
+// startReadinessTimer creates and starts a transient systemd timer that
+// runs the container's readiness check every readiness interval.
+func (c *Container) startReadinessTimer() error {
+	if !c.readinessTimerSupported() {
+		return nil
+	}
+	// Remove a timer left behind by a previous run.
+	if err := c.removeReadinessTimer(context.Background()); err != nil {
+		return err
+	}
+	interval := c.config.ReadinessCheckConfig.Interval.String()
+	timerOptions := []string{"--on-active=" + interval, "--on-unit-inactive=" + interval, "--timer-property=AccuracySec=1s"}
+	return createTransientTimer(c.readinessUnitName(), timerOptions, "healthcheck", "run", "--readiness", "--ignore-result", c.ID())
+}
+
+// removeReadinessTimer stops and removes the container's readiness timer.
+func (c *Container) removeReadinessTimer(ctx context.Context) error {
+	if !c.readinessTimerSupported() {
+		return nil
+	}
+	return removeTransientTimer(ctx, c.readinessUnitName())
+}
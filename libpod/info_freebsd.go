@@ -38,5 +38,8 @@ func getCPUUtilization() (*define.CPUUsage, error) {
 		UserPercent:   timeToPercent(times[unix.CP_USER], total),
 		SystemPercent: timeToPercent(times[unix.CP_SYS], total),
 		IdlePercent:   timeToPercent(times[unix.CP_IDLE], total),
+		UserTicks:     times[unix.CP_USER],
+		SystemTicks:   times[unix.CP_SYS],
+		IdleTicks:     times[unix.CP_IDLE],
 	}, nil
 }
@@ -111,6 +111,9 @@ func statToPercent(stats []string) (*define.CPUUsage, error) {
 		UserPercent:   math.Round((userTotal/total*100)*100) / 100,
 		SystemPercent: math.Round((systemTotal/total*100)*100) / 100,
 		IdlePercent:   math.Round((idleTotal/total*100)*100) / 100,
+		UserTicks:     uint64(userTotal),
+		SystemTicks:   uint64(systemTotal),
+		IdleTicks:     uint64(idleTotal),
 	}
 	return &s, nil
 }
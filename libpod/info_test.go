@@ -27,6 +27,9 @@ func Test_statToPercent(t *testing.T) {
 				UserPercent:   2.48,
 				SystemPercent: 0.71,
 				IdlePercent:   96.81,
+				UserTicks:     33628064,
+				SystemTicks:   9696996,
+				IdleTicks:     1314806705,
 			},
 			wantErr: assert.NoError,
 		},
@@ -19,6 +19,7 @@ import (
 	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/containers/podman/v6/pkg/env"
+	appsv1 "github.com/containers/podman/v6/pkg/k8s.io/api/apps/v1"
 	v1 "github.com/containers/podman/v6/pkg/k8s.io/api/core/v1"
 	"github.com/containers/podman/v6/pkg/k8s.io/apimachinery/pkg/api/resource"
 	v12 "github.com/containers/podman/v6/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -289,6 +290,134 @@ func GenerateForKubeJob(_ context.Context, pod *YAMLPod, options entities.Genera
 	return &job, nil
 }
 
+// GenerateForKubeStatefulSet returns a YAMLStatefulSet from a YAMLPod that is then used to create a kubernetes
+// StatefulSet kind YAML.  Persistent volume claims of the pod are turned into volume claim templates so every
+// replica gets its own volume.
+func GenerateForKubeStatefulSet(_ context.Context, pod *YAMLPod, options entities.GenerateKubeOptions) (*YAMLStatefulSet, error) {
+	// Restart policy for StatefulSets can only be set to Always
+	if pod.Spec.RestartPolicy != "" && pod.Spec.RestartPolicy != v1.RestartPolicyAlways {
+		return nil, fmt.Errorf("k8s StatefulSets can only have restartPolicy set to Always")
+	}
+
+	// Create label map that will be added to podSpec and StatefulSet metadata
+	// The matching label lets the statefulset know which pods to manage
+	appKey := "app"
+	matchLabels := map[string]string{appKey: pod.Name}
+	// Add the key:value (app:pod-name) to the podSpec labels
+	if pod.Labels == nil {
+		pod.Labels = matchLabels
+	} else {
+		pod.Labels[appKey] = pod.Name
+	}
+
+	podSpec := *pod.Spec
+	podSpec.Volumes = nil
+	var claims []v1.PersistentVolumeClaim
+	for _, vol := range pod.Spec.Volumes {
+		if vol.PersistentVolumeClaim == nil {
+			podSpec.Volumes = append(podSpec.Volumes, vol)
+			continue
+		}
+		claims = append(claims, v1.PersistentVolumeClaim{
+			ObjectMeta: v12.ObjectMeta{
+				Name: vol.Name,
+			},
+			Spec: v1.PersistentVolumeClaimSpec{
+				Resources: v1.ResourceRequirements{
+					Requests: map[v1.ResourceName]resource.Quantity{
+						v1.ResourceStorage: resource.MustParse("1Gi"),
+					},
+				},
+				AccessModes: []v1.PersistentVolumeAccessMode{
+					v1.ReadWriteOnce,
+				},
+			},
+		})
+	}
+
+	stsSpec := YAMLStatefulSetSpec{
+		StatefulSetSpec: appsv1.StatefulSetSpec{
+			Selector: &v12.LabelSelector{
+				MatchLabels: matchLabels,
+			},
+			VolumeClaimTemplates: claims,
+		},
+		Template: &YAMLPodTemplateSpec{
+			PodTemplateSpec: v1.PodTemplateSpec{
+				ObjectMeta: pod.ObjectMeta,
+			},
+			Spec: &podSpec,
+		},
+	}
+
+	// The governing service has the same name as the pod
+	if options.Service {
+		stsSpec.ServiceName = pod.Name
+	}
+
+	// Add replicas count if user adds replica number with --replicas flag and is greater than 1
+	if options.Replicas > 1 {
+		stsSpec.Replicas = &options.Replicas
+	}
+
+	// Create the StatefulSet object
+	sts := YAMLStatefulSet{
+		StatefulSet: appsv1.StatefulSet{
+			ObjectMeta: v12.ObjectMeta{
+				Name:              pod.Name + "-statefulset",
+				CreationTimestamp: pod.CreationTimestamp,
+				Labels:            pod.Labels,
+			},
+			TypeMeta: v12.TypeMeta{
+				Kind:       "StatefulSet",
+				APIVersion: "apps/v1",
+			},
+		},
+		Spec: &stsSpec,
+	}
+
+	return &sts, nil
+}
+
+// GenerateForKubeCronJob returns a YAMLCronJob from a YAMLPod that is then used to create a kubernetes CronJob
+// kind YAML.
+func GenerateForKubeCronJob(ctx context.Context, pod *YAMLPod, options entities.GenerateKubeOptions) (*YAMLCronJob, error) {
+	if options.Schedule == "" {
+		return nil, errors.New("k8s CronJobs require a schedule")
+	}
+
+	// The job template follows the same rules as a Job
+	job, err := GenerateForKubeJob(ctx, pod, entities.GenerateKubeOptions{Type: define.K8sKindJob})
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the CronJob object
+	cronJob := YAMLCronJob{
+		CronJob: v1.CronJob{
+			ObjectMeta: v12.ObjectMeta{
+				Name:              pod.Name + "-cronjob",
+				CreationTimestamp: pod.CreationTimestamp,
+				Labels:            pod.Labels,
+			},
+			TypeMeta: v12.TypeMeta{
+				Kind:       "CronJob",
+				APIVersion: "batch/v1",
+			},
+		},
+		Spec: &YAMLCronJobSpec{
+			CronJobSpec: v1.CronJobSpec{
+				Schedule: options.Schedule,
+			},
+			JobTemplate: &YAMLJobTemplateSpec{
+				Spec: job.Spec,
+			},
+		},
+	}
+
+	return &cronJob, nil
+}
+
 // GenerateForKube generates a v1.PersistentVolumeClaim from a libpod volume.
 func (v *Volume) GenerateForKube() *v1.PersistentVolumeClaim {
 	annotations := make(map[string]string)
@@ -393,6 +522,32 @@ type YAMLJobSpec struct {
 	Template *YAMLPodTemplateSpec `json:"template,omitempty"`
 }
 
+// YAMLStatefulSetSpec represents the same k8s API apps StatefulSetSpec with a small
+// change and that is having Template as a pointer to YAMLPodTemplateSpec and UpdateStrategy
+// as a pointer to k8s API apps StatefulSetUpdateStrategy.
+// Because Go doesn't omit empty struct and we want to omit UpdateStrategy and any fields in the Pod YAML
+// if it's empty.
+type YAMLStatefulSetSpec struct {
+	appsv1.StatefulSetSpec
+	Template       *YAMLPodTemplateSpec              `json:"template,omitempty"`
+	ServiceName    string                            `json:"serviceName,omitempty"`
+	UpdateStrategy *appsv1.StatefulSetUpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// YAMLJobTemplateSpec represents the same k8s API batch JobTemplateSpec with a small
+// change and that is having Spec as a pointer to YAMLJobSpec.
+type YAMLJobTemplateSpec struct {
+	v1.JobTemplateSpec
+	Spec *YAMLJobSpec `json:"spec,omitempty"`
+}
+
+// YAMLCronJobSpec represents the same k8s API batch CronJobSpec with a small
+// change and that is having JobTemplate as a pointer to YAMLJobTemplateSpec.
+type YAMLCronJobSpec struct {
+	v1.CronJobSpec
+	JobTemplate *YAMLJobTemplateSpec `json:"jobTemplate,omitempty"`
+}
+
 // YAMLDaemonSet represents the same k8s API core DaemonSet with a small change
 // and that is having Spec as a pointer to YAMLDaemonSetSpec and Status as a pointer to
 // k8s API core DaemonSetStatus.
@@ -421,6 +576,24 @@ type YAMLJob struct {
 	Status *v1.JobStatus `json:"status,omitempty"`
 }
 
+// YAMLStatefulSet represents the same k8s API apps StatefulSet with a small change
+// and that is having Spec as a pointer to YAMLStatefulSetSpec and Status as a pointer to
+// k8s API apps StatefulSetStatus.
+type YAMLStatefulSet struct {
+	appsv1.StatefulSet
+	Spec   *YAMLStatefulSetSpec      `json:"spec,omitempty"`
+	Status *appsv1.StatefulSetStatus `json:"status,omitempty"`
+}
+
+// YAMLCronJob represents the same k8s API batch CronJob with a small change
+// and that is having Spec as a pointer to YAMLCronJobSpec and Status as a pointer to
+// k8s API batch CronJobStatus.
+type YAMLCronJob struct {
+	v1.CronJob
+	Spec   *YAMLCronJobSpec  `json:"spec,omitempty"`
+	Status *v1.CronJobStatus `json:"status,omitempty"`
+}
+
 // YAMLService represents the same k8s API core Service struct with a small
 // change and that is having Status as a pointer to k8s API core ServiceStatus.
 // Because Go doesn't omit empty struct and we want to omit Status in YAML
@@ -1,6 +1,7 @@
 package logs
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
 	"io"
@@ -57,7 +58,9 @@ type LogLine struct {
 	ColorID      int64
 }
 
-// GetLogFile returns an hp tail for a container given options
+// GetLogFile returns an hp tail for a container given options.  If a tail is
+// requested, lines of rotated log segments are returned as part of the tail
+// lines, otherwise rotated segments must be read with ReadSegments.
 func GetLogFile(path string, options *LogOptions) (*tail.Tail, []*LogLine, error) {
 	var (
 		whence  int
@@ -83,30 +86,113 @@ func GetLogFile(path string, options *LogOptions) (*tail.Tail, []*LogLine, error
 	return t, logTail, err
 }
 
-func getTailLog(path string, tail int) ([]*LogLine, error) {
-	var (
-		nllCounter int
-		leftover   string
-		tailLog    []*LogLine
-		eof        bool
-	)
+// ReadSegments calls fn for each line of the rotated segments of the log
+// file, starting with the oldest one.  Segments that were last written to
+// before since are skipped.  The segments are streamed, they are not read
+// into memory.
+func ReadSegments(path string, since time.Time, fn func(*LogLine) error) error {
+	segments, err := Segments(path)
+	if err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		st, err := os.Stat(segment)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				// removed by a concurrent rotation
+				continue
+			}
+			return err
+		}
+		if !since.IsZero() && st.ModTime().Before(since) {
+			continue
+		}
+		err = forEachLine(segment, func(line string) error {
+			nll, err := NewLogLine(line)
+			if err != nil {
+				return err
+			}
+			return fn(nll)
+		})
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// forEachLine calls fn for each non-empty line of a log file or segment.
+func forEachLine(path string, fn func(line string) error) error {
+	r, err := openSegment(path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadString('\n')
+		if line = strings.TrimSuffix(line, "\n"); line != "" {
+			if err := fn(line); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// readLines returns the non-empty lines of a log file or segment.
+func readLines(path string) ([]string, error) {
+	var lines []string
+	err := forEachLine(path, func(line string) error {
+		lines = append(lines, line)
+		return nil
+	})
+	return lines, err
+}
+
+// readLinesReverse calls fn for each line of the log file at path, starting
+// with the last one, until fn returns false.  Compressed segments cannot be
+// read backwards and are read into memory.  It returns whether all lines were
+// read.
+func readLinesReverse(path string, fn func(line string) (bool, error)) (bool, error) {
+	if strings.HasSuffix(path, compressedSuffix) {
+		lines, err := readLines(path)
+		if err != nil {
+			return false, err
+		}
+		for i := len(lines) - 1; i >= 0; i-- {
+			if cont, err := fn(lines[i]); !cont || err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+
 	f, err := os.Open(path)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 	defer f.Close()
 	rr, err := reversereader.NewReverseReader(f)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	first := true
-
+	var leftover string
 	for {
 		s, err := rr.Read()
+		eof := false
 		if err != nil {
 			if !errors.Is(err, io.EOF) {
-				return nil, fmt.Errorf("reverse log read: %w", err)
+				return false, fmt.Errorf("reverse log read: %w", err)
 			}
 			eof = true
 		}
@@ -118,42 +204,75 @@ func getTailLog(path string, tail int) ([]*LogLine, error) {
 			if lines[i] == "" {
 				continue
 			}
-			nll, err := NewLogLine(lines[i])
-			if err != nil {
-				return nil, err
-			}
-			if !nll.Partial() || first {
-				nllCounter++
-				// Even if the last line is partial we need to count it as it will be printed as line.
-				// Because we read backwards the first line we read is the last line in the log.
-				first = false
-			}
-			// We explicitly need to check for more lines than tail because we have
-			// to read to next full line and must keep all partial lines
-			// https://github.com/containers/podman/issues/19545
-			if nllCounter > tail {
-				// because we add lines in the inverse order we must invert the slice in the end
-				return reverseLog(tailLog), nil
+			if cont, err := fn(lines[i]); !cont || err != nil {
+				return false, err
 			}
-			// only append after the return here because we do not want to include the next full line
-			tailLog = append(tailLog, nll)
 		}
 		leftover = lines[0]
 
 		// eof was reached
 		if eof {
-			// when we have still a line and do not have enough tail lines already
-			if leftover != "" && nllCounter < tail {
-				nll, err := NewLogLine(leftover)
-				if err != nil {
-					return nil, err
+			if leftover != "" {
+				if cont, err := fn(leftover); !cont || err != nil {
+					return false, err
 				}
-				tailLog = append(tailLog, nll)
 			}
-			// because we add lines in the inverse order we must invert the slice in the end
-			return reverseLog(tailLog), nil
+			return true, nil
+		}
+	}
+}
+
+// getTailLog returns the last tail lines of the log file, continuing into
+// rotated segments if the log file does not have enough lines.
+func getTailLog(path string, tail int) ([]*LogLine, error) {
+	var (
+		nllCounter int
+		tailLog    []*LogLine
+	)
+	segments, err := Segments(path)
+	if err != nil {
+		return nil, err
+	}
+	files := append(segments, path)
+
+	first := true
+	addLine := func(line string) (bool, error) {
+		nll, err := NewLogLine(line)
+		if err != nil {
+			return false, err
+		}
+		if !nll.Partial() || first {
+			nllCounter++
+			// Even if the last line is partial we need to count it as it will be printed as line.
+			// Because we read backwards the first line we read is the last line in the log.
+			first = false
+		}
+		// We explicitly need to check for more lines than tail because we have
+		// to read to next full line and must keep all partial lines
+		// https://github.com/containers/podman/issues/19545
+		if nllCounter > tail {
+			return false, nil
+		}
+		// only append after the return here because we do not want to include the next full line
+		tailLog = append(tailLog, nll)
+		return true, nil
+	}
+
+	for i := len(files) - 1; i >= 0; i-- {
+		cont, err := readLinesReverse(files[i], addLine)
+		if err != nil {
+			if i < len(files)-1 && errors.Is(err, os.ErrNotExist) {
+				// removed by a concurrent rotation
+				break
+			}
+			return nil, err
+		}
+		if !cont {
+			break
 		}
 	}
+	// because we add lines in the inverse order we must invert the slice in the end
+	return reverseLog(tailLog), nil
 }
 
 // reverseLog reverse the log line slice, needed for tail as we read lines backwards but still
@@ -0,0 +1,250 @@
+package logs
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// compressedSuffix is the file name suffix of compressed log segments.
+const compressedSuffix = ".gz"
+
+// RotateOptions describe when and how a log file is rotated.
+type RotateOptions struct {
+	// MaxSize is the size at which the log file is rotated.
+	MaxSize int64
+	// MaxFiles is the maximum number of log files to keep, including the
+	// log file that is currently written to.
+	MaxFiles uint
+	// Compress compresses rotated segments except the most recent one.
+	Compress bool
+	// MaxAge is the maximum age of rotated segments, older segments are
+	// removed.  Zero keeps segments regardless of their age.
+	MaxAge time.Duration
+}
+
+// segmentPath returns the path of the n-th rotated segment of the log file,
+// the first segment being the most recent one.
+func segmentPath(path string, n int, compressed bool) string {
+	p := path + "." + strconv.Itoa(n)
+	if compressed {
+		p += compressedSuffix
+	}
+	return p
+}
+
+// findSegment returns the path of the n-th rotated segment of the log file
+// or an empty string if it does not exist.
+func findSegment(path string, n int) (string, error) {
+	for _, compressed := range []bool{false, true} {
+		p := segmentPath(path, n, compressed)
+		if _, err := os.Lstat(p); err == nil {
+			return p, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+// Segments returns the rotated segments of the log file at path, ordered from
+// the oldest to the most recent one.
+func Segments(path string) ([]string, error) {
+	var segments []string
+	for n := 1; ; n++ {
+		p, err := findSegment(path, n)
+		if err != nil {
+			return nil, err
+		}
+		if p == "" {
+			break
+		}
+		segments = append([]string{p}, segments...)
+	}
+	return segments, nil
+}
+
+// RemoveSegments removes all rotated segments of the log file at path.
+func RemoveSegments(path string) error {
+	segments, err := Segments(path)
+	if err != nil {
+		return err
+	}
+	for _, s := range segments {
+		if err := os.Remove(s); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rotate rotates the log file at path if it reached the maximum size.  The
+// log file is renamed to path.1 and older segments are shifted, compressed
+// and removed according to the options.  Segments older than the maximum
+// age are removed even if the log file is not rotated.  It returns whether
+// the log file was rotated, in which case the writer has to reopen it.
+func Rotate(path string, options RotateOptions) (bool, error) {
+	segments, err := Segments(path)
+	if err != nil {
+		return false, err
+	}
+	// Most recent segment first.
+	for i, j := 0, len(segments)-1; i < j; i, j = i+1, j-1 {
+		segments[i], segments[j] = segments[j], segments[i]
+	}
+
+	if options.MaxAge > 0 {
+		cutoff := time.Now().Add(-options.MaxAge)
+		for i, s := range segments {
+			st, err := os.Stat(s)
+			if err != nil {
+				return false, err
+			}
+			if st.ModTime().Before(cutoff) {
+				// Segments are ordered, all following ones are older.
+				if err := removeAll(segments[i:]); err != nil {
+					return false, err
+				}
+				segments = segments[:i]
+				break
+			}
+		}
+	}
+
+	st, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	if options.MaxSize <= 0 || st.Size() < options.MaxSize {
+		return false, nil
+	}
+
+	keep := 0
+	if options.MaxFiles > 1 {
+		keep = int(options.MaxFiles) - 2
+	}
+	if len(segments) > keep {
+		if err := removeAll(segments[keep:]); err != nil {
+			return false, err
+		}
+		segments = segments[:keep]
+	}
+
+	for i := len(segments) - 1; i >= 0; i-- {
+		src := segments[i]
+		srcCompressed := strings.HasSuffix(src, compressedSuffix)
+		dst := segmentPath(path, i+2, srcCompressed || options.Compress)
+		if options.Compress && !srcCompressed {
+			if err := compressFile(src, dst); err != nil {
+				return false, fmt.Errorf("compressing log segment %s: %w", src, err)
+			}
+			continue
+		}
+		if err := os.Rename(src, dst); err != nil {
+			return false, err
+		}
+	}
+
+	if options.MaxFiles <= 1 {
+		if err := os.Truncate(path, 0); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	if err := os.Rename(path, segmentPath(path, 1, false)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func removeAll(paths []string) error {
+	for _, p := range paths {
+		if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// compressFile gzips src into dst and removes src.  The modification time is
+// preserved so that the retention applies to the time of the last write.
+func compressFile(src, dst string) (retErr error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	st, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	tmp := dst + ".tmp"
+	out, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, st.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			_ = os.Remove(tmp)
+		}
+	}()
+	zw := gzip.NewWriter(out)
+	if _, err := io.Copy(zw, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	if err := os.Chtimes(tmp, st.ModTime(), st.ModTime()); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
+
+// openSegment opens a log file or segment for reading, decompressing it if
+// needed.
+func openSegment(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(path, compressedSuffix) {
+		return f, nil
+	}
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("reading compressed log segment %s: %w", path, err)
+	}
+	return &gzipReadCloser{Reader: zr, file: f}, nil
+}
+
+type gzipReadCloser struct {
+	*gzip.Reader
+	file *os.File
+}
+
+func (g *gzipReadCloser) Close() error {
+	err := g.Reader.Close()
+	if cerr := g.file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
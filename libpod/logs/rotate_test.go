@@ -0,0 +1,89 @@
+package logs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeLines appends full log lines with the given messages to the log file.
+func writeLines(t *testing.T, path string, msgs ...string) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	defer f.Close()
+	for _, msg := range msgs {
+		_, err := fmt.Fprintf(f, "2023-08-07T19:56:34.223758260-06:00 stdout F %s\n", msg)
+		require.NoError(t, err)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ctr.log")
+	options := RotateOptions{MaxSize: 1, MaxFiles: 3, Compress: true}
+
+	rotated, err := Rotate(file, options)
+	require.NoError(t, err)
+	assert.False(t, rotated, "missing log file")
+
+	for i := range 4 {
+		writeLines(t, file, fmt.Sprintf("line%d", i))
+		rotated, err := Rotate(file, options)
+		require.NoError(t, err)
+		assert.True(t, rotated)
+	}
+	writeLines(t, file, "line4")
+
+	segments, err := Segments(file)
+	require.NoError(t, err)
+	assert.Equal(t, []string{file + ".2.gz", file + ".1"}, segments, "max-file includes the current log file")
+
+	got, err := getTailLog(file, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", "line2"), makeTestLogLine("F", "line3"), makeTestLogLine("F", "line4")}, got)
+
+	got, err = getTailLog(file, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", "line3"), makeTestLogLine("F", "line4")}, got)
+
+	got = nil
+	collect := func(nll *LogLine) error {
+		got = append(got, nll)
+		return nil
+	}
+	require.NoError(t, ReadSegments(file, time.Time{}, collect))
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", "line2"), makeTestLogLine("F", "line3")}, got)
+
+	// Segments last written to before since are skipped.
+	old := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(file+".2.gz", old, old))
+	got = nil
+	require.NoError(t, ReadSegments(file, time.Now().Add(-time.Minute), collect))
+	assert.Equal(t, []*LogLine{makeTestLogLine("F", "line3")}, got)
+
+	require.NoError(t, RemoveSegments(file))
+	segments, err = Segments(file)
+	require.NoError(t, err)
+	assert.Empty(t, segments)
+}
+
+func TestRotateMaxAge(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ctr.log")
+	options := RotateOptions{MaxSize: 1024, MaxFiles: 5, MaxAge: time.Hour}
+	writeLines(t, file, "current")
+	writeLines(t, file+".1", "recent")
+	writeLines(t, file+".2", "old")
+	old := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(file+".2", old, old))
+
+	rotated, err := Rotate(file, options)
+	require.NoError(t, err)
+	assert.False(t, rotated, "the log file is smaller than max-size")
+	segments, err := Segments(file)
+	require.NoError(t, err)
+	assert.Equal(t, []string{file + ".1"}, segments, "expired segments are removed")
+}
@@ -0,0 +1,106 @@
+package shipper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+const defaultFluentdAddress = "tcp://localhost:24224"
+
+// fluentdShipper ships messages using the Fluentd forward protocol in
+// forward mode: each batch of messages is sent as a single
+// [tag, [[time, record], ...]] msgpack array.
+type fluentdShipper struct {
+	conn
+	tag    string
+	fields map[string]string
+}
+
+func newFluentd(options map[string]string, info Info) (*fluentdShipper, error) {
+	address := options["fluentd-address"]
+	if address == "" {
+		address = defaultFluentdAddress
+	}
+	network, addr, err := parseAddress(address, "tcp", "tcp", "unix")
+	if err != nil {
+		return nil, fmt.Errorf("fluentd-address: %w", err)
+	}
+	fields := map[string]string{
+		"container_id":   info.ID,
+		"container_name": info.Name,
+	}
+	for k, v := range info.Labels {
+		if _, ok := fields[k]; ok || k == "log" || k == "source" {
+			continue
+		}
+		fields[k] = v
+	}
+	return &fluentdShipper{
+		conn:   conn{network: network, address: addr},
+		tag:    info.Tag,
+		fields: fields,
+	}, nil
+}
+
+// Ship sends the messages to the Fluentd server.
+func (f *fluentdShipper) Ship(msgs []Message) error {
+	var b msgpackBuffer
+	b.arrayHeader(2)
+	b.str(f.tag)
+	b.arrayHeader(len(msgs))
+	for _, m := range msgs {
+		b.arrayHeader(2)
+		b.eventTime(m.Time.Unix(), m.Time.Nanosecond())
+		b.mapHeader(len(f.fields) + 2)
+		for k, v := range f.fields {
+			b.str(k)
+			b.str(v)
+		}
+		b.str("source")
+		b.str(m.Stream)
+		b.str("log")
+		b.str(m.Msg)
+	}
+	return f.write(b)
+}
+
+// msgpackBuffer encodes the msgpack types needed by the forward protocol.
+type msgpackBuffer []byte
+
+func (b *msgpackBuffer) header(fix byte, fixMax int, n int, b16, b32 byte) {
+	switch {
+	case n <= fixMax:
+		*b = append(*b, fix|byte(n))
+	case n <= math.MaxUint16:
+		*b = append(*b, b16)
+		*b = binary.BigEndian.AppendUint16(*b, uint16(n))
+	default:
+		*b = append(*b, b32)
+		*b = binary.BigEndian.AppendUint32(*b, uint32(n))
+	}
+}
+
+func (b *msgpackBuffer) arrayHeader(n int) {
+	b.header(0x90, 15, n, 0xdc, 0xdd)
+}
+
+func (b *msgpackBuffer) mapHeader(n int) {
+	b.header(0x80, 15, n, 0xde, 0xdf)
+}
+
+func (b *msgpackBuffer) str(s string) {
+	if len(s) > 31 && len(s) <= math.MaxUint8 {
+		*b = append(*b, 0xd9, byte(len(s)))
+	} else {
+		b.header(0xa0, 31, len(s), 0xda, 0xdb)
+	}
+	*b = append(*b, s...)
+}
+
+// eventTime encodes the Fluentd EventTime extension type.
+func (b *msgpackBuffer) eventTime(sec int64, nsec int) {
+	*b = append(*b, 0xd7, 0x00)
+	*b = binary.BigEndian.AppendUint32(*b, uint32(sec))
+	*b = binary.BigEndian.AppendUint32(*b, uint32(nsec))
+}
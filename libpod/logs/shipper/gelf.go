@@ -0,0 +1,183 @@
+package shipper
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// gelfChunkSize is the maximum size of a UDP datagram, chunks include
+	// a 12 byte header.
+	gelfChunkSize = 1420
+	gelfMaxChunks = 128
+)
+
+var (
+	gelfChunkMagic = []byte{0x1e, 0x0f}
+	gelfFieldRegex = regexp.MustCompile(`[^\w.\-]`)
+)
+
+// gelfShipper ships messages to a Graylog server.  Messages are sent
+// compressed and chunked over UDP and null byte delimited over TCP.
+type gelfShipper struct {
+	conn
+	compression string
+	fields      map[string]any
+}
+
+func newGELF(options map[string]string, info Info) (*gelfShipper, error) {
+	address := options["gelf-address"]
+	if address == "" {
+		return nil, fmt.Errorf("the %s log driver requires the gelf-address log option", define.GELFLogging)
+	}
+	network, addr, err := parseAddress(address, "udp", "udp", "tcp")
+	if err != nil {
+		return nil, fmt.Errorf("gelf-address: %w", err)
+	}
+	compression := options["gelf-compression-type"]
+	switch compression {
+	case "":
+		compression = "gzip"
+	case "gzip", "zlib", "none":
+	default:
+		return nil, fmt.Errorf("invalid gelf-compression-type %q: must be gzip, zlib or none", compression)
+	}
+
+	host := info.Hostname
+	if host == "" {
+		host = "localhost"
+	}
+	fields := map[string]any{
+		"version":         "1.1",
+		"host":            host,
+		"_container_id":   info.ID,
+		"_container_name": info.Name,
+		"_image_name":     info.ImageName,
+		"_tag":            info.Tag,
+	}
+	for k, v := range info.Labels {
+		name := "_" + gelfFieldRegex.ReplaceAllString(k, "_")
+		if _, ok := fields[name]; ok || name == "_id" {
+			logrus.Debugf("Skipping GELF field %q, it is reserved", name)
+			continue
+		}
+		fields[name] = v
+	}
+	return &gelfShipper{
+		conn:        conn{network: network, address: addr},
+		compression: compression,
+		fields:      fields,
+	}, nil
+}
+
+func (g *gelfShipper) encode(m Message) ([]byte, error) {
+	// informational for stdout, error for stderr
+	level := 6
+	if m.Stream == "stderr" {
+		level = 3
+	}
+	msg := make(map[string]any, len(g.fields)+3)
+	for k, v := range g.fields {
+		msg[k] = v
+	}
+	msg["short_message"] = m.Msg
+	msg["timestamp"] = float64(m.Time.UnixMilli()) / 1000
+	msg["level"] = level
+	return json.Marshal(msg)
+}
+
+func (g *gelfShipper) compress(b []byte) ([]byte, error) {
+	var (
+		buf bytes.Buffer
+		w   io.WriteCloser
+	)
+	switch g.compression {
+	case "gzip":
+		w = gzip.NewWriter(&buf)
+	case "zlib":
+		w = zlib.NewWriter(&buf)
+	default:
+		return b, nil
+	}
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// chunks splits a message into GELF chunks if it does not fit into a
+// single datagram.
+func gelfChunks(b []byte) ([][]byte, error) {
+	if len(b) <= gelfChunkSize {
+		return [][]byte{b}, nil
+	}
+	dataSize := gelfChunkSize - 12
+	count := (len(b) + dataSize - 1) / dataSize
+	if count > gelfMaxChunks {
+		return nil, errMessageTooLarge
+	}
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	chunks := make([][]byte, 0, count)
+	for i := range count {
+		data := b[i*dataSize : min((i+1)*dataSize, len(b))]
+		chunk := make([]byte, 0, 12+len(data))
+		chunk = append(chunk, gelfChunkMagic...)
+		chunk = append(chunk, id...)
+		chunk = append(chunk, byte(i), byte(count))
+		chunks = append(chunks, append(chunk, data...))
+	}
+	return chunks, nil
+}
+
+// Ship sends the messages to the Graylog server.
+func (g *gelfShipper) Ship(msgs []Message) error {
+	if !g.isDatagram() {
+		var buf bytes.Buffer
+		for _, m := range msgs {
+			b, err := g.encode(m)
+			if err != nil {
+				return err
+			}
+			buf.Write(b)
+			buf.WriteByte(0)
+		}
+		return g.write(buf.Bytes())
+	}
+
+	for _, m := range msgs {
+		b, err := g.encode(m)
+		if err != nil {
+			return err
+		}
+		if b, err = g.compress(b); err != nil {
+			return err
+		}
+		chunks, err := gelfChunks(b)
+		if err != nil {
+			// Retrying will not help, drop the message.
+			logrus.Errorf("Dropping GELF log message of %d bytes: %v", len(b), err)
+			continue
+		}
+		for _, chunk := range chunks {
+			if err := g.write(chunk); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
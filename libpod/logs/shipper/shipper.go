@@ -0,0 +1,187 @@
+// Package shipper sends container log messages to remote log servers.
+package shipper
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/containers/podman/v6/libpod/define"
+)
+
+const (
+	dialTimeout  = 5 * time.Second
+	writeTimeout = 10 * time.Second
+)
+
+// Message is a complete log message of a container.
+type Message struct {
+	// Time is the time the message was logged.
+	Time time.Time
+	// Stream is the stream the message was logged to, stdout or stderr.
+	Stream string
+	// Msg is the message without trailing newline.
+	Msg string
+}
+
+// Info describes the container the log messages belong to.
+type Info struct {
+	ID        string
+	Name      string
+	ImageName string
+	Hostname  string
+	// Tag identifies the container in the log server.
+	Tag string
+	// Labels are added to every message.
+	Labels map[string]string
+}
+
+// Shipper sends log messages to a remote log server.
+type Shipper interface {
+	// Ship sends the messages.  If an error is returned, the messages
+	// should be sent again.
+	Ship(msgs []Message) error
+	// Close closes the connection to the log server.
+	Close() error
+}
+
+// driverOptions are the options supported by each driver.
+var driverOptions = map[string][]string{
+	define.SyslogLogging:  {"syslog-address", "syslog-facility"},
+	define.GELFLogging:    {"gelf-address", "gelf-compression-type"},
+	define.FluentdLogging: {"fluentd-address"},
+}
+
+// IsShippingDriver returns whether the log driver ships log messages to a
+// remote log server.
+func IsShippingDriver(driver string) bool {
+	_, ok := driverOptions[driver]
+	return ok
+}
+
+// IsDriverOption returns whether the log option is an option of any of the
+// shipping log drivers.
+func IsDriverOption(key string) bool {
+	for prefix := range driverOptions {
+		if strings.HasPrefix(key, prefix+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateOptions validates the options of a shipping log driver.
+func ValidateOptions(driver string, options map[string]string) error {
+	_, err := New(driver, options, Info{})
+	return err
+}
+
+// New returns a Shipper for the given log driver.  The connection to the log
+// server is established when messages are shipped.
+func New(driver string, options map[string]string, info Info) (Shipper, error) {
+	supported, ok := driverOptions[driver]
+	if !ok {
+		return nil, fmt.Errorf("log driver %q does not ship logs", driver)
+	}
+	for key := range options {
+		if !slices.Contains(supported, key) {
+			return nil, fmt.Errorf("unknown log option %q for log driver %q", key, driver)
+		}
+	}
+	switch driver {
+	case define.SyslogLogging:
+		return newSyslog(options, info)
+	case define.GELFLogging:
+		return newGELF(options, info)
+	default:
+		return newFluentd(options, info)
+	}
+}
+
+// parseAddress parses an address of the form proto://address.  An address
+// without protocol uses defaultProto.
+func parseAddress(address, defaultProto string, protos ...string) (string, string, error) {
+	if !strings.Contains(address, "://") {
+		address = defaultProto + "://" + address
+	}
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid address %q: %w", address, err)
+	}
+	if !slices.Contains(protos, u.Scheme) {
+		return "", "", fmt.Errorf("invalid address %q: protocol must be one of %s", address, strings.Join(protos, ", "))
+	}
+	switch u.Scheme {
+	case "unix", "unixgram":
+		if u.Path == "" {
+			return "", "", fmt.Errorf("invalid address %q: missing socket path", address)
+		}
+		return u.Scheme, u.Path, nil
+	}
+	if _, _, err := net.SplitHostPort(u.Host); err != nil {
+		return "", "", fmt.Errorf("invalid address %q: %w", address, err)
+	}
+	return u.Scheme, u.Host, nil
+}
+
+// conn is a lazily established connection which is reestablished after a
+// write error.
+type conn struct {
+	network string
+	address string
+	c       net.Conn
+}
+
+// connect establishes the connection unless it is already established.
+func (c *conn) connect() error {
+	if c.c != nil {
+		return nil
+	}
+	nc, err := net.DialTimeout(c.network, c.address, dialTimeout)
+	if err != nil && c.network == "unix" {
+		// Sockets like /dev/log are datagram sockets.
+		if nc, err = net.DialTimeout("unixgram", c.address, dialTimeout); err == nil {
+			c.network = "unixgram"
+		}
+	}
+	if err != nil {
+		return err
+	}
+	c.c = nc
+	return nil
+}
+
+func (c *conn) write(b []byte) error {
+	if err := c.connect(); err != nil {
+		return err
+	}
+	if err := c.c.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return err
+	}
+	if _, err := c.c.Write(b); err != nil {
+		c.c.Close()
+		c.c = nil
+		return err
+	}
+	return nil
+}
+
+func (c *conn) Close() error {
+	if c.c == nil {
+		return nil
+	}
+	err := c.c.Close()
+	c.c = nil
+	return err
+}
+
+// isDatagram returns whether messages are sent as datagrams.
+func (c *conn) isDatagram() bool {
+	return c.network == "udp" || c.network == "unixgram"
+}
+
+var errMessageTooLarge = errors.New("message too large")
@@ -0,0 +1,174 @@
+package shipper
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testInfo = Info{
+	ID:        "0123456789ab",
+	Name:      "web",
+	ImageName: "quay.io/libpod/alpine:latest",
+	Hostname:  "host1",
+	Tag:       "web-tag",
+	Labels:    map[string]string{"env": `prod "eu"`},
+}
+
+var testTime = time.Date(2024, 5, 1, 12, 30, 15, 123456789, time.UTC)
+
+func listenUDP(t *testing.T) *net.UDPConn {
+	l, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	require.NoError(t, l.SetReadDeadline(time.Now().Add(10*time.Second)))
+	return l
+}
+
+func readDatagram(t *testing.T, l *net.UDPConn) []byte {
+	buf := make([]byte, 65536)
+	n, _, err := l.ReadFrom(buf)
+	require.NoError(t, err)
+	return buf[:n]
+}
+
+func TestSyslogUDP(t *testing.T) {
+	l := listenUDP(t)
+	s, err := New(define.SyslogLogging, map[string]string{"syslog-address": "udp://" + l.LocalAddr().String(), "syslog-facility": "local0"}, testInfo)
+	require.NoError(t, err)
+	defer s.Close()
+
+	require.NoError(t, s.Ship([]Message{
+		{Time: testTime, Stream: "stdout", Msg: "hello"},
+		{Time: testTime, Stream: "stderr", Msg: "oops"},
+	}))
+	assert.Equal(t, `<134>1 2024-05-01T12:30:15.123456Z host1 web-tag - - [podman@32473 env="prod \"eu\""] hello`, string(readDatagram(t, l)))
+	assert.Equal(t, `<131>1 2024-05-01T12:30:15.123456Z host1 web-tag - - [podman@32473 env="prod \"eu\""] oops`, string(readDatagram(t, l)))
+}
+
+func TestSyslogTCP(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	s, err := New(define.SyslogLogging, map[string]string{"syslog-address": "tcp://" + l.Addr().String()}, Info{Tag: "tag"})
+	require.NoError(t, err)
+	defer s.Close()
+	require.NoError(t, s.Ship([]Message{{Time: testTime, Stream: "stdout", Msg: "a"}, {Time: testTime, Stream: "stdout", Msg: "b"}}))
+
+	c, err := l.Accept()
+	require.NoError(t, err)
+	defer c.Close()
+	line := "<30>1 2024-05-01T12:30:15.123456Z - tag - - - "
+	expected := "47 " + line + "a47 " + line + "b"
+	buf := make([]byte, len(expected))
+	_, err = io.ReadFull(c, buf)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(buf), "octet counting framing")
+}
+
+func TestGELFUDP(t *testing.T) {
+	l := listenUDP(t)
+	s, err := New(define.GELFLogging, map[string]string{"gelf-address": "udp://" + l.LocalAddr().String()}, testInfo)
+	require.NoError(t, err)
+	defer s.Close()
+	require.NoError(t, s.Ship([]Message{{Time: testTime, Stream: "stderr", Msg: "oops"}}))
+
+	zr, err := gzip.NewReader(strings.NewReader(string(readDatagram(t, l))))
+	require.NoError(t, err)
+	var msg map[string]any
+	require.NoError(t, json.NewDecoder(zr).Decode(&msg))
+	assert.Equal(t, "1.1", msg["version"])
+	assert.Equal(t, "host1", msg["host"])
+	assert.Equal(t, "oops", msg["short_message"])
+	assert.Equal(t, 3.0, msg["level"])
+	assert.Equal(t, 1714566615.123, msg["timestamp"])
+	assert.Equal(t, "web", msg["_container_name"])
+	assert.Equal(t, "web-tag", msg["_tag"])
+	assert.Equal(t, `prod "eu"`, msg["_env"])
+}
+
+func TestGELFChunks(t *testing.T) {
+	b := make([]byte, 3000)
+	chunks, err := gelfChunks(b)
+	require.NoError(t, err)
+	require.Len(t, chunks, 3)
+	for i, c := range chunks {
+		assert.LessOrEqual(t, len(c), gelfChunkSize)
+		assert.Equal(t, gelfChunkMagic, c[:2])
+		assert.Equal(t, chunks[0][2:10], c[2:10], "same message id")
+		assert.Equal(t, []byte{byte(i), 3}, c[10:12])
+	}
+
+	_, err = gelfChunks(make([]byte, gelfMaxChunks*gelfChunkSize))
+	assert.ErrorIs(t, err, errMessageTooLarge)
+}
+
+func TestFluentd(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	s, err := New(define.FluentdLogging, map[string]string{"fluentd-address": l.Addr().String()}, Info{ID: "abc", Name: "web", Tag: "docker.web"})
+	require.NoError(t, err)
+	defer s.Close()
+	require.NoError(t, s.Ship([]Message{{Time: testTime, Stream: "stdout", Msg: "hello"}}))
+
+	c, err := l.Accept()
+	require.NoError(t, err)
+	defer c.Close()
+	r := bufio.NewReader(c)
+	prefix := []byte{
+		0x92,                                                   // [tag, entries]
+		0xaa, 'd', 'o', 'c', 'k', 'e', 'r', '.', 'w', 'e', 'b', // tag
+		0x91,       // one entry
+		0x92,       // [time, record]
+		0xd7, 0x00, // EventTime
+		0x66, 0x32, 0x35, 0xd7, // seconds
+		0x07, 0x5b, 0xcd, 0x15, // nanoseconds
+		0x84, // record with 4 fields
+	}
+	buf := make([]byte, len(prefix))
+	_, err = io.ReadFull(r, buf)
+	require.NoError(t, err)
+	assert.Equal(t, prefix, buf)
+}
+
+func TestValidateOptions(t *testing.T) {
+	for _, tt := range []struct {
+		driver  string
+		options map[string]string
+		err     string
+	}{
+		{define.SyslogLogging, nil, ""},
+		{define.SyslogLogging, map[string]string{"syslog-address": "unix:///dev/log"}, ""},
+		{define.SyslogLogging, map[string]string{"syslog-address": "http://host:514"}, "protocol must be one of"},
+		{define.SyslogLogging, map[string]string{"syslog-address": "udp://host"}, "missing port"},
+		{define.SyslogLogging, map[string]string{"syslog-facility": "nope"}, "invalid syslog-facility"},
+		{define.SyslogLogging, map[string]string{"gelf-address": "udp://host:12201"}, `unknown log option "gelf-address"`},
+		{define.GELFLogging, nil, "requires the gelf-address log option"},
+		{define.GELFLogging, map[string]string{"gelf-address": "host:12201", "gelf-compression-type": "zlib"}, ""},
+		{define.GELFLogging, map[string]string{"gelf-address": "host:12201", "gelf-compression-type": "lz4"}, "invalid gelf-compression-type"},
+		{define.FluentdLogging, nil, ""},
+		{define.FluentdLogging, map[string]string{"fluentd-address": "udp://host:24224"}, "protocol must be one of"},
+		{"k8s-file", nil, "does not ship logs"},
+	} {
+		err := ValidateOptions(tt.driver, tt.options)
+		if tt.err == "" {
+			assert.NoError(t, err, "%s %v", tt.driver, tt.options)
+		} else {
+			assert.ErrorContains(t, err, tt.err, "%s %v", tt.driver, tt.options)
+		}
+	}
+	assert.True(t, IsDriverOption("syslog-address"))
+	assert.False(t, IsDriverOption("max-size"))
+}
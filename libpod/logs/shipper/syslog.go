@@ -0,0 +1,136 @@
+package shipper
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+const (
+	defaultSyslogAddress = "unixgram:///dev/log"
+	// syslogSDID is the ID of the structured data element with the log
+	// labels, 32473 is the private enterprise number reserved for
+	// documentation.
+	syslogSDID = "podman@32473"
+	// syslogTimeFormat is the RFC 3339 time format with microseconds.
+	syslogTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+var syslogFacilities = map[string]int{
+	"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5,
+	"lpr": 6, "news": 7, "uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11,
+	"local0": 16, "local1": 17, "local2": 18, "local3": 19,
+	"local4": 20, "local5": 21, "local6": 22, "local7": 23,
+}
+
+// syslogShipper ships messages to a syslog server in the RFC 5424 format.
+// Messages are sent as datagrams over UDP and unix datagram sockets and with
+// octet counting framing (RFC 6587) over TCP and unix stream sockets.
+type syslogShipper struct {
+	conn
+	facility int
+	header   string
+	sd       string
+}
+
+func newSyslog(options map[string]string, info Info) (*syslogShipper, error) {
+	address := options["syslog-address"]
+	if address == "" {
+		address = defaultSyslogAddress
+	}
+	network, addr, err := parseAddress(address, "udp", "udp", "tcp", "unix", "unixgram")
+	if err != nil {
+		return nil, fmt.Errorf("syslog-address: %w", err)
+	}
+
+	facility := "daemon"
+	if f := options["syslog-facility"]; f != "" {
+		facility = f
+	}
+	f, ok := syslogFacilities[facility]
+	if !ok {
+		return nil, fmt.Errorf("invalid syslog-facility %q", facility)
+	}
+
+	s := &syslogShipper{
+		conn:     conn{network: network, address: addr},
+		facility: f,
+		header:   syslogField(info.Hostname, 255) + " " + syslogField(info.Tag, 48) + " - -",
+		sd:       syslogStructuredData(info.Labels),
+	}
+	return s, nil
+}
+
+// syslogField returns s as a syslog header field: printable ASCII without
+// spaces of at most maxLen characters, "-" if empty.
+func syslogField(s string, maxLen int) string {
+	s = strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' {
+			return '_'
+		}
+		return r
+	}, s)
+	if s == "" {
+		return "-"
+	}
+	if len(s) > maxLen {
+		s = s[:maxLen]
+	}
+	return s
+}
+
+// syslogStructuredData returns the labels as structured data element.
+func syslogStructuredData(labels map[string]string) string {
+	if len(labels) == 0 {
+		return "-"
+	}
+	var b strings.Builder
+	b.WriteString("[" + syslogSDID)
+	for _, k := range slices.Sorted(maps.Keys(labels)) {
+		name := strings.Map(func(r rune) rune {
+			if r <= ' ' || r > '~' || r == '=' || r == ']' || r == '"' {
+				return '_'
+			}
+			return r
+		}, k)
+		if len(name) > 32 {
+			name = name[:32]
+		}
+		value := strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(labels[k])
+		fmt.Fprintf(&b, ` %s="%s"`, name, value)
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+func (s *syslogShipper) format(m Message) string {
+	// informational for stdout, error for stderr
+	severity := 6
+	if m.Stream == "stderr" {
+		severity = 3
+	}
+	return fmt.Sprintf("<%d>1 %s %s %s %s", s.facility*8+severity, m.Time.Format(syslogTimeFormat), s.header, s.sd, m.Msg)
+}
+
+// Ship sends the messages to the syslog server.
+func (s *syslogShipper) Ship(msgs []Message) error {
+	// Connect first, the framing depends on the socket type.
+	if err := s.connect(); err != nil {
+		return err
+	}
+	if s.isDatagram() {
+		for _, m := range msgs {
+			if err := s.write([]byte(s.format(m))); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var b strings.Builder
+	for _, m := range msgs {
+		line := s.format(m)
+		fmt.Fprintf(&b, "%d %s", len(line), line)
+	}
+	return s.write([]byte(b.String()))
+}
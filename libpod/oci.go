@@ -69,6 +69,9 @@ type OCIRuntime interface { //nolint:interfacebloat
 	HTTPAttach(ctr *Container, r *http.Request, w http.ResponseWriter, streams *HTTPAttachStreams, detachKeys *string, cancel <-chan bool, hijackDone chan<- bool, streamAttach, streamLogs bool) error
 	// AttachResize resizes the terminal in use by the given container.
 	AttachResize(ctr *Container, newSize resize.TerminalSize) error
+	// ReopenContainerLog makes the runtime reopen the log file of the
+	// given container, after it was rotated.
+	ReopenContainerLog(ctr *Container) error
 
 	// ExecContainer executes a command in a running container.
 	// Returns an int (PID of exec session), error channel (errors from
@@ -18,7 +18,6 @@ import (
 	"strings"
 	"sync"
 	"syscall"
-	"text/template"
 	"time"
 
 	"github.com/containers/podman/v6/libpod/define"
@@ -725,6 +724,22 @@ func (r *ConmonOCIRuntime) AttachResize(ctr *Container, newSize resize.TerminalS
 	return nil
 }
 
+// ReopenContainerLog makes conmon reopen the log file of the given container.
+func (r *ConmonOCIRuntime) ReopenContainerLog(ctr *Container) error {
+	controlFile, err := openControlFile(ctr, ctr.bundlePath())
+	if err != nil {
+		return err
+	}
+	defer controlFile.Close()
+
+	logrus.Debugf("Reopening log file of container %s", ctr.ID())
+	if _, err = fmt.Fprintf(controlFile, "%d %d %d\n", 2, 0, 0); err != nil {
+		return fmt.Errorf("failed to write to ctl file to reopen log file: %w", err)
+	}
+
+	return nil
+}
+
 // CheckpointContainer checkpoints the given container.
 func (r *ConmonOCIRuntime) CheckpointContainer(ctr *Container, options ContainerCheckpointOptions) (int64, error) {
 	// imagePath is used by CRIU to store the actual checkpoint files
@@ -759,11 +774,15 @@ func (r *ConmonOCIRuntime) CheckpointContainer(ctr *Container, options Container
 	if options.PreCheckPoint {
 		args = append(args, "--pre-dump")
 	}
-	if !options.PreCheckPoint && options.WithPrevious {
+	if options.WithPrevious {
+		parentDir := preCheckpointDir
+		if options.PreCheckPoint {
+			parentDir = preCheckpointChainDir(ctr.preCheckpointChainLength())
+		}
 		args = append(
 			args,
 			"--parent-path",
-			filepath.Join("..", preCheckpointDir),
+			filepath.Join("..", parentDir),
 		)
 	}
 
@@ -924,53 +943,6 @@ func waitPidStop(pid int, timeout time.Duration) error {
 	}
 }
 
-func (r *ConmonOCIRuntime) getLogData(ctr *Container) (string, map[string]string, error) {
-	logTag := ctr.LogTag()
-	logLabels := ctr.LogLabels()
-
-	// inspectLocked is expensive, skip it if possible
-	if logTag == "" && len(logLabels) == 0 {
-		return "", nil, nil
-	}
-
-	data, err := ctr.inspectLocked(false)
-	if err != nil {
-		// FIXME: this error should probably be returned
-		return "", nil, nil //nolint: nilerr
-	}
-
-	var parsedLogTag string
-	if logTag != "" {
-		tmpl, err := template.New("container").Parse(logTag)
-		if err != nil {
-			return "", nil, fmt.Errorf("template parsing error %s: %w", logTag, err)
-		}
-		var b bytes.Buffer
-		err = tmpl.Execute(&b, data)
-		if err != nil {
-			return "", nil, err
-		}
-		parsedLogTag = b.String()
-	}
-
-	parsedLogLabels := make(map[string]string)
-	for labelKey, labelValue := range logLabels {
-		tmpl, err := template.New("container").Parse(labelValue)
-		if err != nil {
-			return "", nil, fmt.Errorf("template parsing error %s (label %s): %w", labelValue, labelKey, err)
-		}
-
-		var b bytes.Buffer
-		err = tmpl.Execute(&b, data)
-		if err != nil {
-			return "", nil, err
-		}
-		parsedLogLabels[labelKey] = b.String()
-	}
-
-	return parsedLogTag, parsedLogLabels, nil
-}
-
 func getPreserveFdExtraFiles(preserveFD []uint, preserveFDs uint) (uint, []*os.File, []*os.File, error) {
 	var filesToClose []*os.File
 	var extraFiles []*os.File
@@ -1026,7 +998,7 @@ func (r *ConmonOCIRuntime) createOCIContainer(ctr *Container, restoreOptions *Co
 		ociLog = filepath.Join(ctr.state.RunDir, "oci-log")
 	}
 
-	logTag, logLabels, err := r.getLogData(ctr)
+	logTag, logLabels, err := ctr.logTagAndLabels()
 	if err != nil {
 		return 0, err
 	}
@@ -1370,6 +1342,9 @@ func (r *ConmonOCIRuntime) sharedConmonArgs(ctr *Container, cuuid, bundlePath, p
 		fallthrough
 	case define.JSONLogging:
 		fallthrough
+	case define.SyslogLogging, define.GELFLogging, define.FluentdLogging:
+		// Logs are shipped from the local log file.
+		fallthrough
 	case define.KubernetesLogging:
 		logDriverArg = fmt.Sprintf("%s:%s", define.KubernetesLogging, logPath)
 	}
@@ -129,6 +129,11 @@ func (r *MissingRuntime) AttachResize(_ *Container, _ resize.TerminalSize) error
 	return r.printError()
 }
 
+// ReopenContainerLog is not available as the runtime is missing
+func (r *MissingRuntime) ReopenContainerLog(_ *Container) error {
+	return r.printError()
+}
+
 // ExecContainer is not available as the runtime is missing
 func (r *MissingRuntime) ExecContainer(_ *Container, _ string, _ *ExecOptions, _ *define.AttachStreams, _ *resize.TerminalSize) (int, chan error, error) {
 	return -1, nil, r.printError()
@@ -417,8 +417,9 @@ func WithRenumber() RuntimeOption {
 }
 
 // WithEventsLogger sets the events backend to use.
-// Currently supported values are "file" for file backend and "journald" for
-// journald backend.
+// Currently supported values are "file" for file backend, "journald" for
+// journald backend and "webhook" for the file backend forwarding to an HTTP
+// endpoint.
 func WithEventsLogger(logger string) RuntimeOption {
 	return func(rt *Runtime) error {
 		if rt.valid {
@@ -477,6 +478,37 @@ func WithMaxLogSize(limit int64) CtrCreateOption {
 	}
 }
 
+// WithLogDriverOptions sets the options of log drivers which ship logs to a
+// remote log server, e.g. the address of the server.
+func WithLogDriverOptions(options map[string]string) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		ctr.config.LogDriverOptions = options
+		return nil
+	}
+}
+
+// WithLogRotation sets how the container's log file is rotated.  maxFiles is
+// the maximum number of log files to keep, including the active one.  Rotated
+// files are compressed if compress is set and removed once they are older than
+// maxAge, if it is not zero.
+func WithLogRotation(maxFiles uint, compress bool, maxAge time.Duration) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		if maxAge < 0 {
+			return fmt.Errorf("log max-age must not be negative: %w", define.ErrInvalidArg)
+		}
+		ctr.config.LogMaxFiles = maxFiles
+		ctr.config.LogCompress = compress
+		ctr.config.LogMaxAge = maxAge
+		return nil
+	}
+}
+
 // WithShmDir sets the directory that should be mounted on /dev/shm.
 func WithShmDir(dir string) CtrCreateOption {
 	return func(ctr *Container) error {
@@ -1003,7 +1035,8 @@ func WithLogDriver(driver string) CtrCreateOption {
 		switch driver {
 		case "":
 			return fmt.Errorf("log driver must be set: %w", define.ErrInvalidArg)
-		case define.JournaldLogging, define.KubernetesLogging, define.JSONLogging, define.NoLogging, define.PassthroughLogging, define.PassthroughTTYLogging:
+		case define.JournaldLogging, define.KubernetesLogging, define.JSONLogging, define.NoLogging, define.PassthroughLogging, define.PassthroughTTYLogging,
+			define.SyslogLogging, define.GELFLogging, define.FluentdLogging:
 			break
 		default:
 			return fmt.Errorf("invalid log driver: %w", define.ErrInvalidArg)
@@ -1337,6 +1370,41 @@ func WithRestartRetries(tries uint) CtrCreateOption {
 	}
 }
 
+// WithRestartBackoff enables an exponential backoff for restarts done by the
+// container's restart policy. The first restart is delayed by delay, every
+// consecutive restart doubles the delay up to maxDelay. The delay is reset
+// once the container ran for resetWindow. If maxDelay or resetWindow are 0,
+// the defaults are used.
+func WithRestartBackoff(delay, maxDelay, resetWindow time.Duration) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		if delay <= 0 {
+			return fmt.Errorf("restart delay must be greater than 0: %w", define.ErrInvalidArg)
+		}
+		if maxDelay == 0 {
+			maxDelay = max(delay, define.DefaultRestartMaxDelay)
+		}
+		if maxDelay < delay {
+			return fmt.Errorf("maximum restart delay %s must not be smaller than the restart delay %s: %w", maxDelay, delay, define.ErrInvalidArg)
+		}
+		if resetWindow == 0 {
+			resetWindow = define.DefaultRestartResetWindow
+		}
+		if resetWindow < 0 {
+			return fmt.Errorf("restart reset window must not be negative: %w", define.ErrInvalidArg)
+		}
+
+		ctr.config.RestartDelay = delay
+		ctr.config.RestartMaxDelay = maxDelay
+		ctr.config.RestartResetWindow = resetWindow
+
+		return nil
+	}
+}
+
 // WithNamedVolumes adds the given named volumes to the container.
 func WithNamedVolumes(volumes []*ContainerNamedVolume) CtrCreateOption {
 	return func(ctr *Container) error {
@@ -1729,6 +1797,25 @@ func WithVolumeDisableQuota() VolumeCreateOption {
 	}
 }
 
+// WithVolumeQuota sets the backend used to enforce the size and inodes limits
+// of the volume, either define.VolumeQuotaProject or define.VolumeQuotaLoop.
+func WithVolumeQuota(backend string) VolumeCreateOption {
+	return func(volume *Volume) error {
+		if volume.valid {
+			return define.ErrVolumeFinalized
+		}
+
+		switch backend {
+		case define.VolumeQuotaProject, define.VolumeQuotaLoop:
+		default:
+			return fmt.Errorf("invalid volume quota %q, must be %q or %q: %w", backend, define.VolumeQuotaProject, define.VolumeQuotaLoop, define.ErrInvalidArg)
+		}
+		volume.config.Quota = backend
+
+		return nil
+	}
+}
+
 // WithVolumeAnonymous sets a bool notifying libpod that this volume is anonymous and
 // should be removed when containers using it are removed and volumes are
 // specified for removal.
@@ -1904,6 +1991,20 @@ func WithStartupHealthcheck(startupHC *define.StartupHealthCheck) CtrCreateOptio
 	}
 }
 
+// WithReadinessCheck sets a readiness check for the container.
+func WithReadinessCheck(readinessCheck *define.ReadinessCheck) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		ctr.config.ReadinessCheckConfig = new(define.ReadinessCheck)
+		if err := JSONDeepCopy(readinessCheck, ctr.config.ReadinessCheckConfig); err != nil {
+			return fmt.Errorf("error copying readiness check into container: %w", err)
+		}
+		return nil
+	}
+}
+
 // Pod Creation Options
 
 // WithPodCreateCommand adds the full command plus arguments of the current
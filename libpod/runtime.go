@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"slices"
@@ -777,6 +778,12 @@ func (r *Runtime) Shutdown(force bool) error {
 			lastError = fmt.Errorf("shutting down container storage: %w", err)
 		}
 	}
+	// Give eventers delivering events asynchronously a chance to finish.
+	if closer, ok := r.eventer.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			logrus.Errorf("Closing event logger: %v", err)
+		}
+	}
 	if err := r.state.Close(); err != nil {
 		if lastError != nil {
 			logrus.Error(lastError)
@@ -22,6 +22,7 @@ import (
 	"github.com/containers/podman/v6/pkg/domain/entities/reports"
 	"github.com/containers/podman/v6/pkg/rootless"
 	"github.com/containers/podman/v6/pkg/specgen"
+	"github.com/containers/podman/v6/pkg/tracing"
 	"github.com/containers/podman/v6/pkg/util"
 	"github.com/docker/go-units"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
@@ -45,7 +46,7 @@ type CtrCreateOption func(*Container) error
 type ContainerFilter func(*Container) bool
 
 // NewContainer creates a new container from a given OCI config.
-func (r *Runtime) NewContainer(ctx context.Context, rSpec *spec.Spec, spec *specgen.SpecGenerator, infra bool, options ...CtrCreateOption) (*Container, error) {
+func (r *Runtime) NewContainer(ctx context.Context, rSpec *spec.Spec, spec *specgen.SpecGenerator, infra bool, options ...CtrCreateOption) (_ *Container, retErr error) {
 	if !r.valid {
 		return nil, define.ErrRuntimeStopped
 	}
@@ -55,7 +56,20 @@ func (r *Runtime) NewContainer(ctx context.Context, rSpec *spec.Spec, spec *spec
 			options = append(options, withIsDefaultInfra())
 		}
 	}
-	return r.newContainer(ctx, rSpec, options...)
+
+	ctx, span := tracing.Start(ctx, "libpod.container.create")
+	defer func() { tracing.End(span, retErr) }()
+
+	ctr, err := r.newContainer(ctx, rSpec, options...)
+	if err != nil {
+		return nil, err
+	}
+	span.SetAttributes(
+		tracing.ContainerIDKey.String(ctr.ID()),
+		tracing.ContainerNameKey.String(ctr.Name()),
+		tracing.ImageKey.String(ctr.config.RootfsImageName),
+	)
+	return ctr, nil
 }
 
 func (r *Runtime) PrepareVolumeOnCreateContainer(_ context.Context, ctr *Container) error {
@@ -491,6 +505,9 @@ func (r *Runtime) setupContainer(ctx context.Context, ctr *Container) (_ *Contai
 		return nil, err
 	}
 	for _, secr := range ctr.config.Secrets {
+		if isOnDemandSecret(secr) {
+			continue
+		}
 		err = ctr.extractSecretToCtrStorage(secr)
 		if err != nil {
 			return nil, err
@@ -994,6 +1011,7 @@ func (r *Runtime) removeContainer(ctx context.Context, c *Container, opts ctrRmO
 	// Set ContainerStateRemoving as an intermediate state (we may get
 	// killed at any time) and save the container.
 	c.state.State = define.ContainerStateRemoving
+	c.cancelRestartBackoff()
 
 	if err := c.save(); err != nil {
 		if !errors.Is(err, define.ErrCtrRemoved) {
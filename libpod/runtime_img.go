@@ -6,14 +6,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	buildahDefine "github.com/containers/buildah/define"
 	"github.com/containers/buildah/imagebuildah"
 	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/libpod/events"
+	"github.com/opencontainers/go-digest"
 	"github.com/sirupsen/logrus"
 	"go.podman.io/common/libimage"
 	"go.podman.io/image/v5/docker/reference"
+	"go.podman.io/storage"
+	"go.podman.io/storage/pkg/archive"
 )
 
 // Runtime API
@@ -127,3 +131,26 @@ func (r *Runtime) Build(ctx context.Context, options buildahDefine.BuildOptions,
 	r.newImageBuildCompleteEvent(id)
 	return id, ref, err
 }
+
+// LayerByUncompressedDigest returns the uncompressed contents of a layer in
+// local storage with the given uncompressed digest, and their size if known
+// or -1 otherwise.
+func (r *Runtime) LayerByUncompressedDigest(d digest.Digest) (io.ReadCloser, int64, error) {
+	if !r.valid {
+		return nil, -1, define.ErrRuntimeStopped
+	}
+	layers, err := r.store.LayersByUncompressedDigest(d)
+	if err != nil {
+		return nil, -1, err
+	}
+	if len(layers) == 0 {
+		return nil, -1, fmt.Errorf("layer with digest %s: %w", d, storage.ErrLayerUnknown)
+	}
+	size := layers[0].UncompressedSize
+	if size <= 0 {
+		size = -1
+	}
+	uncompressed := archive.Uncompressed
+	rc, err := r.store.Diff("", layers[0].ID, &storage.DiffOptions{Compression: &uncompressed})
+	return rc, size, err
+}
@@ -89,7 +89,7 @@ func (r *Runtime) newVolume(ctx context.Context, noCreatePluginVolume bool, opti
 						return nil, fmt.Errorf("invalid volume option %s for driver 'local': %w", key, err)
 					}
 				}
-			case "o", "type", "uid", "gid", "size", "inodes", "noquota", "copy", "nocopy":
+			case "o", "type", "uid", "gid", "size", "inodes", "noquota", "quota", "copy", "nocopy":
 				// Do nothing, valid keys
 			default:
 				return nil, fmt.Errorf("invalid mount option %s for driver 'local': %w", key, define.ErrInvalidArg)
@@ -178,6 +178,19 @@ func (r *Runtime) newVolume(ctx context.Context, noCreatePluginVolume bool, opti
 			if volume.config.Size > 0 || volume.config.Inodes > 0 {
 				return nil, errors.New("volume options size and inodes cannot be used without quota")
 			}
+			if volume.config.Quota != "" {
+				return nil, errors.New("volume options quota and noquota cannot be used together")
+			}
+		case volume.config.Quota == define.VolumeQuotaLoop:
+			if volume.config.Size == 0 {
+				return nil, fmt.Errorf("volume option quota=%s requires the size option", define.VolumeQuotaLoop)
+			}
+			if volume.config.Options["type"] != "" || volume.config.Options["device"] != "" {
+				return nil, fmt.Errorf("volume option quota=%s cannot be used with the type and device options", define.VolumeQuotaLoop)
+			}
+			if err := volume.createVolumeImage(); err != nil {
+				return nil, err
+			}
 		case volume.config.Options["type"] == define.TypeTmpfs:
 			// tmpfs only supports Size
 			if volume.config.Inodes > 0 {
@@ -190,7 +203,7 @@ func (r *Runtime) newVolume(ctx context.Context, noCreatePluginVolume bool, opti
 				projectQuotaSupported = true
 			}
 			if !projectQuotaSupported {
-				return nil, errors.New("volume options size and inodes not supported. Filesystem does not support Project Quota")
+				return nil, fmt.Errorf("volume options size and inodes not supported. Filesystem does not support Project Quota, use the quota=%s option to back the volume with a filesystem image instead", define.VolumeQuotaLoop)
 			}
 			quota := quota.Quota{
 				Inodes: volume.config.Inodes,
@@ -0,0 +1,83 @@
+//go:build !remote && systemd
+
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/containers/podman/v6/pkg/systemd"
+	"github.com/sirupsen/logrus"
+)
+
+// createTransientTimer creates and starts a transient systemd timer and
+// service named unitName that runs podman with the given arguments.
+// timerOptions are passed to systemd-run and define when the timer fires.
+func createTransientTimer(unitName string, timerOptions []string, args ...string) error {
+	podman, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to get path for podman for timer %s: %w", unitName, err)
+	}
+
+	cmd := []string{"--property", "LogLevelMax=notice"}
+	if rootless.IsRootless() {
+		cmd = append(cmd, "--user")
+	}
+	path := os.Getenv("PATH")
+	if path != "" {
+		cmd = append(cmd, "--setenv=PATH="+path)
+	}
+	cmd = append(cmd, "--unit", unitName)
+	cmd = append(cmd, timerOptions...)
+	// StartLimitIntervalSec=0 so we don't hit the restart limit
+	cmd = append(cmd, "--property=StartLimitIntervalSec=0", podman)
+	if logrus.IsLevelEnabled(logrus.DebugLevel) {
+		cmd = append(cmd, "--log-level=debug", "--syslog")
+	}
+	cmd = append(cmd, args...)
+
+	logrus.Debugf("creating systemd-transient files: %s %s", "systemd-run", cmd)
+	systemdRun := exec.Command("systemd-run", cmd...)
+	if output, err := systemdRun.CombinedOutput(); err != nil {
+		exitError := &exec.ExitError{}
+		if errors.As(err, &exitError) {
+			return fmt.Errorf("systemd-run failed: %w: output: %s", err, strings.TrimSpace(string(output)))
+		}
+		return fmt.Errorf("failed to execute systemd-run: %w", err)
+	}
+	return nil
+}
+
+// removeTransientTimer stops and removes the transient systemd timer and
+// service named unitName.  Units that are not loaded are ignored.
+func removeTransientTimer(ctx context.Context, unitName string) error {
+	conn, err := systemd.ConnectToDBUS()
+	if err != nil {
+		return fmt.Errorf("unable to get systemd connection to remove timer %s: %w", unitName, err)
+	}
+	defer conn.Close()
+
+	// Stop the timer before the service to make sure the timer does not
+	// fire after the service is stopped.
+	for _, unit := range []string{unitName + ".timer", unitName + ".service"} {
+		stopChan := make(chan string)
+		if _, err := conn.StopUnitContext(ctx, unit, "ignore-dependencies", stopChan); err != nil {
+			if !strings.HasSuffix(err.Error(), " not loaded.") {
+				return fmt.Errorf("removing unit %q: %w", unit, err)
+			}
+		} else if err := systemdOpSuccessful(stopChan); err != nil {
+			return fmt.Errorf("stopping unit %q: %w", unit, err)
+		}
+	}
+	// systemd keeps failed transient services around, reset them so that the
+	// unit name can be reused.
+	if err := conn.ResetFailedUnitContext(ctx, unitName+".service"); err != nil {
+		logrus.Debugf("Failed to reset unit file: %q", err)
+	}
+	return nil
+}
@@ -64,6 +64,10 @@ type VolumeConfig struct {
 	// DisableQuota indicates that the volume should completely disable using any
 	// quota tracking.
 	DisableQuota bool `json:"disableQuota,omitempty"`
+	// Quota is the backend enforcing Size and Inodes, either
+	// define.VolumeQuotaProject or define.VolumeQuotaLoop. Empty means
+	// project quotas.
+	Quota string `json:"quota,omitempty"`
 	// Timeout allows users to override the default driver timeout of 5 seconds
 	Timeout *uint `json:"timeout,omitempty"`
 	// StorageName is the name of the volume in c/storage. Only used for
@@ -112,6 +116,8 @@ type VolumeState struct {
 	UIDChowned int `json:"uidChowned,omitempty"`
 	// GIDChowned is the GID the volume was chowned to.
 	GIDChowned int `json:"gidChowned,omitempty"`
+	// Snapshots are the snapshots of the volume, oldest first.
+	Snapshots []VolumeSnapshot `json:"snapshots,omitempty"`
 }
 
 // Name retrieves the volume's name
@@ -66,6 +66,12 @@ func (v *Volume) Inspect() (*define.InspectVolumeData, error) {
 	data.StorageID = v.config.StorageID
 	data.LockNumber = v.lock.ID()
 
+	usage, err := v.usage()
+	if err != nil {
+		logrus.Warnf("Reading disk usage of volume %s: %v", v.Name(), err)
+	}
+	data.Usage = usage
+
 	if v.config.Timeout != nil {
 		data.Timeout = *v.config.Timeout
 	} else if v.UsesVolumeDriver() {
@@ -29,6 +29,10 @@ func (v *Volume) teardownStorage() error {
 		return nil
 	}
 
+	if err := v.removeSnapshots(); err != nil {
+		return fmt.Errorf("removing snapshots of volume %s: %w", v.Name(), err)
+	}
+
 	// TODO: Should this be converted to use v.config.MountPoint?
 	return os.RemoveAll(filepath.Join(v.runtime.config.Engine.VolumePath, v.Name()))
 }
@@ -41,6 +45,11 @@ func (v *Volume) needsMount() bool {
 		return true
 	}
 
+	// Volumes backed by a filesystem image always need mount
+	if v.config.Quota == define.VolumeQuotaLoop {
+		return true
+	}
+
 	// Image driver always needs mount
 	if v.config.Driver == define.VolumeDriverImage {
 		return true
@@ -62,6 +71,9 @@ func (v *Volume) needsMount() bool {
 	if _, ok := v.config.Options["NOQUOTA"]; ok {
 		index++
 	}
+	if _, ok := v.config.Options["QUOTA"]; ok {
+		index++
+	}
 	if _, ok := v.config.Options["nocopy"]; ok {
 		index++
 	}
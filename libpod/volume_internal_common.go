@@ -76,6 +76,17 @@ func (v *Volume) mount() error {
 		return v.save()
 	}
 
+	if v.config.Quota == define.VolumeQuotaLoop {
+		if err := v.mountVolumeImage(); err != nil {
+			return err
+		}
+		logrus.Debugf("Mounted volume %s", v.Name())
+
+		v.state.MountCount++
+		logrus.Debugf("Volume %s mount count now at %d", v.Name(), v.state.MountCount)
+		return v.save()
+	}
+
 	volDevice := v.config.Options["device"]
 	volType := v.config.Options["type"]
 	volOptions := v.config.Options["o"]
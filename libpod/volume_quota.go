@@ -0,0 +1,90 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"golang.org/x/sys/unix"
+)
+
+// imagePath returns the path of the filesystem image backing a volume using
+// the loop quota. It lives next to the _data mount point.
+func (v *Volume) imagePath() string {
+	return filepath.Join(v.runtime.config.Engine.VolumePath, v.Name(), "volume.img")
+}
+
+// Usage returns the disk usage of the volume. It returns nil for volumes
+// without a size limit, as their usage can only be computed by walking the
+// whole volume.
+func (v *Volume) Usage() (*define.VolumeUsage, error) {
+	if !v.valid {
+		return nil, define.ErrVolumeRemoved
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if err := v.update(); err != nil {
+		return nil, err
+	}
+	return v.usage()
+}
+
+// usage returns the disk usage of a volume with a size limit.
+// Must be called with the volume locked and updated.
+func (v *Volume) usage() (*define.VolumeUsage, error) {
+	if v.UsesVolumeDriver() || v.config.Driver == define.VolumeDriverImage || v.config.Size == 0 {
+		return nil, nil
+	}
+
+	usage := &define.VolumeUsage{Limit: v.config.Size}
+	switch {
+	case v.config.Quota == define.VolumeQuotaLoop:
+		if v.state.MountCount > 0 {
+			used, err := filesystemUsed(v.config.MountPoint)
+			if err != nil {
+				return nil, err
+			}
+			usage.Used = used
+			break
+		}
+		// The image is sparse, so the space allocated for it is the
+		// space used by the filesystem inside of it.
+		var st unix.Stat_t
+		if err := unix.Stat(v.imagePath(), &st); err != nil {
+			return nil, fmt.Errorf("reading filesystem image of volume %s: %w", v.Name(), err)
+		}
+		usage.Used = uint64(st.Blocks) * 512
+	case v.config.Options["type"] == define.TypeTmpfs:
+		// An unmounted tmpfs volume is empty.
+		if v.state.MountCount > 0 {
+			used, err := filesystemUsed(v.config.MountPoint)
+			if err != nil {
+				return nil, err
+			}
+			usage.Used = used
+		}
+	default:
+		// The project quota accounts the space used by the volume, so
+		// the volume does not need to be walked.
+		used, err := v.projectQuotaUsed()
+		if err != nil {
+			return nil, fmt.Errorf("reading project quota usage of volume %s: %w", v.Name(), err)
+		}
+		usage.Used = used
+	}
+	return usage, nil
+}
+
+// filesystemUsed returns the number of bytes used on the filesystem mounted
+// at path.
+func filesystemUsed(path string) (uint64, error) {
+	var st unix.Statfs_t
+	if err := unix.Statfs(path, &st); err != nil {
+		return 0, fmt.Errorf("reading filesystem usage of %s: %w", path, err)
+	}
+	return (st.Blocks - st.Bfree) * uint64(st.Bsize), nil
+}
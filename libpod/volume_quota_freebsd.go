@@ -0,0 +1,17 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v6/libpod/define"
+)
+
+func (v *Volume) createVolumeImage() error {
+	return fmt.Errorf("volume option quota=%s: %w", define.VolumeQuotaLoop, define.ErrNotImplemented)
+}
+
+func (v *Volume) mountVolumeImage() error {
+	return fmt.Errorf("volume option quota=%s: %w", define.VolumeQuotaLoop, define.ErrNotImplemented)
+}
@@ -0,0 +1,84 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/sirupsen/logrus"
+)
+
+// createVolumeImage creates the sparse ext4 image backing a volume using the
+// loop quota. The size of the image is the size limit of the volume.
+func (v *Volume) createVolumeImage() error {
+	mkfsPath, err := exec.LookPath("mkfs.ext4")
+	if err != nil {
+		return fmt.Errorf("locating 'mkfs.ext4' binary: %w", err)
+	}
+
+	path := v.imagePath()
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("creating filesystem image of volume %s: %w", v.Name(), err)
+	}
+	err = f.Truncate(int64(v.config.Size))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("sizing filesystem image of volume %s: %w", v.Name(), err)
+	}
+
+	// No blocks are reserved for root, the whole size is usable by the
+	// containers.
+	mkfsArgs := []string{"-q", "-F", "-m", "0", "-E", fmt.Sprintf("root_owner=%d:%d", v.config.UID, v.config.GID)}
+	if v.config.Inodes > 0 {
+		mkfsArgs = append(mkfsArgs, "-N", strconv.FormatUint(v.config.Inodes, 10))
+	}
+	mkfsArgs = append(mkfsArgs, path)
+
+	logrus.Debugf("Running mkfs command: %s %s", mkfsPath, strings.Join(mkfsArgs, " "))
+	if output, err := exec.Command(mkfsPath, mkfsArgs...).CombinedOutput(); err != nil {
+		return fmt.Errorf("creating filesystem in image of volume %s: %s: %w", v.Name(), strings.TrimSpace(string(output)), err)
+	}
+	return nil
+}
+
+// mountVolumeImage mounts the image backing a volume using the loop quota on
+// the mount point of the volume. Rootless users cannot set up loop devices,
+// so fuse2fs serves the image instead.
+func (v *Volume) mountVolumeImage() error {
+	var mountCmd *exec.Cmd
+	if rootless.IsRootless() {
+		fuse2fsPath, err := exec.LookPath("fuse2fs")
+		if err != nil {
+			return fmt.Errorf("locating 'fuse2fs' binary, needed to mount volume %s as rootless user: %w", v.Name(), err)
+		}
+		mountCmd = exec.Command(fuse2fsPath, "-o", "allow_other", v.imagePath(), v.config.MountPoint)
+	} else {
+		mountPath, err := exec.LookPath("mount")
+		if err != nil {
+			return fmt.Errorf("locating 'mount' binary: %w", err)
+		}
+		mountCmd = exec.Command(mountPath, "-t", "ext4", "-o", "loop", v.imagePath(), v.config.MountPoint)
+	}
+
+	logrus.Debugf("Running mount command: %s", strings.Join(mountCmd.Args, " "))
+	if output, err := mountCmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("mounting filesystem image of volume %s: %s: %w", v.Name(), strings.TrimSpace(string(output)), err)
+	}
+
+	// mkfs always creates lost+found, which trips up applications that
+	// expect a new volume to be empty. Only an empty one is removed.
+	if err := os.Remove(filepath.Join(v.config.MountPoint, "lost+found")); err != nil && !errors.Is(err, os.ErrNotExist) {
+		logrus.Debugf("Not removing lost+found from volume %s: %v", v.Name(), err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+//go:build !remote
+
+package libpod
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/common/pkg/config"
+)
+
+func TestVolumeUsageLoopQuota(t *testing.T) {
+	if _, err := exec.LookPath("mkfs.ext4"); err != nil {
+		t.Skip("mkfs.ext4 is not installed")
+	}
+	tmpDir := t.TempDir()
+	runtime := &Runtime{config: &config.Config{}}
+	runtime.config.Engine.VolumePath = tmpDir
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "testvol", "_data"), 0o755))
+
+	vol := newVolume(runtime)
+	vol.config.Name = "testvol"
+	vol.config.Driver = define.VolumeDriverLocal
+	vol.config.MountPoint = filepath.Join(tmpDir, "testvol", "_data")
+	vol.config.Size = 64 * 1024 * 1024
+	vol.config.Quota = define.VolumeQuotaLoop
+	assert.True(t, vol.needsMount())
+
+	require.NoError(t, vol.createVolumeImage())
+	info, err := os.Stat(vol.imagePath())
+	require.NoError(t, err)
+	assert.Equal(t, int64(vol.config.Size), info.Size())
+
+	// The image is sparse, an unmounted volume only reports the blocks
+	// used by the filesystem.
+	usage, err := vol.usage()
+	require.NoError(t, err)
+	assert.Equal(t, vol.config.Size, usage.Limit)
+	assert.NotZero(t, usage.Used)
+	assert.Less(t, usage.Used, usage.Limit)
+
+	assert.Error(t, vol.createVolumeImage(), "image must not be overwritten")
+}
@@ -0,0 +1,24 @@
+//go:build !remote && linux && !exclude_disk_quota && cgo
+
+package libpod
+
+import (
+	"path/filepath"
+
+	"go.podman.io/storage/drivers/quota"
+	"go.podman.io/storage/pkg/directory"
+)
+
+// projectQuotaUsed returns the space used by a volume limited by a project
+// quota as accounted by the quota.
+func (v *Volume) projectQuotaUsed() (uint64, error) {
+	q, err := quota.NewControl(v.runtime.config.Engine.VolumePath)
+	if err != nil {
+		return 0, err
+	}
+	var usage directory.DiskUsage
+	if err := q.GetDiskUsage(filepath.Join(v.runtime.config.Engine.VolumePath, v.Name()), &usage); err != nil {
+		return 0, err
+	}
+	return uint64(usage.Size), nil
+}
@@ -0,0 +1,15 @@
+//go:build !remote && (freebsd || (linux && (exclude_disk_quota || !cgo)))
+
+package libpod
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v6/libpod/define"
+)
+
+// projectQuotaUsed returns the space used by a volume limited by a project
+// quota as accounted by the quota.
+func (v *Volume) projectQuotaUsed() (uint64, error) {
+	return 0, fmt.Errorf("project quotas: %w", define.ErrNotImplemented)
+}
@@ -0,0 +1,271 @@
+//go:build !remote && (linux || freebsd)
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/sirupsen/logrus"
+	driversCopy "go.podman.io/storage/drivers/copy"
+	"go.podman.io/storage/pkg/directory"
+)
+
+// VolumeSnapshot is a point-in-time copy of the contents of a volume.
+type VolumeSnapshot struct {
+	// Name of the snapshot, unique per volume.
+	Name string `json:"name"`
+	// CreatedTime is the time the snapshot was taken.
+	CreatedTime time.Time `json:"createdAt"`
+	// Size is the size of the contents of the snapshot in bytes.
+	Size uint64 `json:"size"`
+}
+
+// snapshotPath returns the directory holding the contents of the snapshot.
+// Snapshots live outside of the volume directory so they are not counted
+// against the quota of the volume.
+func (v *Volume) snapshotPath(name string) string {
+	return filepath.Join(v.runtime.config.Engine.VolumePath, "_snapshots", v.Name(), name)
+}
+
+// snapshotIndex returns the index of the snapshot in the volume state or -1.
+// Must be called with the volume locked and updated.
+func (v *Volume) snapshotIndex(name string) int {
+	return slices.IndexFunc(v.state.Snapshots, func(s VolumeSnapshot) bool {
+		return s.Name == name
+	})
+}
+
+func (v *Volume) validateSnapshots() error {
+	if !v.valid {
+		return define.ErrVolumeRemoved
+	}
+	if v.config.Driver != define.VolumeDriverLocal && v.config.Driver != "" {
+		return fmt.Errorf("volume %s uses driver %s, only volumes using the local driver support snapshots: %w", v.Name(), v.config.Driver, define.ErrNotImplemented)
+	}
+	return nil
+}
+
+// Snapshots returns the snapshots of the volume, oldest first.
+func (v *Volume) Snapshots() ([]VolumeSnapshot, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if err := v.validateSnapshots(); err != nil {
+		return nil, err
+	}
+	if err := v.update(); err != nil {
+		return nil, err
+	}
+	return slices.Clone(v.state.Snapshots), nil
+}
+
+// CreateSnapshot copies the current contents of the volume into a new
+// snapshot with the given name.  Files are reflinked where the filesystem
+// supports it and copied otherwise.  Containers writing to the volume
+// while the snapshot is taken may leave it inconsistent.
+func (v *Volume) CreateSnapshot(name string) (*VolumeSnapshot, error) {
+	if !define.NameRegex.MatchString(name) {
+		return nil, fmt.Errorf("snapshot name %q: %w", name, define.RegexError)
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if err := v.validateSnapshots(); err != nil {
+		return nil, err
+	}
+	if err := v.update(); err != nil {
+		return nil, err
+	}
+	if v.snapshotIndex(name) >= 0 {
+		return nil, fmt.Errorf("volume %s already has a snapshot %s: %w", v.Name(), name, define.ErrVolumeSnapshotExists)
+	}
+
+	if err := v.mount(); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := v.unmount(false); err != nil {
+			logrus.Errorf("Unmounting volume %s: %v", v.Name(), err)
+		}
+	}()
+
+	// Leftovers of a snapshot that failed to save must not be merged
+	// into the new one.
+	path := v.snapshotPath(name)
+	if err := os.RemoveAll(path); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("creating snapshot directory for volume %s: %w", v.Name(), err)
+	}
+	if err := driversCopy.DirCopy(v.mountPoint(), path, driversCopy.Content, true); err != nil {
+		if rmErr := os.RemoveAll(path); rmErr != nil {
+			logrus.Errorf("Removing incomplete snapshot %s of volume %s: %v", name, v.Name(), rmErr)
+		}
+		return nil, fmt.Errorf("copying volume %s to snapshot %s: %w", v.Name(), name, err)
+	}
+	size, err := directory.Size(path)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := VolumeSnapshot{
+		Name:        name,
+		CreatedTime: time.Now(),
+		Size:        uint64(size),
+	}
+	v.state.Snapshots = append(v.state.Snapshots, snapshot)
+	if err := v.save(); err != nil {
+		if rmErr := os.RemoveAll(path); rmErr != nil {
+			logrus.Errorf("Removing snapshot %s of volume %s: %v", name, v.Name(), rmErr)
+		}
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// snapshotRestoreDir is the directory inside the volume the contents of a
+// snapshot are copied to before they replace the contents of the volume.
+const snapshotRestoreDir = ".podman-snapshot-restore"
+
+// RestoreSnapshot replaces the contents of the volume with the contents of
+// the snapshot.  The snapshot is kept and can be restored again.  The volume
+// must not be used by running containers.  The contents of the volume are
+// only replaced once the snapshot was copied completely.
+func (v *Volume) RestoreSnapshot(name string) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if err := v.validateSnapshots(); err != nil {
+		return err
+	}
+	if err := v.update(); err != nil {
+		return err
+	}
+	// Containers mount the volume while holding the volume lock, so no
+	// container can start using the volume until the restore completed.
+	if err := v.checkNotInUse(); err != nil {
+		return err
+	}
+	if v.snapshotIndex(name) < 0 {
+		return fmt.Errorf("volume %s has no snapshot %s: %w", v.Name(), name, define.ErrNoSuchVolumeSnapshot)
+	}
+
+	if err := v.mount(); err != nil {
+		return err
+	}
+	defer func() {
+		if err := v.unmount(false); err != nil {
+			logrus.Errorf("Unmounting volume %s: %v", v.Name(), err)
+		}
+	}()
+
+	// Stage the contents of the snapshot inside the volume, so they are
+	// on the same filesystem and can be renamed into place.
+	mountPoint := v.mountPoint()
+	staging := filepath.Join(mountPoint, snapshotRestoreDir)
+	if err := os.RemoveAll(staging); err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(staging); err != nil {
+			logrus.Errorf("Removing %s of volume %s: %v", staging, v.Name(), err)
+		}
+	}()
+	if err := driversCopy.DirCopy(v.snapshotPath(name), staging, driversCopy.Content, true); err != nil {
+		return fmt.Errorf("restoring snapshot %s of volume %s: %w", name, v.Name(), err)
+	}
+
+	// Replace the contents of the volume but keep its root directory,
+	// which carries the ownership and label of the volume.
+	entries, err := os.ReadDir(mountPoint)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.Name() == snapshotRestoreDir {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(mountPoint, entry.Name())); err != nil {
+			return fmt.Errorf("emptying volume %s: %w", v.Name(), err)
+		}
+	}
+	staged, err := os.ReadDir(staging)
+	if err != nil {
+		return err
+	}
+	for _, entry := range staged {
+		if err := os.Rename(filepath.Join(staging, entry.Name()), filepath.Join(mountPoint, entry.Name())); err != nil {
+			return fmt.Errorf("restoring snapshot %s of volume %s: %w", name, v.Name(), err)
+		}
+	}
+	return nil
+}
+
+// RemoveSnapshot removes the snapshot from the volume.
+func (v *Volume) RemoveSnapshot(name string) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if err := v.validateSnapshots(); err != nil {
+		return err
+	}
+	if err := v.update(); err != nil {
+		return err
+	}
+	i := v.snapshotIndex(name)
+	if i < 0 {
+		return fmt.Errorf("volume %s has no snapshot %s: %w", v.Name(), name, define.ErrNoSuchVolumeSnapshot)
+	}
+
+	v.state.Snapshots = slices.Delete(v.state.Snapshots, i, i+1)
+	if err := v.save(); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(v.snapshotPath(name)); err != nil {
+		return fmt.Errorf("removing snapshot %s of volume %s: %w", name, v.Name(), err)
+	}
+	return nil
+}
+
+// checkNotInUse returns ErrVolumeBeingUsed if a running container uses the
+// volume.  The state of the containers is read from the database without
+// taking their locks, so it can be called with the volume locked.
+func (v *Volume) checkNotInUse() error {
+	ctrIDs, err := v.runtime.state.VolumeInUse(v)
+	if err != nil {
+		return err
+	}
+	for _, id := range ctrIDs {
+		ctr, err := v.runtime.state.Container(id)
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) {
+				continue
+			}
+			return err
+		}
+		if err := v.runtime.state.UpdateContainer(ctr); err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+				continue
+			}
+			return err
+		}
+		switch ctr.state.State {
+		case define.ContainerStateRunning, define.ContainerStatePaused, define.ContainerStateStopping:
+			return fmt.Errorf("volume %s is used by running container %s: %w", v.Name(), ctr.ID(), define.ErrVolumeBeingUsed)
+		}
+	}
+	return nil
+}
+
+// removeSnapshots removes the contents of all snapshots of the volume.
+func (v *Volume) removeSnapshots() error {
+	return os.RemoveAll(filepath.Join(v.runtime.config.Engine.VolumePath, "_snapshots", v.Name()))
+}
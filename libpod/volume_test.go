@@ -3,11 +3,17 @@
 package libpod
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/libpod/lock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.podman.io/common/pkg/config"
+	"go.podman.io/storage"
+	"go.podman.io/storage/drivers/quota"
 )
 
 // Regression test for issue #27858.
@@ -128,3 +134,119 @@ func TestVolumeLocalDriverDoesNotUseVolumeDriver(t *testing.T) {
 	result := vol.mountPoint()
 	assert.Equal(t, vol.config.MountPoint, result)
 }
+
+func TestVolumeSnapshots(t *testing.T) {
+	tmpDir := t.TempDir()
+	lockManager, err := lock.NewInMemoryManager(16)
+	require.NoError(t, err)
+
+	runtime := new(Runtime)
+	runtime.config = new(config.Config)
+	runtime.config.Engine.VolumePath = filepath.Join(tmpDir, "volumes")
+	runtime.storageConfig = storage.StoreOptions{GraphRoot: tmpDir}
+	runtime.storageSet = storageSet{StaticDirSet: true}
+	runtime.lockManager = lockManager
+	state, err := NewSqliteState(runtime)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		state.Close()
+	})
+	runtime.state = state
+
+	mountPoint := filepath.Join(runtime.config.Engine.VolumePath, "testvol", "_data")
+	require.NoError(t, os.MkdirAll(filepath.Join(mountPoint, "dir"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(mountPoint, "file"), []byte("one"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(mountPoint, "dir", "nested"), []byte("two"), 0o644))
+
+	volLock, err := lockManager.AllocateLock()
+	require.NoError(t, err)
+	vol := newVolume(runtime)
+	vol.config.Name = "testvol"
+	vol.config.Driver = define.VolumeDriverLocal
+	vol.config.MountPoint = mountPoint
+	vol.config.LockID = volLock.ID()
+	vol.lock = volLock
+	vol.valid = true
+	require.NoError(t, state.AddVolume(vol))
+
+	_, err = vol.CreateSnapshot("bad/name")
+	assert.ErrorIs(t, err, define.RegexError)
+
+	snap, err := vol.CreateSnapshot("clean")
+	require.NoError(t, err)
+	assert.Equal(t, "clean", snap.Name)
+	assert.Equal(t, uint64(6), snap.Size)
+	_, err = vol.CreateSnapshot("clean")
+	assert.ErrorIs(t, err, define.ErrVolumeSnapshotExists)
+
+	// Snapshots are kept in the database.
+	retrieved, err := state.Volume("testvol")
+	require.NoError(t, err)
+	snaps, err := retrieved.Snapshots()
+	require.NoError(t, err)
+	require.Len(t, snaps, 1)
+	assert.Equal(t, "clean", snaps[0].Name)
+
+	require.NoError(t, os.WriteFile(filepath.Join(mountPoint, "file"), []byte("changed"), 0o644))
+	require.NoError(t, os.RemoveAll(filepath.Join(mountPoint, "dir")))
+	require.NoError(t, os.WriteFile(filepath.Join(mountPoint, "new"), []byte("new"), 0o644))
+
+	assert.ErrorIs(t, vol.RestoreSnapshot("missing"), define.ErrNoSuchVolumeSnapshot)
+	require.NoError(t, vol.RestoreSnapshot("clean"))
+	content, err := os.ReadFile(filepath.Join(mountPoint, "file"))
+	require.NoError(t, err)
+	assert.Equal(t, "one", string(content))
+	content, err = os.ReadFile(filepath.Join(mountPoint, "dir", "nested"))
+	require.NoError(t, err)
+	assert.Equal(t, "two", string(content))
+	assert.NoFileExists(t, filepath.Join(mountPoint, "new"))
+
+	// A failed restore keeps the contents of the volume.
+	snapshotPath := vol.snapshotPath("clean")
+	require.NoError(t, os.Rename(snapshotPath, snapshotPath+".moved"))
+	require.NoError(t, os.WriteFile(filepath.Join(mountPoint, "new"), []byte("new"), 0o644))
+	assert.Error(t, vol.RestoreSnapshot("clean"))
+	assert.FileExists(t, filepath.Join(mountPoint, "new"))
+	assert.NoDirExists(t, filepath.Join(mountPoint, snapshotRestoreDir))
+	require.NoError(t, os.Rename(snapshotPath+".moved", snapshotPath))
+
+	assert.ErrorIs(t, vol.RemoveSnapshot("missing"), define.ErrNoSuchVolumeSnapshot)
+	require.NoError(t, vol.RemoveSnapshot("clean"))
+	assert.NoDirExists(t, vol.snapshotPath("clean"))
+	snaps, err = vol.Snapshots()
+	require.NoError(t, err)
+	assert.Empty(t, snaps)
+}
+
+func TestVolumeUsage(t *testing.T) {
+	tmpDir := t.TempDir()
+	runtime := &Runtime{config: &config.Config{}}
+	runtime.config.Engine.VolumePath = tmpDir
+
+	mountPoint := filepath.Join(tmpDir, "testvol", "_data")
+	require.NoError(t, os.MkdirAll(mountPoint, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(mountPoint, "file"), []byte("hello"), 0o644))
+
+	vol := newVolume(runtime)
+	vol.config.Name = "testvol"
+	vol.config.Driver = define.VolumeDriverLocal
+	vol.config.MountPoint = mountPoint
+
+	// Only volumes with a size limit report their usage.
+	usage, err := vol.usage()
+	require.NoError(t, err)
+	assert.Nil(t, usage)
+
+	// The usage of a volume with a project quota is read from the quota.
+	q, err := quota.NewControl(tmpDir)
+	if err != nil {
+		t.Skipf("project quotas are not supported: %v", err)
+	}
+	vol.config.Size = 1024 * 1024
+	require.NoError(t, q.SetQuota(filepath.Join(tmpDir, "testvol"), quota.Quota{Size: vol.config.Size}))
+	require.NoError(t, os.WriteFile(filepath.Join(mountPoint, "other"), []byte("world"), 0o644))
+	usage, err = vol.usage()
+	require.NoError(t, err)
+	assert.Equal(t, vol.config.Size, usage.Limit)
+	assert.NotZero(t, usage.Used)
+}
@@ -0,0 +1,1234 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.19.6
+// source: containers.proto
+
+package grpcpb
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ContainerLogsResponse_Stream int32
+
+const (
+	ContainerLogsResponse_STDOUT ContainerLogsResponse_Stream = 0
+	ContainerLogsResponse_STDERR ContainerLogsResponse_Stream = 1
+)
+
+// Enum value maps for ContainerLogsResponse_Stream.
+var (
+	ContainerLogsResponse_Stream_name = map[int32]string{
+		0: "STDOUT",
+		1: "STDERR",
+	}
+	ContainerLogsResponse_Stream_value = map[string]int32{
+		"STDOUT": 0,
+		"STDERR": 1,
+	}
+)
+
+func (x ContainerLogsResponse_Stream) Enum() *ContainerLogsResponse_Stream {
+	p := new(ContainerLogsResponse_Stream)
+	*p = x
+	return p
+}
+
+func (x ContainerLogsResponse_Stream) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContainerLogsResponse_Stream) Descriptor() protoreflect.EnumDescriptor {
+	return file_containers_proto_enumTypes[0].Descriptor()
+}
+
+func (ContainerLogsResponse_Stream) Type() protoreflect.EnumType {
+	return &file_containers_proto_enumTypes[0]
+}
+
+func (x ContainerLogsResponse_Stream) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContainerLogsResponse_Stream.Descriptor instead.
+func (ContainerLogsResponse_Stream) EnumDescriptor() ([]byte, []int) {
+	return file_containers_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type ListContainersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	All           bool                   `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`
+	Filters       []*Filter              `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	Size          bool                   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContainersRequest) Reset() {
+	*x = ListContainersRequest{}
+	mi := &file_containers_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContainersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContainersRequest) ProtoMessage() {}
+
+func (x *ListContainersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_containers_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContainersRequest.ProtoReflect.Descriptor instead.
+func (*ListContainersRequest) Descriptor() ([]byte, []int) {
+	return file_containers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListContainersRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *ListContainersRequest) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *ListContainersRequest) GetSize() bool {
+	if x != nil {
+		return x.Size
+	}
+	return false
+}
+
+type Container struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Names         []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	ImageId       string                 `protobuf:"bytes,4,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	Command       []string               `protobuf:"bytes,5,rep,name=command,proto3" json:"command,omitempty"`
+	Created       int64                  `protobuf:"varint,6,opt,name=created,proto3" json:"created,omitempty"`
+	State         string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Exited        bool                   `protobuf:"varint,9,opt,name=exited,proto3" json:"exited,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,10,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	ExitedAt      int64                  `protobuf:"varint,12,opt,name=exited_at,json=exitedAt,proto3" json:"exited_at,omitempty"`
+	Pod           string                 `protobuf:"bytes,13,opt,name=pod,proto3" json:"pod,omitempty"`
+	PodName       string                 `protobuf:"bytes,14,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	IsInfra       bool                   `protobuf:"varint,15,opt,name=is_infra,json=isInfra,proto3" json:"is_infra,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,16,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ports         []*PortMapping         `protobuf:"bytes,17,rep,name=ports,proto3" json:"ports,omitempty"`
+	Networks      []string               `protobuf:"bytes,18,rep,name=networks,proto3" json:"networks,omitempty"`
+	Restarts      uint32                 `protobuf:"varint,19,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	Pid           int32                  `protobuf:"varint,20,opt,name=pid,proto3" json:"pid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Container) Reset() {
+	*x = Container{}
+	mi := &file_containers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Container) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Container) ProtoMessage() {}
+
+func (x *Container) ProtoReflect() protoreflect.Message {
+	mi := &file_containers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Container.ProtoReflect.Descriptor instead.
+func (*Container) Descriptor() ([]byte, []int) {
+	return file_containers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Container) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Container) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *Container) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Container) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *Container) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *Container) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *Container) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Container) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Container) GetExited() bool {
+	if x != nil {
+		return x.Exited
+	}
+	return false
+}
+
+func (x *Container) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *Container) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *Container) GetExitedAt() int64 {
+	if x != nil {
+		return x.ExitedAt
+	}
+	return 0
+}
+
+func (x *Container) GetPod() string {
+	if x != nil {
+		return x.Pod
+	}
+	return ""
+}
+
+func (x *Container) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *Container) GetIsInfra() bool {
+	if x != nil {
+		return x.IsInfra
+	}
+	return false
+}
+
+func (x *Container) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Container) GetPorts() []*PortMapping {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *Container) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *Container) GetRestarts() uint32 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *Container) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+type ListContainersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Containers    []*Container           `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContainersResponse) Reset() {
+	*x = ListContainersResponse{}
+	mi := &file_containers_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContainersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContainersResponse) ProtoMessage() {}
+
+func (x *ListContainersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_containers_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContainersResponse.ProtoReflect.Descriptor instead.
+func (*ListContainersResponse) Descriptor() ([]byte, []int) {
+	return file_containers_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListContainersResponse) GetContainers() []*Container {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+type InspectContainerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NameOrId      string                 `protobuf:"bytes,1,opt,name=name_or_id,json=nameOrId,proto3" json:"name_or_id,omitempty"`
+	Size          bool                   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InspectContainerRequest) Reset() {
+	*x = InspectContainerRequest{}
+	mi := &file_containers_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectContainerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectContainerRequest) ProtoMessage() {}
+
+func (x *InspectContainerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_containers_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectContainerRequest.ProtoReflect.Descriptor instead.
+func (*InspectContainerRequest) Descriptor() ([]byte, []int) {
+	return file_containers_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InspectContainerRequest) GetNameOrId() string {
+	if x != nil {
+		return x.NameOrId
+	}
+	return ""
+}
+
+func (x *InspectContainerRequest) GetSize() bool {
+	if x != nil {
+		return x.Size
+	}
+	return false
+}
+
+type InspectContainerResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Image        string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	ImageName    string                 `protobuf:"bytes,4,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`
+	Pod          string                 `protobuf:"bytes,5,opt,name=pod,proto3" json:"pod,omitempty"`
+	State        string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Running      bool                   `protobuf:"varint,7,opt,name=running,proto3" json:"running,omitempty"`
+	Pid          int32                  `protobuf:"varint,8,opt,name=pid,proto3" json:"pid,omitempty"`
+	ExitCode     int32                  `protobuf:"varint,9,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	RestartCount int32                  `protobuf:"varint,10,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	// JSON encoding of the complete inspect data, identical to the body of
+	// the REST inspect endpoint.
+	InspectJson   []byte `protobuf:"bytes,11,opt,name=inspect_json,json=inspectJson,proto3" json:"inspect_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InspectContainerResponse) Reset() {
+	*x = InspectContainerResponse{}
+	mi := &file_containers_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectContainerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectContainerResponse) ProtoMessage() {}
+
+func (x *InspectContainerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_containers_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectContainerResponse.ProtoReflect.Descriptor instead.
+func (*InspectContainerResponse) Descriptor() ([]byte, []int) {
+	return file_containers_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InspectContainerResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InspectContainerResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InspectContainerResponse) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *InspectContainerResponse) GetImageName() string {
+	if x != nil {
+		return x.ImageName
+	}
+	return ""
+}
+
+func (x *InspectContainerResponse) GetPod() string {
+	if x != nil {
+		return x.Pod
+	}
+	return ""
+}
+
+func (x *InspectContainerResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *InspectContainerResponse) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *InspectContainerResponse) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *InspectContainerResponse) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *InspectContainerResponse) GetRestartCount() int32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *InspectContainerResponse) GetInspectJson() []byte {
+	if x != nil {
+		return x.InspectJson
+	}
+	return nil
+}
+
+type CreateContainerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// JSON encoding of a SpecGenerator, identical to the body accepted by
+	// the REST create endpoint.  The fields below override the matching
+	// fields of the spec when set.
+	SpecJson      []byte            `protobuf:"bytes,1,opt,name=spec_json,json=specJson,proto3" json:"spec_json,omitempty"`
+	Name          string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Image         string            `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Command       []string          `protobuf:"bytes,4,rep,name=command,proto3" json:"command,omitempty"`
+	Env           map[string]string `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels        map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Pod           string            `protobuf:"bytes,7,opt,name=pod,proto3" json:"pod,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContainerRequest) Reset() {
+	*x = CreateContainerRequest{}
+	mi := &file_containers_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContainerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContainerRequest) ProtoMessage() {}
+
+func (x *CreateContainerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_containers_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContainerRequest.ProtoReflect.Descriptor instead.
+func (*CreateContainerRequest) Descriptor() ([]byte, []int) {
+	return file_containers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateContainerRequest) GetSpecJson() []byte {
+	if x != nil {
+		return x.SpecJson
+	}
+	return nil
+}
+
+func (x *CreateContainerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateContainerRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *CreateContainerRequest) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *CreateContainerRequest) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *CreateContainerRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateContainerRequest) GetPod() string {
+	if x != nil {
+		return x.Pod
+	}
+	return ""
+}
+
+type CreateContainerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Warnings      []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContainerResponse) Reset() {
+	*x = CreateContainerResponse{}
+	mi := &file_containers_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContainerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContainerResponse) ProtoMessage() {}
+
+func (x *CreateContainerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_containers_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContainerResponse.ProtoReflect.Descriptor instead.
+func (*CreateContainerResponse) Descriptor() ([]byte, []int) {
+	return file_containers_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateContainerResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateContainerResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type StartContainerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NameOrId      string                 `protobuf:"bytes,1,opt,name=name_or_id,json=nameOrId,proto3" json:"name_or_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartContainerRequest) Reset() {
+	*x = StartContainerRequest{}
+	mi := &file_containers_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartContainerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartContainerRequest) ProtoMessage() {}
+
+func (x *StartContainerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_containers_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartContainerRequest.ProtoReflect.Descriptor instead.
+func (*StartContainerRequest) Descriptor() ([]byte, []int) {
+	return file_containers_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StartContainerRequest) GetNameOrId() string {
+	if x != nil {
+		return x.NameOrId
+	}
+	return ""
+}
+
+type StartContainerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartContainerResponse) Reset() {
+	*x = StartContainerResponse{}
+	mi := &file_containers_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartContainerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartContainerResponse) ProtoMessage() {}
+
+func (x *StartContainerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_containers_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartContainerResponse.ProtoReflect.Descriptor instead.
+func (*StartContainerResponse) Descriptor() ([]byte, []int) {
+	return file_containers_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StartContainerResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StopContainerRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	NameOrId string                 `protobuf:"bytes,1,opt,name=name_or_id,json=nameOrId,proto3" json:"name_or_id,omitempty"`
+	// Seconds to wait before killing the container.  The container's
+	// configured stop timeout is used when unset.
+	Timeout       *uint32 `protobuf:"varint,2,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	Ignore        bool    `protobuf:"varint,3,opt,name=ignore,proto3" json:"ignore,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopContainerRequest) Reset() {
+	*x = StopContainerRequest{}
+	mi := &file_containers_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopContainerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopContainerRequest) ProtoMessage() {}
+
+func (x *StopContainerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_containers_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopContainerRequest.ProtoReflect.Descriptor instead.
+func (*StopContainerRequest) Descriptor() ([]byte, []int) {
+	return file_containers_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StopContainerRequest) GetNameOrId() string {
+	if x != nil {
+		return x.NameOrId
+	}
+	return ""
+}
+
+func (x *StopContainerRequest) GetTimeout() uint32 {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return 0
+}
+
+func (x *StopContainerRequest) GetIgnore() bool {
+	if x != nil {
+		return x.Ignore
+	}
+	return false
+}
+
+type StopContainerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopContainerResponse) Reset() {
+	*x = StopContainerResponse{}
+	mi := &file_containers_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopContainerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopContainerResponse) ProtoMessage() {}
+
+func (x *StopContainerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_containers_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopContainerResponse.ProtoReflect.Descriptor instead.
+func (*StopContainerResponse) Descriptor() ([]byte, []int) {
+	return file_containers_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StopContainerResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ContainerLogsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	NameOrId string                 `protobuf:"bytes,1,opt,name=name_or_id,json=nameOrId,proto3" json:"name_or_id,omitempty"`
+	Follow   bool                   `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+	Since    int64                  `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	Until    int64                  `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`
+	// Number of lines to show from the end of the logs, all lines when unset.
+	Tail          *int64 `protobuf:"varint,5,opt,name=tail,proto3,oneof" json:"tail,omitempty"`
+	Timestamps    bool   `protobuf:"varint,6,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerLogsRequest) Reset() {
+	*x = ContainerLogsRequest{}
+	mi := &file_containers_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerLogsRequest) ProtoMessage() {}
+
+func (x *ContainerLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_containers_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerLogsRequest.ProtoReflect.Descriptor instead.
+func (*ContainerLogsRequest) Descriptor() ([]byte, []int) {
+	return file_containers_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ContainerLogsRequest) GetNameOrId() string {
+	if x != nil {
+		return x.NameOrId
+	}
+	return ""
+}
+
+func (x *ContainerLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *ContainerLogsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ContainerLogsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ContainerLogsRequest) GetTail() int64 {
+	if x != nil && x.Tail != nil {
+		return *x.Tail
+	}
+	return 0
+}
+
+func (x *ContainerLogsRequest) GetTimestamps() bool {
+	if x != nil {
+		return x.Timestamps
+	}
+	return false
+}
+
+type ContainerLogsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Stream        ContainerLogsResponse_Stream `protobuf:"varint,1,opt,name=stream,proto3,enum=io.podman.v1.ContainerLogsResponse_Stream" json:"stream,omitempty"`
+	Data          []byte                       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerLogsResponse) Reset() {
+	*x = ContainerLogsResponse{}
+	mi := &file_containers_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerLogsResponse) ProtoMessage() {}
+
+func (x *ContainerLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_containers_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerLogsResponse.ProtoReflect.Descriptor instead.
+func (*ContainerLogsResponse) Descriptor() ([]byte, []int) {
+	return file_containers_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ContainerLogsResponse) GetStream() ContainerLogsResponse_Stream {
+	if x != nil {
+		return x.Stream
+	}
+	return ContainerLogsResponse_STDOUT
+}
+
+func (x *ContainerLogsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_containers_proto protoreflect.FileDescriptor
+
+const file_containers_proto_rawDesc = "" +
+	"\n" +
+	"\x10containers.proto\x12\fio.podman.v1\x1a\fcommon.proto\"m\n" +
+	"\x15ListContainersRequest\x12\x10\n" +
+	"\x03all\x18\x01 \x01(\bR\x03all\x12.\n" +
+	"\afilters\x18\x02 \x03(\v2\x14.io.podman.v1.FilterR\afilters\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\bR\x04size\"\xf0\x04\n" +
+	"\tContainer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05names\x18\x02 \x03(\tR\x05names\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x19\n" +
+	"\bimage_id\x18\x04 \x01(\tR\aimageId\x12\x18\n" +
+	"\acommand\x18\x05 \x03(\tR\acommand\x12\x18\n" +
+	"\acreated\x18\x06 \x01(\x03R\acreated\x12\x14\n" +
+	"\x05state\x18\a \x01(\tR\x05state\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x16\n" +
+	"\x06exited\x18\t \x01(\bR\x06exited\x12\x1b\n" +
+	"\texit_code\x18\n" +
+	" \x01(\x05R\bexitCode\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\v \x01(\x03R\tstartedAt\x12\x1b\n" +
+	"\texited_at\x18\f \x01(\x03R\bexitedAt\x12\x10\n" +
+	"\x03pod\x18\r \x01(\tR\x03pod\x12\x19\n" +
+	"\bpod_name\x18\x0e \x01(\tR\apodName\x12\x19\n" +
+	"\bis_infra\x18\x0f \x01(\bR\aisInfra\x12;\n" +
+	"\x06labels\x18\x10 \x03(\v2#.io.podman.v1.Container.LabelsEntryR\x06labels\x12/\n" +
+	"\x05ports\x18\x11 \x03(\v2\x19.io.podman.v1.PortMappingR\x05ports\x12\x1a\n" +
+	"\bnetworks\x18\x12 \x03(\tR\bnetworks\x12\x1a\n" +
+	"\brestarts\x18\x13 \x01(\rR\brestarts\x12\x10\n" +
+	"\x03pid\x18\x14 \x01(\x05R\x03pid\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Q\n" +
+	"\x16ListContainersResponse\x127\n" +
+	"\n" +
+	"containers\x18\x01 \x03(\v2\x17.io.podman.v1.ContainerR\n" +
+	"containers\"K\n" +
+	"\x17InspectContainerRequest\x12\x1c\n" +
+	"\n" +
+	"name_or_id\x18\x01 \x01(\tR\bnameOrId\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\bR\x04size\"\xac\x02\n" +
+	"\x18InspectContainerResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x1d\n" +
+	"\n" +
+	"image_name\x18\x04 \x01(\tR\timageName\x12\x10\n" +
+	"\x03pod\x18\x05 \x01(\tR\x03pod\x12\x14\n" +
+	"\x05state\x18\x06 \x01(\tR\x05state\x12\x18\n" +
+	"\arunning\x18\a \x01(\bR\arunning\x12\x10\n" +
+	"\x03pid\x18\b \x01(\x05R\x03pid\x12\x1b\n" +
+	"\texit_code\x18\t \x01(\x05R\bexitCode\x12#\n" +
+	"\rrestart_count\x18\n" +
+	" \x01(\x05R\frestartCount\x12!\n" +
+	"\finspect_json\x18\v \x01(\fR\vinspectJson\"\x89\x03\n" +
+	"\x16CreateContainerRequest\x12\x1b\n" +
+	"\tspec_json\x18\x01 \x01(\fR\bspecJson\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x18\n" +
+	"\acommand\x18\x04 \x03(\tR\acommand\x12?\n" +
+	"\x03env\x18\x05 \x03(\v2-.io.podman.v1.CreateContainerRequest.EnvEntryR\x03env\x12H\n" +
+	"\x06labels\x18\x06 \x03(\v20.io.podman.v1.CreateContainerRequest.LabelsEntryR\x06labels\x12\x10\n" +
+	"\x03pod\x18\a \x01(\tR\x03pod\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"E\n" +
+	"\x17CreateContainerResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"5\n" +
+	"\x15StartContainerRequest\x12\x1c\n" +
+	"\n" +
+	"name_or_id\x18\x01 \x01(\tR\bnameOrId\"(\n" +
+	"\x16StartContainerResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"w\n" +
+	"\x14StopContainerRequest\x12\x1c\n" +
+	"\n" +
+	"name_or_id\x18\x01 \x01(\tR\bnameOrId\x12\x1d\n" +
+	"\atimeout\x18\x02 \x01(\rH\x00R\atimeout\x88\x01\x01\x12\x16\n" +
+	"\x06ignore\x18\x03 \x01(\bR\x06ignoreB\n" +
+	"\n" +
+	"\b_timeout\"'\n" +
+	"\x15StopContainerResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xba\x01\n" +
+	"\x14ContainerLogsRequest\x12\x1c\n" +
+	"\n" +
+	"name_or_id\x18\x01 \x01(\tR\bnameOrId\x12\x16\n" +
+	"\x06follow\x18\x02 \x01(\bR\x06follow\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x04 \x01(\x03R\x05until\x12\x17\n" +
+	"\x04tail\x18\x05 \x01(\x03H\x00R\x04tail\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"timestamps\x18\x06 \x01(\bR\n" +
+	"timestampsB\a\n" +
+	"\x05_tail\"\x91\x01\n" +
+	"\x15ContainerLogsResponse\x12B\n" +
+	"\x06stream\x18\x01 \x01(\x0e2*.io.podman.v1.ContainerLogsResponse.StreamR\x06stream\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\" \n" +
+	"\x06Stream\x12\n" +
+	"\n" +
+	"\x06STDOUT\x10\x00\x12\n" +
+	"\n" +
+	"\x06STDERR\x10\x012\x88\x04\n" +
+	"\n" +
+	"Containers\x12Q\n" +
+	"\x04List\x12#.io.podman.v1.ListContainersRequest\x1a$.io.podman.v1.ListContainersResponse\x12X\n" +
+	"\aInspect\x12%.io.podman.v1.InspectContainerRequest\x1a&.io.podman.v1.InspectContainerResponse\x12U\n" +
+	"\x06Create\x12$.io.podman.v1.CreateContainerRequest\x1a%.io.podman.v1.CreateContainerResponse\x12R\n" +
+	"\x05Start\x12#.io.podman.v1.StartContainerRequest\x1a$.io.podman.v1.StartContainerResponse\x12O\n" +
+	"\x04Stop\x12\".io.podman.v1.StopContainerRequest\x1a#.io.podman.v1.StopContainerResponse\x12Q\n" +
+	"\x04Logs\x12\".io.podman.v1.ContainerLogsRequest\x1a#.io.podman.v1.ContainerLogsResponse0\x01B0Z.github.com/containers/podman/v6/pkg/api/grpcpbb\x06proto3"
+
+var (
+	file_containers_proto_rawDescOnce sync.Once
+	file_containers_proto_rawDescData []byte
+)
+
+func file_containers_proto_rawDescGZIP() []byte {
+	file_containers_proto_rawDescOnce.Do(func() {
+		file_containers_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_containers_proto_rawDesc), len(file_containers_proto_rawDesc)))
+	})
+	return file_containers_proto_rawDescData
+}
+
+var (
+	file_containers_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+	file_containers_proto_msgTypes  = make([]protoimpl.MessageInfo, 16)
+	file_containers_proto_goTypes   = []any{
+		ContainerLogsResponse_Stream(0),  // 0: io.podman.v1.ContainerLogsResponse.Stream
+		(*ListContainersRequest)(nil),    // 1: io.podman.v1.ListContainersRequest
+		(*Container)(nil),                // 2: io.podman.v1.Container
+		(*ListContainersResponse)(nil),   // 3: io.podman.v1.ListContainersResponse
+		(*InspectContainerRequest)(nil),  // 4: io.podman.v1.InspectContainerRequest
+		(*InspectContainerResponse)(nil), // 5: io.podman.v1.InspectContainerResponse
+		(*CreateContainerRequest)(nil),   // 6: io.podman.v1.CreateContainerRequest
+		(*CreateContainerResponse)(nil),  // 7: io.podman.v1.CreateContainerResponse
+		(*StartContainerRequest)(nil),    // 8: io.podman.v1.StartContainerRequest
+		(*StartContainerResponse)(nil),   // 9: io.podman.v1.StartContainerResponse
+		(*StopContainerRequest)(nil),     // 10: io.podman.v1.StopContainerRequest
+		(*StopContainerResponse)(nil),    // 11: io.podman.v1.StopContainerResponse
+		(*ContainerLogsRequest)(nil),     // 12: io.podman.v1.ContainerLogsRequest
+		(*ContainerLogsResponse)(nil),    // 13: io.podman.v1.ContainerLogsResponse
+		nil,                              // 14: io.podman.v1.Container.LabelsEntry
+		nil,                              // 15: io.podman.v1.CreateContainerRequest.EnvEntry
+		nil,                              // 16: io.podman.v1.CreateContainerRequest.LabelsEntry
+		(*Filter)(nil),                   // 17: io.podman.v1.Filter
+		(*PortMapping)(nil),              // 18: io.podman.v1.PortMapping
+	}
+)
+
+var file_containers_proto_depIdxs = []int32{
+	17, // 0: io.podman.v1.ListContainersRequest.filters:type_name -> io.podman.v1.Filter
+	14, // 1: io.podman.v1.Container.labels:type_name -> io.podman.v1.Container.LabelsEntry
+	18, // 2: io.podman.v1.Container.ports:type_name -> io.podman.v1.PortMapping
+	2,  // 3: io.podman.v1.ListContainersResponse.containers:type_name -> io.podman.v1.Container
+	15, // 4: io.podman.v1.CreateContainerRequest.env:type_name -> io.podman.v1.CreateContainerRequest.EnvEntry
+	16, // 5: io.podman.v1.CreateContainerRequest.labels:type_name -> io.podman.v1.CreateContainerRequest.LabelsEntry
+	0,  // 6: io.podman.v1.ContainerLogsResponse.stream:type_name -> io.podman.v1.ContainerLogsResponse.Stream
+	1,  // 7: io.podman.v1.Containers.List:input_type -> io.podman.v1.ListContainersRequest
+	4,  // 8: io.podman.v1.Containers.Inspect:input_type -> io.podman.v1.InspectContainerRequest
+	6,  // 9: io.podman.v1.Containers.Create:input_type -> io.podman.v1.CreateContainerRequest
+	8,  // 10: io.podman.v1.Containers.Start:input_type -> io.podman.v1.StartContainerRequest
+	10, // 11: io.podman.v1.Containers.Stop:input_type -> io.podman.v1.StopContainerRequest
+	12, // 12: io.podman.v1.Containers.Logs:input_type -> io.podman.v1.ContainerLogsRequest
+	3,  // 13: io.podman.v1.Containers.List:output_type -> io.podman.v1.ListContainersResponse
+	5,  // 14: io.podman.v1.Containers.Inspect:output_type -> io.podman.v1.InspectContainerResponse
+	7,  // 15: io.podman.v1.Containers.Create:output_type -> io.podman.v1.CreateContainerResponse
+	9,  // 16: io.podman.v1.Containers.Start:output_type -> io.podman.v1.StartContainerResponse
+	11, // 17: io.podman.v1.Containers.Stop:output_type -> io.podman.v1.StopContainerResponse
+	13, // 18: io.podman.v1.Containers.Logs:output_type -> io.podman.v1.ContainerLogsResponse
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_containers_proto_init() }
+func file_containers_proto_init() {
+	if File_containers_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_containers_proto_msgTypes[9].OneofWrappers = []any{}
+	file_containers_proto_msgTypes[11].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_containers_proto_rawDesc), len(file_containers_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_containers_proto_goTypes,
+		DependencyIndexes: file_containers_proto_depIdxs,
+		EnumInfos:         file_containers_proto_enumTypes,
+		MessageInfos:      file_containers_proto_msgTypes,
+	}.Build()
+	File_containers_proto = out.File
+	file_containers_proto_goTypes = nil
+	file_containers_proto_depIdxs = nil
+}
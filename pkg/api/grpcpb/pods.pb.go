@@ -0,0 +1,890 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.19.6
+// source: pods.proto
+
+package grpcpb
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListPodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filters       []*Filter              `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPodsRequest) Reset() {
+	*x = ListPodsRequest{}
+	mi := &file_pods_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPodsRequest) ProtoMessage() {}
+
+func (x *ListPodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pods_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPodsRequest.ProtoReflect.Descriptor instead.
+func (*ListPodsRequest) Descriptor() ([]byte, []int) {
+	return file_pods_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListPodsRequest) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type PodContainer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	RestartCount  uint32                 `protobuf:"varint,4,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PodContainer) Reset() {
+	*x = PodContainer{}
+	mi := &file_pods_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodContainer) ProtoMessage() {}
+
+func (x *PodContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_pods_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodContainer.ProtoReflect.Descriptor instead.
+func (*PodContainer) Descriptor() ([]byte, []int) {
+	return file_pods_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PodContainer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PodContainer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PodContainer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PodContainer) GetRestartCount() uint32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+type Pod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Created       int64                  `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	InfraId       string                 `protobuf:"bytes,5,opt,name=infra_id,json=infraId,proto3" json:"infra_id,omitempty"`
+	Cgroup        string                 `protobuf:"bytes,6,opt,name=cgroup,proto3" json:"cgroup,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Networks      []string               `protobuf:"bytes,8,rep,name=networks,proto3" json:"networks,omitempty"`
+	Containers    []*PodContainer        `protobuf:"bytes,9,rep,name=containers,proto3" json:"containers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pod) Reset() {
+	*x = Pod{}
+	mi := &file_pods_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pod) ProtoMessage() {}
+
+func (x *Pod) ProtoReflect() protoreflect.Message {
+	mi := &file_pods_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pod.ProtoReflect.Descriptor instead.
+func (*Pod) Descriptor() ([]byte, []int) {
+	return file_pods_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Pod) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Pod) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pod) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Pod) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *Pod) GetInfraId() string {
+	if x != nil {
+		return x.InfraId
+	}
+	return ""
+}
+
+func (x *Pod) GetCgroup() string {
+	if x != nil {
+		return x.Cgroup
+	}
+	return ""
+}
+
+func (x *Pod) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Pod) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *Pod) GetContainers() []*PodContainer {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+type ListPodsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pods          []*Pod                 `protobuf:"bytes,1,rep,name=pods,proto3" json:"pods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPodsResponse) Reset() {
+	*x = ListPodsResponse{}
+	mi := &file_pods_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPodsResponse) ProtoMessage() {}
+
+func (x *ListPodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pods_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPodsResponse.ProtoReflect.Descriptor instead.
+func (*ListPodsResponse) Descriptor() ([]byte, []int) {
+	return file_pods_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPodsResponse) GetPods() []*Pod {
+	if x != nil {
+		return x.Pods
+	}
+	return nil
+}
+
+type InspectPodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NameOrId      string                 `protobuf:"bytes,1,opt,name=name_or_id,json=nameOrId,proto3" json:"name_or_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InspectPodRequest) Reset() {
+	*x = InspectPodRequest{}
+	mi := &file_pods_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectPodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectPodRequest) ProtoMessage() {}
+
+func (x *InspectPodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pods_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectPodRequest.ProtoReflect.Descriptor instead.
+func (*InspectPodRequest) Descriptor() ([]byte, []int) {
+	return file_pods_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InspectPodRequest) GetNameOrId() string {
+	if x != nil {
+		return x.NameOrId
+	}
+	return ""
+}
+
+type InspectPodResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	State            string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	InfraContainerId string                 `protobuf:"bytes,4,opt,name=infra_container_id,json=infraContainerId,proto3" json:"infra_container_id,omitempty"`
+	ContainerIds     []string               `protobuf:"bytes,5,rep,name=container_ids,json=containerIds,proto3" json:"container_ids,omitempty"`
+	// JSON encoding of the complete inspect data, identical to the body of
+	// the REST inspect endpoint.
+	InspectJson   []byte `protobuf:"bytes,6,opt,name=inspect_json,json=inspectJson,proto3" json:"inspect_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InspectPodResponse) Reset() {
+	*x = InspectPodResponse{}
+	mi := &file_pods_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectPodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectPodResponse) ProtoMessage() {}
+
+func (x *InspectPodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pods_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectPodResponse.ProtoReflect.Descriptor instead.
+func (*InspectPodResponse) Descriptor() ([]byte, []int) {
+	return file_pods_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InspectPodResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InspectPodResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InspectPodResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *InspectPodResponse) GetInfraContainerId() string {
+	if x != nil {
+		return x.InfraContainerId
+	}
+	return ""
+}
+
+func (x *InspectPodResponse) GetContainerIds() []string {
+	if x != nil {
+		return x.ContainerIds
+	}
+	return nil
+}
+
+func (x *InspectPodResponse) GetInspectJson() []byte {
+	if x != nil {
+		return x.InspectJson
+	}
+	return nil
+}
+
+type CreatePodRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// JSON encoding of a PodSpecGenerator, identical to the body accepted
+	// by the REST create endpoint.  The fields below override the matching
+	// fields of the spec when set.
+	SpecJson      []byte            `protobuf:"bytes,1,opt,name=spec_json,json=specJson,proto3" json:"spec_json,omitempty"`
+	Name          string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Labels        map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	NoInfra       bool              `protobuf:"varint,4,opt,name=no_infra,json=noInfra,proto3" json:"no_infra,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePodRequest) Reset() {
+	*x = CreatePodRequest{}
+	mi := &file_pods_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePodRequest) ProtoMessage() {}
+
+func (x *CreatePodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pods_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePodRequest.ProtoReflect.Descriptor instead.
+func (*CreatePodRequest) Descriptor() ([]byte, []int) {
+	return file_pods_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreatePodRequest) GetSpecJson() []byte {
+	if x != nil {
+		return x.SpecJson
+	}
+	return nil
+}
+
+func (x *CreatePodRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePodRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreatePodRequest) GetNoInfra() bool {
+	if x != nil {
+		return x.NoInfra
+	}
+	return false
+}
+
+type CreatePodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePodResponse) Reset() {
+	*x = CreatePodResponse{}
+	mi := &file_pods_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePodResponse) ProtoMessage() {}
+
+func (x *CreatePodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pods_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePodResponse.ProtoReflect.Descriptor instead.
+func (*CreatePodResponse) Descriptor() ([]byte, []int) {
+	return file_pods_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreatePodResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StartPodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NameOrId      string                 `protobuf:"bytes,1,opt,name=name_or_id,json=nameOrId,proto3" json:"name_or_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartPodRequest) Reset() {
+	*x = StartPodRequest{}
+	mi := &file_pods_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPodRequest) ProtoMessage() {}
+
+func (x *StartPodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pods_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPodRequest.ProtoReflect.Descriptor instead.
+func (*StartPodRequest) Descriptor() ([]byte, []int) {
+	return file_pods_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StartPodRequest) GetNameOrId() string {
+	if x != nil {
+		return x.NameOrId
+	}
+	return ""
+}
+
+type StartPodResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Errors of the individual containers that failed to start.
+	Errors        []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartPodResponse) Reset() {
+	*x = StartPodResponse{}
+	mi := &file_pods_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPodResponse) ProtoMessage() {}
+
+func (x *StartPodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pods_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPodResponse.ProtoReflect.Descriptor instead.
+func (*StartPodResponse) Descriptor() ([]byte, []int) {
+	return file_pods_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StartPodResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StartPodResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type StopPodRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	NameOrId string                 `protobuf:"bytes,1,opt,name=name_or_id,json=nameOrId,proto3" json:"name_or_id,omitempty"`
+	// Seconds to wait before killing the containers of the pod.  The
+	// configured stop timeout is used when unset.
+	Timeout       *uint32 `protobuf:"varint,2,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopPodRequest) Reset() {
+	*x = StopPodRequest{}
+	mi := &file_pods_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopPodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPodRequest) ProtoMessage() {}
+
+func (x *StopPodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pods_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPodRequest.ProtoReflect.Descriptor instead.
+func (*StopPodRequest) Descriptor() ([]byte, []int) {
+	return file_pods_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StopPodRequest) GetNameOrId() string {
+	if x != nil {
+		return x.NameOrId
+	}
+	return ""
+}
+
+func (x *StopPodRequest) GetTimeout() uint32 {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return 0
+}
+
+type StopPodResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Errors of the individual containers that failed to stop.
+	Errors        []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopPodResponse) Reset() {
+	*x = StopPodResponse{}
+	mi := &file_pods_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopPodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPodResponse) ProtoMessage() {}
+
+func (x *StopPodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pods_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPodResponse.ProtoReflect.Descriptor instead.
+func (*StopPodResponse) Descriptor() ([]byte, []int) {
+	return file_pods_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StopPodResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StopPodResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+var File_pods_proto protoreflect.FileDescriptor
+
+const file_pods_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"pods.proto\x12\fio.podman.v1\x1a\fcommon.proto\"A\n" +
+	"\x0fListPodsRequest\x12.\n" +
+	"\afilters\x18\x01 \x03(\v2\x14.io.podman.v1.FilterR\afilters\"o\n" +
+	"\fPodContainer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12#\n" +
+	"\rrestart_count\x18\x04 \x01(\rR\frestartCount\"\xd8\x02\n" +
+	"\x03Pod\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
+	"\acreated\x18\x04 \x01(\x03R\acreated\x12\x19\n" +
+	"\binfra_id\x18\x05 \x01(\tR\ainfraId\x12\x16\n" +
+	"\x06cgroup\x18\x06 \x01(\tR\x06cgroup\x125\n" +
+	"\x06labels\x18\a \x03(\v2\x1d.io.podman.v1.Pod.LabelsEntryR\x06labels\x12\x1a\n" +
+	"\bnetworks\x18\b \x03(\tR\bnetworks\x12:\n" +
+	"\n" +
+	"containers\x18\t \x03(\v2\x1a.io.podman.v1.PodContainerR\n" +
+	"containers\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"9\n" +
+	"\x10ListPodsResponse\x12%\n" +
+	"\x04pods\x18\x01 \x03(\v2\x11.io.podman.v1.PodR\x04pods\"1\n" +
+	"\x11InspectPodRequest\x12\x1c\n" +
+	"\n" +
+	"name_or_id\x18\x01 \x01(\tR\bnameOrId\"\xc4\x01\n" +
+	"\x12InspectPodResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12,\n" +
+	"\x12infra_container_id\x18\x04 \x01(\tR\x10infraContainerId\x12#\n" +
+	"\rcontainer_ids\x18\x05 \x03(\tR\fcontainerIds\x12!\n" +
+	"\finspect_json\x18\x06 \x01(\fR\vinspectJson\"\xdd\x01\n" +
+	"\x10CreatePodRequest\x12\x1b\n" +
+	"\tspec_json\x18\x01 \x01(\fR\bspecJson\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12B\n" +
+	"\x06labels\x18\x03 \x03(\v2*.io.podman.v1.CreatePodRequest.LabelsEntryR\x06labels\x12\x19\n" +
+	"\bno_infra\x18\x04 \x01(\bR\anoInfra\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"#\n" +
+	"\x11CreatePodResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x0fStartPodRequest\x12\x1c\n" +
+	"\n" +
+	"name_or_id\x18\x01 \x01(\tR\bnameOrId\":\n" +
+	"\x10StartPodResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\tR\x06errors\"Y\n" +
+	"\x0eStopPodRequest\x12\x1c\n" +
+	"\n" +
+	"name_or_id\x18\x01 \x01(\tR\bnameOrId\x12\x1d\n" +
+	"\atimeout\x18\x02 \x01(\rH\x00R\atimeout\x88\x01\x01B\n" +
+	"\n" +
+	"\b_timeout\"9\n" +
+	"\x0fStopPodResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\tR\x06errors2\xf3\x02\n" +
+	"\x04Pods\x12E\n" +
+	"\x04List\x12\x1d.io.podman.v1.ListPodsRequest\x1a\x1e.io.podman.v1.ListPodsResponse\x12L\n" +
+	"\aInspect\x12\x1f.io.podman.v1.InspectPodRequest\x1a .io.podman.v1.InspectPodResponse\x12I\n" +
+	"\x06Create\x12\x1e.io.podman.v1.CreatePodRequest\x1a\x1f.io.podman.v1.CreatePodResponse\x12F\n" +
+	"\x05Start\x12\x1d.io.podman.v1.StartPodRequest\x1a\x1e.io.podman.v1.StartPodResponse\x12C\n" +
+	"\x04Stop\x12\x1c.io.podman.v1.StopPodRequest\x1a\x1d.io.podman.v1.StopPodResponseB0Z.github.com/containers/podman/v6/pkg/api/grpcpbb\x06proto3"
+
+var (
+	file_pods_proto_rawDescOnce sync.Once
+	file_pods_proto_rawDescData []byte
+)
+
+func file_pods_proto_rawDescGZIP() []byte {
+	file_pods_proto_rawDescOnce.Do(func() {
+		file_pods_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pods_proto_rawDesc), len(file_pods_proto_rawDesc)))
+	})
+	return file_pods_proto_rawDescData
+}
+
+var (
+	file_pods_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+	file_pods_proto_goTypes  = []any{
+		(*ListPodsRequest)(nil),    // 0: io.podman.v1.ListPodsRequest
+		(*PodContainer)(nil),       // 1: io.podman.v1.PodContainer
+		(*Pod)(nil),                // 2: io.podman.v1.Pod
+		(*ListPodsResponse)(nil),   // 3: io.podman.v1.ListPodsResponse
+		(*InspectPodRequest)(nil),  // 4: io.podman.v1.InspectPodRequest
+		(*InspectPodResponse)(nil), // 5: io.podman.v1.InspectPodResponse
+		(*CreatePodRequest)(nil),   // 6: io.podman.v1.CreatePodRequest
+		(*CreatePodResponse)(nil),  // 7: io.podman.v1.CreatePodResponse
+		(*StartPodRequest)(nil),    // 8: io.podman.v1.StartPodRequest
+		(*StartPodResponse)(nil),   // 9: io.podman.v1.StartPodResponse
+		(*StopPodRequest)(nil),     // 10: io.podman.v1.StopPodRequest
+		(*StopPodResponse)(nil),    // 11: io.podman.v1.StopPodResponse
+		nil,                        // 12: io.podman.v1.Pod.LabelsEntry
+		nil,                        // 13: io.podman.v1.CreatePodRequest.LabelsEntry
+		(*Filter)(nil),             // 14: io.podman.v1.Filter
+	}
+)
+
+var file_pods_proto_depIdxs = []int32{
+	14, // 0: io.podman.v1.ListPodsRequest.filters:type_name -> io.podman.v1.Filter
+	12, // 1: io.podman.v1.Pod.labels:type_name -> io.podman.v1.Pod.LabelsEntry
+	1,  // 2: io.podman.v1.Pod.containers:type_name -> io.podman.v1.PodContainer
+	2,  // 3: io.podman.v1.ListPodsResponse.pods:type_name -> io.podman.v1.Pod
+	13, // 4: io.podman.v1.CreatePodRequest.labels:type_name -> io.podman.v1.CreatePodRequest.LabelsEntry
+	0,  // 5: io.podman.v1.Pods.List:input_type -> io.podman.v1.ListPodsRequest
+	4,  // 6: io.podman.v1.Pods.Inspect:input_type -> io.podman.v1.InspectPodRequest
+	6,  // 7: io.podman.v1.Pods.Create:input_type -> io.podman.v1.CreatePodRequest
+	8,  // 8: io.podman.v1.Pods.Start:input_type -> io.podman.v1.StartPodRequest
+	10, // 9: io.podman.v1.Pods.Stop:input_type -> io.podman.v1.StopPodRequest
+	3,  // 10: io.podman.v1.Pods.List:output_type -> io.podman.v1.ListPodsResponse
+	5,  // 11: io.podman.v1.Pods.Inspect:output_type -> io.podman.v1.InspectPodResponse
+	7,  // 12: io.podman.v1.Pods.Create:output_type -> io.podman.v1.CreatePodResponse
+	9,  // 13: io.podman.v1.Pods.Start:output_type -> io.podman.v1.StartPodResponse
+	11, // 14: io.podman.v1.Pods.Stop:output_type -> io.podman.v1.StopPodResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_pods_proto_init() }
+func file_pods_proto_init() {
+	if File_pods_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_pods_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pods_proto_rawDesc), len(file_pods_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pods_proto_goTypes,
+		DependencyIndexes: file_pods_proto_depIdxs,
+		MessageInfos:      file_pods_proto_msgTypes,
+	}.Build()
+	File_pods_proto = out.File
+	file_pods_proto_goTypes = nil
+	file_pods_proto_depIdxs = nil
+}
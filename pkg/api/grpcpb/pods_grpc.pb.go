@@ -0,0 +1,278 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.19.6
+// source: pods.proto
+
+package grpcpb
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Pods_List_FullMethodName    = "/io.podman.v1.Pods/List"
+	Pods_Inspect_FullMethodName = "/io.podman.v1.Pods/Inspect"
+	Pods_Create_FullMethodName  = "/io.podman.v1.Pods/Create"
+	Pods_Start_FullMethodName   = "/io.podman.v1.Pods/Start"
+	Pods_Stop_FullMethodName    = "/io.podman.v1.Pods/Stop"
+)
+
+// PodsClient is the client API for Pods service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PodsClient interface {
+	List(ctx context.Context, in *ListPodsRequest, opts ...grpc.CallOption) (*ListPodsResponse, error)
+	Inspect(ctx context.Context, in *InspectPodRequest, opts ...grpc.CallOption) (*InspectPodResponse, error)
+	Create(ctx context.Context, in *CreatePodRequest, opts ...grpc.CallOption) (*CreatePodResponse, error)
+	Start(ctx context.Context, in *StartPodRequest, opts ...grpc.CallOption) (*StartPodResponse, error)
+	Stop(ctx context.Context, in *StopPodRequest, opts ...grpc.CallOption) (*StopPodResponse, error)
+}
+
+type podsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPodsClient(cc grpc.ClientConnInterface) PodsClient {
+	return &podsClient{cc}
+}
+
+func (c *podsClient) List(ctx context.Context, in *ListPodsRequest, opts ...grpc.CallOption) (*ListPodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPodsResponse)
+	err := c.cc.Invoke(ctx, Pods_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podsClient) Inspect(ctx context.Context, in *InspectPodRequest, opts ...grpc.CallOption) (*InspectPodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InspectPodResponse)
+	err := c.cc.Invoke(ctx, Pods_Inspect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podsClient) Create(ctx context.Context, in *CreatePodRequest, opts ...grpc.CallOption) (*CreatePodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePodResponse)
+	err := c.cc.Invoke(ctx, Pods_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podsClient) Start(ctx context.Context, in *StartPodRequest, opts ...grpc.CallOption) (*StartPodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartPodResponse)
+	err := c.cc.Invoke(ctx, Pods_Start_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *podsClient) Stop(ctx context.Context, in *StopPodRequest, opts ...grpc.CallOption) (*StopPodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopPodResponse)
+	err := c.cc.Invoke(ctx, Pods_Stop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PodsServer is the server API for Pods service.
+// All implementations must embed UnimplementedPodsServer
+// for forward compatibility.
+type PodsServer interface {
+	List(context.Context, *ListPodsRequest) (*ListPodsResponse, error)
+	Inspect(context.Context, *InspectPodRequest) (*InspectPodResponse, error)
+	Create(context.Context, *CreatePodRequest) (*CreatePodResponse, error)
+	Start(context.Context, *StartPodRequest) (*StartPodResponse, error)
+	Stop(context.Context, *StopPodRequest) (*StopPodResponse, error)
+	mustEmbedUnimplementedPodsServer()
+}
+
+// UnimplementedPodsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPodsServer struct{}
+
+func (UnimplementedPodsServer) List(context.Context, *ListPodsRequest) (*ListPodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+
+func (UnimplementedPodsServer) Inspect(context.Context, *InspectPodRequest) (*InspectPodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inspect not implemented")
+}
+
+func (UnimplementedPodsServer) Create(context.Context, *CreatePodRequest) (*CreatePodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+
+func (UnimplementedPodsServer) Start(context.Context, *StartPodRequest) (*StartPodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+}
+
+func (UnimplementedPodsServer) Stop(context.Context, *StopPodRequest) (*StopPodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (UnimplementedPodsServer) mustEmbedUnimplementedPodsServer() {}
+func (UnimplementedPodsServer) testEmbeddedByValue()              {}
+
+// UnsafePodsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PodsServer will
+// result in compilation errors.
+type UnsafePodsServer interface {
+	mustEmbedUnimplementedPodsServer()
+}
+
+func RegisterPodsServer(s grpc.ServiceRegistrar, srv PodsServer) {
+	// If the following call pancis, it indicates UnimplementedPodsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Pods_ServiceDesc, srv)
+}
+
+func _Pods_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pods_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodsServer).List(ctx, req.(*ListPodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pods_Inspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectPodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodsServer).Inspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pods_Inspect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodsServer).Inspect(ctx, req.(*InspectPodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pods_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pods_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodsServer).Create(ctx, req.(*CreatePodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pods_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodsServer).Start(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pods_Start_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodsServer).Start(ctx, req.(*StartPodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pods_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopPodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PodsServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pods_Stop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PodsServer).Stop(ctx, req.(*StopPodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Pods_ServiceDesc is the grpc.ServiceDesc for Pods service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Pods_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "io.podman.v1.Pods",
+	HandlerType: (*PodsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Pods_List_Handler,
+		},
+		{
+			MethodName: "Inspect",
+			Handler:    _Pods_Inspect_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Pods_Create_Handler,
+		},
+		{
+			MethodName: "Start",
+			Handler:    _Pods_Start_Handler,
+		},
+		{
+			MethodName: "Stop",
+			Handler:    _Pods_Stop_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pods.proto",
+}
@@ -0,0 +1,59 @@
+//go:build !remote && (linux || freebsd)
+
+package grpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/containers/podman/v6/pkg/api/grpcpb"
+	"go.podman.io/common/libnetwork/types"
+	"google.golang.org/grpc/metadata"
+)
+
+// filtersToMap converts the filters of a List request into the map used by
+// the domain layer.
+func filtersToMap(filters []*grpcpb.Filter) map[string][]string {
+	m := make(map[string][]string, len(filters))
+	for _, f := range filters {
+		m[f.GetKey()] = append(m[f.GetKey()], f.GetValues()...)
+	}
+	return m
+}
+
+// filtersToSlice converts the filters of a List request into the
+// "key=value" form used by the image domain layer.
+func filtersToSlice(filters []*grpcpb.Filter) []string {
+	var s []string
+	for _, f := range filters {
+		for _, v := range f.GetValues() {
+			s = append(s, f.GetKey()+"="+v)
+		}
+	}
+	return s
+}
+
+func portMappingsToProto(ports []types.PortMapping) []*grpcpb.PortMapping {
+	out := make([]*grpcpb.PortMapping, 0, len(ports))
+	for _, p := range ports {
+		out = append(out, &grpcpb.PortMapping{
+			HostIp:        p.HostIP,
+			ContainerPort: uint32(p.ContainerPort),
+			HostPort:      uint32(p.HostPort),
+			Range:         uint32(p.Range),
+			Protocol:      p.Protocol,
+		})
+	}
+	return out
+}
+
+// metadataToHeader returns the metadata of an incoming call as HTTP headers,
+// "x-registry-auth" becoming X-Registry-Auth.
+func metadataToHeader(ctx context.Context) http.Header {
+	md, _ := metadata.FromIncomingContext(ctx)
+	header := make(http.Header, len(md))
+	for key, values := range md {
+		header[http.CanonicalHeaderKey(key)] = values
+	}
+	return header
+}
@@ -0,0 +1,45 @@
+//go:build !remote && (linux || freebsd)
+
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/containers/podman/v6/pkg/api/grpcpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestFiltersToMap(t *testing.T) {
+	filters := []*grpcpb.Filter{
+		{Key: "label", Values: []string{"a=b"}},
+		{Key: "status", Values: []string{"running", "exited"}},
+		{Key: "label", Values: []string{"c"}},
+	}
+	assert.Equal(t, map[string][]string{
+		"label":  {"a=b", "c"},
+		"status": {"running", "exited"},
+	}, filtersToMap(filters))
+	assert.Empty(t, filtersToMap(nil))
+}
+
+func TestFiltersToSlice(t *testing.T) {
+	filters := []*grpcpb.Filter{
+		{Key: "reference", Values: []string{"alpine", "fedora"}},
+		{Key: "dangling", Values: []string{"true"}},
+		{Key: "label"},
+	}
+	assert.Equal(t, []string{"reference=alpine", "reference=fedora", "dangling=true"}, filtersToSlice(filters))
+	assert.Empty(t, filtersToSlice(nil))
+}
+
+func TestMetadataToHeader(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-registry-auth", "e30=", "other", "a", "other", "b"))
+	header := metadataToHeader(ctx)
+	assert.Equal(t, "e30=", header.Get("X-Registry-Auth"))
+	assert.Equal(t, []string{"a", "b"}, header.Values("Other"))
+
+	assert.Equal(t, http.Header{}, metadataToHeader(context.Background()))
+}
@@ -0,0 +1,232 @@
+//go:build !remote && (linux || freebsd)
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/containers/podman/v6/libpod"
+	"github.com/containers/podman/v6/pkg/api/grpcpb"
+	"github.com/containers/podman/v6/pkg/api/handlers/utils"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/domain/infra/abi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type containersServer struct {
+	grpcpb.UnimplementedContainersServer
+	runtime *libpod.Runtime
+}
+
+func NewContainersServer(runtime *libpod.Runtime) grpcpb.ContainersServer {
+	return &containersServer{runtime: runtime}
+}
+
+func (s *containersServer) engine() *abi.ContainerEngine {
+	return &abi.ContainerEngine{Libpod: s.runtime}
+}
+
+func (s *containersServer) List(ctx context.Context, req *grpcpb.ListContainersRequest) (*grpcpb.ListContainersResponse, error) {
+	opts := entities.ContainerListOptions{
+		All:     req.GetAll(),
+		Filters: filtersToMap(req.GetFilters()),
+		Size:    req.GetSize(),
+		Pod:     true,
+	}
+	ctrs, err := s.engine().ContainerList(ctx, opts)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &grpcpb.ListContainersResponse{Containers: make([]*grpcpb.Container, 0, len(ctrs))}
+	for _, c := range ctrs {
+		resp.Containers = append(resp.Containers, &grpcpb.Container{
+			Id:        c.ID,
+			Names:     c.Names,
+			Image:     c.Image,
+			ImageId:   c.ImageID,
+			Command:   c.Command,
+			Created:   c.Created.Unix(),
+			State:     c.State,
+			Status:    c.Status,
+			Exited:    c.Exited,
+			ExitCode:  c.ExitCode,
+			StartedAt: c.StartedAt,
+			ExitedAt:  c.ExitedAt,
+			Pod:       c.Pod,
+			PodName:   c.PodName,
+			IsInfra:   c.IsInfra,
+			Labels:    c.Labels,
+			Ports:     portMappingsToProto(c.Ports),
+			Networks:  c.Networks,
+			Restarts:  uint32(c.Restarts),
+			Pid:       int32(c.Pid),
+		})
+	}
+	return resp, nil
+}
+
+func (s *containersServer) Inspect(ctx context.Context, req *grpcpb.InspectContainerRequest) (*grpcpb.InspectContainerResponse, error) {
+	reports, errs, err := s.engine().ContainerInspect(ctx, []string{req.GetNameOrId()}, entities.InspectOptions{Size: req.GetSize()})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if len(errs) > 0 {
+		return nil, toStatus(errs[0])
+	}
+	if len(reports) != 1 {
+		return nil, status.Errorf(codes.Internal, "expected exactly one inspect report, got %d", len(reports))
+	}
+	data := reports[0].InspectContainerData
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &grpcpb.InspectContainerResponse{
+		Id:           data.ID,
+		Name:         data.Name,
+		Image:        data.Image,
+		ImageName:    data.ImageName,
+		Pod:          data.Pod,
+		RestartCount: data.RestartCount,
+		InspectJson:  raw,
+	}
+	if data.State != nil {
+		resp.State = data.State.Status
+		resp.Running = data.State.Running
+		resp.Pid = int32(data.State.Pid)
+		resp.ExitCode = data.State.ExitCode
+	}
+	return resp, nil
+}
+
+func (s *containersServer) Create(ctx context.Context, req *grpcpb.CreateContainerRequest) (*grpcpb.CreateContainerResponse, error) {
+	// Use the same defaults as the REST create endpoint.
+	sg, err := utils.DefaultSpecGenerator(s.runtime)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if len(req.GetSpecJson()) > 0 {
+		if err := json.Unmarshal(req.GetSpecJson(), &sg); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "decoding container spec: %v", err)
+		}
+	}
+	if req.GetName() != "" {
+		sg.Name = req.GetName()
+	}
+	if req.GetImage() != "" {
+		sg.Image = req.GetImage()
+	}
+	if len(req.GetCommand()) > 0 {
+		sg.Command = req.GetCommand()
+	}
+	if req.GetPod() != "" {
+		sg.Pod = req.GetPod()
+	}
+	if len(req.GetEnv()) > 0 {
+		if sg.Env == nil {
+			sg.Env = make(map[string]string, len(req.GetEnv()))
+		}
+		maps.Copy(sg.Env, req.GetEnv())
+	}
+	if len(req.GetLabels()) > 0 {
+		if sg.Labels == nil {
+			sg.Labels = make(map[string]string, len(req.GetLabels()))
+		}
+		maps.Copy(sg.Labels, req.GetLabels())
+	}
+
+	response, err := utils.CreateContainerFromSpec(ctx, s.runtime, &sg)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &grpcpb.CreateContainerResponse{Id: response.ID, Warnings: response.Warnings}, nil
+}
+
+func (s *containersServer) Start(ctx context.Context, req *grpcpb.StartContainerRequest) (*grpcpb.StartContainerResponse, error) {
+	reports, err := s.engine().ContainerStart(ctx, []string{req.GetNameOrId()}, entities.ContainerStartOptions{})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if len(reports) != 1 {
+		return nil, status.Errorf(codes.Internal, "expected exactly one start report, got %d", len(reports))
+	}
+	if reports[0].Err != nil {
+		return nil, toStatus(reports[0].Err)
+	}
+	return &grpcpb.StartContainerResponse{Id: reports[0].Id}, nil
+}
+
+func (s *containersServer) Stop(ctx context.Context, req *grpcpb.StopContainerRequest) (*grpcpb.StopContainerResponse, error) {
+	opts := entities.StopOptions{Ignore: req.GetIgnore()}
+	if req.Timeout != nil {
+		timeout := uint(req.GetTimeout())
+		opts.Timeout = &timeout
+	}
+	reports, err := s.engine().ContainerStop(ctx, []string{req.GetNameOrId()}, opts)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if len(reports) == 0 {
+		// Only possible when the container is missing and ignore is set.
+		return &grpcpb.StopContainerResponse{}, nil
+	}
+	if reports[0].Err != nil {
+		return nil, toStatus(reports[0].Err)
+	}
+	return &grpcpb.StopContainerResponse{Id: reports[0].Id}, nil
+}
+
+func (s *containersServer) Logs(req *grpcpb.ContainerLogsRequest, stream grpc.ServerStreamingServer[grpcpb.ContainerLogsResponse]) error {
+	var mu sync.Mutex
+	opts := logsOptions(req)
+	opts.StdoutWriter = &logStreamWriter{mu: &mu, stream: stream, kind: grpcpb.ContainerLogsResponse_STDOUT}
+	opts.StderrWriter = &logStreamWriter{mu: &mu, stream: stream, kind: grpcpb.ContainerLogsResponse_STDERR}
+	return toStatus(s.engine().ContainerLogs(stream.Context(), []string{req.GetNameOrId()}, opts))
+}
+
+// logsOptions converts a Logs request into the options of the domain layer.
+// Like the CLI and REST API, a tail of 0 shows no lines and all lines are
+// shown when the tail is unset or negative.
+func logsOptions(req *grpcpb.ContainerLogsRequest) entities.ContainerLogsOptions {
+	opts := entities.ContainerLogsOptions{
+		Follow:     req.GetFollow(),
+		Tail:       -1,
+		Timestamps: req.GetTimestamps(),
+	}
+	if req.Tail != nil && req.GetTail() >= 0 {
+		opts.Tail = req.GetTail()
+	}
+	if req.GetSince() != 0 {
+		opts.Since = time.Unix(req.GetSince(), 0)
+	}
+	if req.GetUntil() != 0 {
+		opts.Until = time.Unix(req.GetUntil(), 0)
+	}
+	return opts
+}
+
+// logStreamWriter forwards log lines written by the domain layer to a gRPC
+// stream.  The stdout and stderr writers of a single call share a lock
+// because a stream must not be written to concurrently.
+type logStreamWriter struct {
+	mu     *sync.Mutex
+	stream grpc.ServerStreamingServer[grpcpb.ContainerLogsResponse]
+	kind   grpcpb.ContainerLogsResponse_Stream
+}
+
+func (w *logStreamWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	// Send serializes the message before returning, so p is not retained.
+	if err := w.stream.Send(&grpcpb.ContainerLogsResponse{Stream: w.kind, Data: p}); err != nil {
+		return 0, fmt.Errorf("sending log line: %w", err)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,42 @@
+//go:build !remote && (linux || freebsd)
+
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/containers/podman/v6/pkg/api/grpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogsOptionsTail(t *testing.T) {
+	tail := func(n int64) *int64 { return &n }
+	tests := []struct {
+		tail *int64
+		res  int64
+	}{
+		{nil, -1},
+		{tail(0), 0},
+		{tail(10), 10},
+		{tail(-1), -1},
+		{tail(-5), -1},
+	}
+
+	for _, test := range tests {
+		opts := logsOptions(&grpcpb.ContainerLogsRequest{Tail: test.tail})
+		assert.Equal(t, test.res, opts.Tail, "%v", test.tail)
+	}
+}
+
+func TestLogsOptions(t *testing.T) {
+	opts := logsOptions(&grpcpb.ContainerLogsRequest{Follow: true, Timestamps: true, Since: 100, Until: 200})
+	assert.True(t, opts.Follow)
+	assert.True(t, opts.Timestamps)
+	assert.Equal(t, time.Unix(100, 0), opts.Since)
+	assert.Equal(t, time.Unix(200, 0), opts.Until)
+
+	opts = logsOptions(&grpcpb.ContainerLogsRequest{})
+	assert.True(t, opts.Since.IsZero())
+	assert.True(t, opts.Until.IsZero())
+}
@@ -0,0 +1,46 @@
+//go:build !remote && (linux || freebsd)
+
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"go.podman.io/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus converts an error returned by libpod into a gRPC status, using
+// the same classification as the HTTP status codes of the REST API.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	var code codes.Code
+	switch {
+	case errors.Is(err, define.ErrNoSuchCtr),
+		errors.Is(err, define.ErrNoSuchPod),
+		errors.Is(err, define.ErrNoSuchVolume),
+		errors.Is(err, define.ErrNoSuchNetwork),
+		errors.Is(err, storage.ErrImageUnknown):
+		code = codes.NotFound
+	case errors.Is(err, define.ErrCtrExists),
+		errors.Is(err, define.ErrPodExists):
+		code = codes.AlreadyExists
+	case errors.Is(err, define.ErrCtrStateInvalid),
+		errors.Is(err, define.ErrCtrStopped),
+		errors.Is(err, define.ErrPodPartialFail):
+		code = codes.FailedPrecondition
+	case errors.Is(err, define.ErrInvalidArg):
+		code = codes.InvalidArgument
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	default:
+		code = codes.Internal
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,47 @@
+//go:build !remote && (linux || freebsd)
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/stretchr/testify/assert"
+	"go.podman.io/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatus(t *testing.T) {
+	assert.NoError(t, toStatus(nil))
+
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{define.ErrNoSuchCtr, codes.NotFound},
+		{fmt.Errorf("looking up pod: %w", define.ErrNoSuchPod), codes.NotFound},
+		{define.ErrNoSuchVolume, codes.NotFound},
+		{define.ErrNoSuchNetwork, codes.NotFound},
+		{fmt.Errorf("alpine: %w", storage.ErrImageUnknown), codes.NotFound},
+		{define.ErrCtrExists, codes.AlreadyExists},
+		{define.ErrPodExists, codes.AlreadyExists},
+		{define.ErrCtrStateInvalid, codes.FailedPrecondition},
+		{define.ErrCtrStopped, codes.FailedPrecondition},
+		{define.ErrPodPartialFail, codes.FailedPrecondition},
+		{fmt.Errorf("bad filter: %w", define.ErrInvalidArg), codes.InvalidArgument},
+		{context.Canceled, codes.Canceled},
+		{context.DeadlineExceeded, codes.DeadlineExceeded},
+		{errors.New("something else"), codes.Internal},
+	}
+
+	for _, test := range tests {
+		st, ok := status.FromError(toStatus(test.err))
+		assert.True(t, ok, "%v", test.err)
+		assert.Equal(t, test.code, st.Code(), "%v", test.err)
+		assert.Equal(t, test.err.Error(), st.Message(), "%v", test.err)
+	}
+}
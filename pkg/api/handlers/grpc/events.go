@@ -0,0 +1,82 @@
+//go:build !remote && (linux || freebsd)
+
+package grpc
+
+import (
+	"github.com/containers/podman/v6/libpod"
+	"github.com/containers/podman/v6/libpod/events"
+	"github.com/containers/podman/v6/pkg/api/grpcpb"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/domain/infra/abi"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+type eventsServer struct {
+	grpcpb.UnimplementedEventsServer
+	runtime *libpod.Runtime
+}
+
+func NewEventsServer(runtime *libpod.Runtime) grpcpb.EventsServer {
+	return &eventsServer{runtime: runtime}
+}
+
+func (s *eventsServer) Stream(req *grpcpb.EventsRequest, stream grpc.ServerStreamingServer[grpcpb.Event]) error {
+	ctx := stream.Context()
+	eventChannel := make(chan events.ReadResult)
+	engine := abi.ContainerEngine{Libpod: s.runtime}
+	opts := entities.EventsOptions{
+		FromStart: req.GetSince() != "" || req.GetUntil() != "",
+		EventChan: eventChannel,
+		Filter:    req.GetFilters(),
+		Stream:    req.GetStream(),
+		Since:     req.GetSince(),
+		Until:     req.GetUntil(),
+	}
+	if err := engine.Events(ctx, opts); err != nil {
+		return toStatus(err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case evt, ok := <-eventChannel:
+			if !ok {
+				return nil
+			}
+			if evt.Error != nil {
+				logrus.Errorf("Unable to read event: %q", evt.Error)
+				continue
+			}
+			if evt.Event == nil {
+				continue
+			}
+			if err := stream.Send(eventToProto(evt.Event)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func eventToProto(e *events.Event) *grpcpb.Event {
+	converted := entities.ConvertToEntitiesEvent(*e)
+	out := &grpcpb.Event{
+		Type:         e.Type.String(),
+		Action:       e.Status.String(),
+		Id:           e.ID,
+		Name:         e.Name,
+		Image:        e.Image,
+		TimeNano:     e.Time.UnixNano(),
+		Attributes:   converted.Actor.Attributes,
+		PodId:        e.PodID,
+		Network:      e.Network,
+		HealthStatus: e.HealthStatus,
+		Error:        e.Error,
+	}
+	if e.ContainerExitCode != nil {
+		exitCode := int32(*e.ContainerExitCode)
+		out.ContainerExitCode = &exitCode
+	}
+	return out
+}
@@ -0,0 +1,157 @@
+//go:build !remote && (linux || freebsd)
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/containers/podman/v6/libpod"
+	"github.com/containers/podman/v6/pkg/api/grpcpb"
+	"github.com/containers/podman/v6/pkg/api/metrics"
+	"github.com/containers/podman/v6/pkg/auth"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/domain/infra/abi"
+	"go.podman.io/common/pkg/config"
+	"go.podman.io/image/v5/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type imagesServer struct {
+	grpcpb.UnimplementedImagesServer
+	runtime *libpod.Runtime
+}
+
+func NewImagesServer(runtime *libpod.Runtime) grpcpb.ImagesServer {
+	return &imagesServer{runtime: runtime}
+}
+
+func (s *imagesServer) engine() *abi.ImageEngine {
+	return &abi.ImageEngine{Libpod: s.runtime}
+}
+
+func (s *imagesServer) List(ctx context.Context, req *grpcpb.ListImagesRequest) (*grpcpb.ListImagesResponse, error) {
+	opts := entities.ImageListOptions{
+		All:                req.GetAll(),
+		ExtendedAttributes: true,
+		Filter:             filtersToSlice(req.GetFilters()),
+	}
+	images, err := s.engine().List(ctx, opts)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &grpcpb.ListImagesResponse{Images: make([]*grpcpb.Image, 0, len(images))}
+	for _, img := range images {
+		resp.Images = append(resp.Images, &grpcpb.Image{
+			Id:          img.ID,
+			RepoTags:    img.RepoTags,
+			RepoDigests: img.RepoDigests,
+			Digest:      img.Digest,
+			Created:     img.Created,
+			Size:        img.Size,
+			Containers:  int64(img.Containers),
+			Labels:      img.Labels,
+			Dangling:    img.Dangling,
+			ReadOnly:    img.ReadOnly,
+			Arch:        img.Arch,
+			Os:          img.Os,
+		})
+	}
+	return resp, nil
+}
+
+func (s *imagesServer) Inspect(ctx context.Context, req *grpcpb.InspectImageRequest) (*grpcpb.InspectImageResponse, error) {
+	reports, errs, err := s.engine().Inspect(ctx, []string{req.GetNameOrId()}, entities.InspectOptions{})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if len(errs) > 0 {
+		return nil, toStatus(errs[0])
+	}
+	if len(reports) != 1 {
+		return nil, status.Errorf(codes.Internal, "expected exactly one inspect report, got %d", len(reports))
+	}
+	data := reports[0].ImageData
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &grpcpb.InspectImageResponse{
+		Id:           data.ID,
+		Digest:       data.Digest.String(),
+		RepoTags:     data.RepoTags,
+		RepoDigests:  data.RepoDigests,
+		Architecture: data.Architecture,
+		Os:           data.Os,
+		Size:         data.Size,
+		InspectJson:  raw,
+	}, nil
+}
+
+func (s *imagesServer) Pull(req *grpcpb.PullImageRequest, stream grpc.ServerStreamingServer[grpcpb.PullImageResponse]) error {
+	if req.GetReference() == "" {
+		return status.Error(codes.InvalidArgument, "image reference must be specified")
+	}
+	policy := config.PullPolicyAlways
+	if req.GetPolicy() != "" {
+		var err error
+		policy, err = config.ParsePullPolicy(req.GetPolicy())
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	opts := entities.ImagePullOptions{
+		AllTags:    req.GetAllTags(),
+		Arch:       req.GetArch(),
+		OS:         req.GetOs(),
+		Variant:    req.GetVariant(),
+		PullPolicy: policy,
+		Quiet:      req.GetQuiet(),
+	}
+
+	// Credentials are passed in the metadata of the call, never in the
+	// request, like the X-Registry-Auth header of the REST API.
+	authConf, authfile, err := auth.GetCredentialsFromHeader(metadataToHeader(stream.Context()))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer auth.RemoveAuthfile(authfile)
+	opts.Authfile = authfile
+	if authConf != nil {
+		opts.Username = authConf.Username
+		opts.Password = authConf.Password
+	}
+	if req.TlsVerify != nil {
+		opts.SkipTLSVerify = types.NewOptionalBool(!req.GetTlsVerify())
+	}
+	if !req.GetQuiet() {
+		opts.Writer = &pullStreamWriter{stream: stream}
+	}
+	start := time.Now()
+	report, err := s.engine().Pull(stream.Context(), req.GetReference(), opts)
+	metrics.ObserveImagePull(start, err)
+	if err != nil {
+		return toStatus(err)
+	}
+	resp := &grpcpb.PullImageResponse{Images: report.Images}
+	if len(report.Images) > 0 {
+		resp.Id = report.Images[0]
+	}
+	return stream.Send(resp)
+}
+
+// pullStreamWriter forwards pull progress output to a gRPC stream.
+type pullStreamWriter struct {
+	stream grpc.ServerStreamingServer[grpcpb.PullImageResponse]
+}
+
+func (w *pullStreamWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&grpcpb.PullImageResponse{Stream: string(p)}); err != nil {
+		return 0, fmt.Errorf("sending pull progress: %w", err)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,191 @@
+//go:build !remote && (linux || freebsd)
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+
+	"github.com/containers/podman/v6/libpod"
+	"github.com/containers/podman/v6/pkg/api/grpcpb"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/domain/infra/abi"
+	"github.com/containers/podman/v6/pkg/specgen"
+	"github.com/containers/podman/v6/pkg/specgenutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type podsServer struct {
+	grpcpb.UnimplementedPodsServer
+	runtime *libpod.Runtime
+}
+
+func NewPodsServer(runtime *libpod.Runtime) grpcpb.PodsServer {
+	return &podsServer{runtime: runtime}
+}
+
+func (s *podsServer) engine() *abi.ContainerEngine {
+	return &abi.ContainerEngine{Libpod: s.runtime}
+}
+
+func (s *podsServer) List(ctx context.Context, req *grpcpb.ListPodsRequest) (*grpcpb.ListPodsResponse, error) {
+	pods, err := s.engine().PodPs(ctx, entities.PodPSOptions{Filters: filtersToMap(req.GetFilters())})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &grpcpb.ListPodsResponse{Pods: make([]*grpcpb.Pod, 0, len(pods))}
+	for _, p := range pods {
+		pod := &grpcpb.Pod{
+			Id:       p.Id,
+			Name:     p.Name,
+			Status:   p.Status,
+			Created:  p.Created.Unix(),
+			InfraId:  p.InfraId,
+			Cgroup:   p.Cgroup,
+			Labels:   p.Labels,
+			Networks: p.Networks,
+		}
+		for _, c := range p.Containers {
+			pod.Containers = append(pod.Containers, &grpcpb.PodContainer{
+				Id:           c.Id,
+				Name:         c.Names,
+				Status:       c.Status,
+				RestartCount: uint32(c.RestartCount),
+			})
+		}
+		resp.Pods = append(resp.Pods, pod)
+	}
+	return resp, nil
+}
+
+func (s *podsServer) Inspect(ctx context.Context, req *grpcpb.InspectPodRequest) (*grpcpb.InspectPodResponse, error) {
+	reports, errs, err := s.engine().PodInspect(ctx, []string{req.GetNameOrId()}, entities.InspectOptions{})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if len(errs) > 0 {
+		return nil, toStatus(errs[0])
+	}
+	if len(reports) != 1 {
+		return nil, status.Errorf(codes.Internal, "expected exactly one inspect report, got %d", len(reports))
+	}
+	data := reports[0].InspectPodData
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &grpcpb.InspectPodResponse{
+		Id:               data.ID,
+		Name:             data.Name,
+		State:            data.State,
+		InfraContainerId: data.InfraContainerID,
+		InspectJson:      raw,
+	}
+	for _, c := range data.Containers {
+		resp.ContainerIds = append(resp.ContainerIds, c.ID)
+	}
+	return resp, nil
+}
+
+func (s *podsServer) Create(ctx context.Context, req *grpcpb.CreatePodRequest) (*grpcpb.CreatePodResponse, error) {
+	psg := specgen.PodSpecGenerator{InfraContainerSpec: &specgen.SpecGenerator{}}
+	if len(req.GetSpecJson()) > 0 {
+		if err := json.Unmarshal(req.GetSpecJson(), &psg); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "decoding pod spec: %v", err)
+		}
+	}
+	if req.GetName() != "" {
+		psg.Name = req.GetName()
+	}
+	if len(req.GetLabels()) > 0 {
+		if psg.Labels == nil {
+			psg.Labels = make(map[string]string, len(req.GetLabels()))
+		}
+		maps.Copy(psg.Labels, req.GetLabels())
+	}
+	if req.GetNoInfra() {
+		psg.NoInfra = true
+	}
+	if err := fillOutInfraSpec(&psg); err != nil {
+		return nil, toStatus(err)
+	}
+	report, err := s.engine().PodCreate(ctx, entities.PodSpec{PodSpecGen: psg})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &grpcpb.CreatePodResponse{Id: report.Id}, nil
+}
+
+// fillOutInfraSpec populates the infra container spec of a pod the same
+// way the REST pod create endpoint does.
+func fillOutInfraSpec(psg *specgen.PodSpecGenerator) error {
+	if psg.NoInfra {
+		return nil
+	}
+	if psg.InfraContainerSpec == nil {
+		psg.InfraContainerSpec = &specgen.SpecGenerator{}
+	}
+	infraOptions := entities.NewInfraContainerCreateOptions()
+	infraOptions.Net = &entities.NetOptions{}
+	infraOptions.Devices = psg.Devices
+	infraOptions.SecurityOpt = psg.SecurityOpt
+	if !psg.Userns.IsDefault() {
+		infraOptions.UserNS = psg.Userns.String()
+	}
+	if psg.ShareParent == nil {
+		t := true
+		psg.ShareParent = &t
+	}
+	if err := specgenutil.FillOutSpecGen(psg.InfraContainerSpec, &infraOptions, []string{}); err != nil {
+		return err
+	}
+	// Copy the options with matching JSON tags over to the infra spec.
+	out, err := json.Marshal(psg)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(out, psg.InfraContainerSpec); err != nil {
+		return err
+	}
+	psg.InfraContainerSpec.Name = psg.InfraName
+	psg.InfraContainerSpec.ConmonPidFile = psg.InfraConmonPidFile
+	psg.InfraContainerSpec.Image = psg.InfraImage
+	psg.InfraContainerSpec.RawImageName = psg.InfraImage
+	return nil
+}
+
+func (s *podsServer) Start(ctx context.Context, req *grpcpb.StartPodRequest) (*grpcpb.StartPodResponse, error) {
+	reports, err := s.engine().PodStart(ctx, []string{req.GetNameOrId()}, entities.PodStartOptions{})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if len(reports) != 1 {
+		return nil, status.Errorf(codes.Internal, "expected exactly one start report, got %d", len(reports))
+	}
+	resp := &grpcpb.StartPodResponse{Id: reports[0].Id}
+	for _, e := range reports[0].Errs {
+		resp.Errors = append(resp.Errors, e.Error())
+	}
+	return resp, nil
+}
+
+func (s *podsServer) Stop(ctx context.Context, req *grpcpb.StopPodRequest) (*grpcpb.StopPodResponse, error) {
+	opts := entities.PodStopOptions{Timeout: -1}
+	if req.Timeout != nil {
+		opts.Timeout = int(req.GetTimeout())
+	}
+	reports, err := s.engine().PodStop(ctx, []string{req.GetNameOrId()}, opts)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if len(reports) != 1 {
+		return nil, status.Errorf(codes.Internal, "expected exactly one stop report, got %d", len(reports))
+	}
+	resp := &grpcpb.StopPodResponse{Id: reports[0].Id}
+	for _, e := range reports[0].Errs {
+		resp.Errors = append(resp.Errors, e.Error())
+	}
+	return resp, nil
+}
@@ -7,16 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"strconv"
 
 	"github.com/containers/podman/v6/libpod"
-	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/pkg/api/handlers/utils"
 	api "github.com/containers/podman/v6/pkg/api/types"
-	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/containers/podman/v6/pkg/specgen"
-	"github.com/containers/podman/v6/pkg/specgen/generate"
-	"github.com/containers/podman/v6/pkg/specgenutil"
 	"go.podman.io/storage"
 )
 
@@ -34,40 +29,21 @@ type specGeneratorWire struct {
 // the new container ID on success along with any warnings.
 func CreateContainer(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
-	conf, err := runtime.GetConfigNoCopy()
+
+	// we have to set the default before we decode to make sure the correct default is set when the field is unset
+	sg, err := utils.DefaultSpecGenerator(runtime)
 	if err != nil {
 		utils.InternalServerError(w, err)
 		return
 	}
-
-	// copy vars here and not leak config pointers into specgen
-	noHosts := conf.Containers.NoHosts
-	privileged := conf.Containers.Privileged
-
-	// we have to set the default before we decode to make sure the correct default is set when the field is unset
-	wire := specGeneratorWire{
-		SpecGenerator: specgen.SpecGenerator{
-			ContainerNetworkConfig: specgen.ContainerNetworkConfig{
-				UseImageHosts: &noHosts,
-			},
-			ContainerSecurityConfig: specgen.ContainerSecurityConfig{
-				Umask:      conf.Containers.Umask,
-				Privileged: &privileged,
-			},
-			ContainerHealthCheckConfig: specgen.ContainerHealthCheckConfig{
-				HealthLogDestination: define.DefaultHealthCheckLocalDestination,
-				HealthMaxLogCount:    define.DefaultHealthMaxLogCount,
-				HealthMaxLogSize:     define.DefaultHealthMaxLogSize,
-			},
-		},
-	}
+	wire := specGeneratorWire{SpecGenerator: sg}
 
 	if err := json.NewDecoder(r.Body).Decode(&wire); err != nil {
 		utils.Error(w, http.StatusInternalServerError, fmt.Errorf("decode(): %w", err))
 		return
 	}
 
-	sg := wire.SpecGenerator
+	sg = wire.SpecGenerator
 	rLimits, err := parseRLimits(wire.Rlimits)
 	if err != nil {
 		utils.Error(w, http.StatusBadRequest, fmt.Errorf("invalid rlimit: %w", err))
@@ -75,35 +51,7 @@ func CreateContainer(w http.ResponseWriter, r *http.Request) {
 	}
 	sg.Rlimits = rLimits
 
-	if sg.Passwd == nil {
-		t := true
-		sg.Passwd = &t
-	}
-
-	// need to check for memory limit to adjust swap
-	if sg.ResourceLimits != nil && sg.ResourceLimits.Memory != nil {
-		s := ""
-		var l int64
-		if sg.ResourceLimits.Memory.Swap != nil {
-			s = strconv.Itoa(int(*sg.ResourceLimits.Memory.Swap))
-		}
-		if sg.ResourceLimits.Memory.Limit != nil {
-			l = *sg.ResourceLimits.Memory.Limit
-		}
-		specgenutil.LimitToSwap(sg.ResourceLimits.Memory, s, l)
-	}
-
-	warn, err := generate.CompleteSpec(r.Context(), runtime, &sg)
-	if err != nil {
-		if errors.Is(err, storage.ErrImageUnknown) {
-			utils.Error(w, http.StatusNotFound, fmt.Errorf("no such image: %w", err))
-			return
-		}
-		utils.InternalServerError(w, err)
-		return
-	}
-
-	rtSpec, spec, opts, err := generate.MakeContainer(r.Context(), runtime, &sg, false, nil)
+	response, err := utils.CreateContainerFromSpec(r.Context(), runtime, &sg)
 	if err != nil {
 		if errors.Is(err, storage.ErrImageUnknown) {
 			utils.Error(w, http.StatusNotFound, fmt.Errorf("no such image: %w", err))
@@ -112,12 +60,5 @@ func CreateContainer(w http.ResponseWriter, r *http.Request) {
 		utils.InternalServerError(w, err)
 		return
 	}
-	ctr, err := generate.ExecuteCreate(r.Context(), runtime, rtSpec, spec, false, opts...)
-	if err != nil {
-		utils.InternalServerError(w, err)
-		return
-	}
-
-	response := entities.ContainerCreateResponse{ID: ctr.ID(), Warnings: warn}
 	utils.WriteJSON(w, http.StatusCreated, response)
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/containers/podman/v6/libpod"
 	"github.com/containers/podman/v6/pkg/api/handlers/utils"
+	"github.com/containers/podman/v6/pkg/api/metrics"
 	api "github.com/containers/podman/v6/pkg/api/types"
 	"github.com/containers/podman/v6/pkg/auth"
 	"github.com/containers/podman/v6/pkg/channel"
@@ -129,7 +130,9 @@ func ImagesPull(w http.ResponseWriter, r *http.Request) {
 
 	// Let's keep thing simple when running in quiet mode and pull directly.
 	if query.Quiet {
+		start := time.Now()
 		images, err := runtime.LibimageRuntime().Pull(r.Context(), query.Reference, pullPolicy, pullOptions)
+		metrics.ObserveImagePull(start, err)
 		if err != nil {
 			utils.Error(w, utils.HTTPStatusFromRegistryError(err), err)
 			return
@@ -162,7 +165,9 @@ func ImagesPull(w http.ResponseWriter, r *http.Request) {
 	runCtx, cancel := context.WithCancel(r.Context())
 	go func() {
 		defer cancel()
+		start := time.Now()
 		pulledImages, pullError = runtime.LibimageRuntime().Pull(runCtx, query.Reference, pullPolicy, pullOptions)
+		metrics.ObserveImagePull(start, pullError)
 	}()
 
 	flush := func() {
@@ -3,12 +3,14 @@
 package libpod
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"go.podman.io/storage/pkg/archive"
@@ -371,3 +373,139 @@ func RemoveQuadlets(w http.ResponseWriter, r *http.Request) {
 	// The CLI behavior returns success even with partial errors
 	utils.WriteResponse(w, http.StatusOK, removeReport)
 }
+
+// StartQuadlet handles POST /libpod/quadlets/{name}/start to start the systemd service of a quadlet
+func StartQuadlet(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	quadletUnitAction(w, r, containerEngine.QuadletStart)
+}
+
+// StopQuadlet handles POST /libpod/quadlets/{name}/stop to stop the systemd service of a quadlet
+func StopQuadlet(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	quadletUnitAction(w, r, containerEngine.QuadletStop)
+}
+
+// RestartQuadlet handles POST /libpod/quadlets/{name}/restart to restart the systemd service of a quadlet
+func RestartQuadlet(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	quadletUnitAction(w, r, containerEngine.QuadletRestart)
+}
+
+func quadletUnitAction(w http.ResponseWriter, r *http.Request, action func(ctx context.Context, quadlets []string) ([]*entities.QuadletUnitReport, error)) {
+	name := utils.GetName(r)
+	reports, err := action(r.Context(), []string{name})
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	if err := reports[0].Err; err != nil {
+		if errors.Is(err, define.ErrNoSuchQuadlet) {
+			utils.Error(w, http.StatusNotFound, fmt.Errorf("no such quadlet: %s: %w", name, err))
+			return
+		}
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, nil)
+}
+
+// QuadletStatus handles GET /libpod/quadlets/{name}/status to report the state of the systemd service of a quadlet
+func QuadletStatus(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	name := utils.GetName(r)
+
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+
+	reports, err := containerEngine.QuadletStatus(r.Context(), []string{name})
+	if err == nil {
+		err = reports[0].Err
+	}
+	if err != nil {
+		if errors.Is(err, define.ErrNoSuchQuadlet) {
+			utils.Error(w, http.StatusNotFound, fmt.Errorf("no such quadlet: %s: %w", name, err))
+			return
+		}
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, reports[0])
+}
+
+// QuadletLogs handles GET /libpod/quadlets/{name}/logs to stream the journal of the systemd service of a quadlet
+func QuadletLogs(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+
+	query := struct {
+		Follow bool   `schema:"follow"`
+		Since  string `schema:"since"`
+		Tail   string `schema:"tail"`
+	}{
+		Tail: "all",
+	}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	tail := -1
+	if query.Tail != "all" {
+		var err error
+		if tail, err = strconv.Atoi(query.Tail); err != nil {
+			utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse tail %q: %w", query.Tail, err))
+			return
+		}
+	}
+
+	name := utils.GetName(r)
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	writer := &quadletLogsWriter{w: w}
+	err := containerEngine.QuadletLogs(r.Context(), name, entities.QuadletLogsOptions{
+		Follow: query.Follow,
+		Since:  query.Since,
+		Tail:   tail,
+		Writer: writer,
+	})
+	if err != nil {
+		if writer.started {
+			logrus.Errorf("Streaming logs of quadlet %s: %v", name, err)
+			return
+		}
+		if errors.Is(err, define.ErrNoSuchQuadlet) {
+			utils.Error(w, http.StatusNotFound, fmt.Errorf("no such quadlet: %s: %w", name, err))
+			return
+		}
+		utils.InternalServerError(w, err)
+		return
+	}
+	if !writer.started {
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// quadletLogsWriter writes the response header on the first write, so that
+// errors occurring before any logs are written get an error status, and
+// flushes every write to the client.
+type quadletLogsWriter struct {
+	w       http.ResponseWriter
+	started bool
+}
+
+func (q *quadletLogsWriter) Write(p []byte) (int, error) {
+	if !q.started {
+		q.started = true
+		q.w.Header().Set("Content-Type", "text/plain")
+		q.w.WriteHeader(http.StatusOK)
+	}
+	n, err := q.w.Write(p)
+	if flusher, ok := q.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return n, err
+}
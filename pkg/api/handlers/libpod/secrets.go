@@ -3,6 +3,7 @@
 package libpod
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -52,6 +53,41 @@ func CreateSecret(w http.ResponseWriter, r *http.Request) {
 	utils.WriteResponse(w, http.StatusOK, report)
 }
 
+func RotateSecret(w http.ResponseWriter, r *http.Request) {
+	var (
+		runtime = r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+		decoder = r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	)
+
+	query := struct {
+		Signal string `schema:"signal"`
+		Exec   string `schema:"exec"`
+	}{
+		// override any golang type defaults
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	opts := entities.SecretRotateOptions{
+		Signal:   query.Signal,
+		ExecHook: query.Exec,
+	}
+	name := utils.GetName(r)
+	ic := abi.ContainerEngine{Libpod: runtime}
+	report, err := ic.SecretRotate(r.Context(), name, r.Body, opts)
+	if err != nil {
+		if errors.Is(err, secrets.ErrNoSuchSecret) {
+			utils.SecretNotFound(w, name, err)
+			return
+		}
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, report)
+}
+
 func SecretExists(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
 	name := utils.GetName(r)
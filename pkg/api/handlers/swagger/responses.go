@@ -541,3 +541,10 @@ type quadletRemoveResponse struct {
 	// in:body
 	Body entities.QuadletRemoveReport
 }
+
+// Quadlet status
+// swagger:response
+type quadletStatusResponse struct {
+	// in:body
+	Body entities.QuadletStatusReport
+}
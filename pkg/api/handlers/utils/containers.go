@@ -15,6 +15,9 @@ import (
 	api "github.com/containers/podman/v6/pkg/api/types"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/containers/podman/v6/pkg/domain/infra/abi"
+	"github.com/containers/podman/v6/pkg/specgen"
+	"github.com/containers/podman/v6/pkg/specgen/generate"
+	"github.com/containers/podman/v6/pkg/specgenutil"
 
 	"github.com/containers/podman/v6/pkg/api/handlers"
 	"github.com/sirupsen/logrus"
@@ -299,3 +302,67 @@ func PSTitles(output string) []string {
 	}
 	return titles
 }
+
+// DefaultSpecGenerator returns the spec generator a container create request
+// is decoded into, so that the defaults are set when fields are unset.
+func DefaultSpecGenerator(runtime *libpod.Runtime) (specgen.SpecGenerator, error) {
+	conf, err := runtime.GetConfigNoCopy()
+	if err != nil {
+		return specgen.SpecGenerator{}, err
+	}
+
+	// copy vars here and not leak config pointers into specgen
+	noHosts := conf.Containers.NoHosts
+	privileged := conf.Containers.Privileged
+
+	return specgen.SpecGenerator{
+		ContainerNetworkConfig: specgen.ContainerNetworkConfig{
+			UseImageHosts: &noHosts,
+		},
+		ContainerSecurityConfig: specgen.ContainerSecurityConfig{
+			Umask:      conf.Containers.Umask,
+			Privileged: &privileged,
+		},
+		ContainerHealthCheckConfig: specgen.ContainerHealthCheckConfig{
+			HealthLogDestination: define.DefaultHealthCheckLocalDestination,
+			HealthMaxLogCount:    define.DefaultHealthMaxLogCount,
+			HealthMaxLogSize:     define.DefaultHealthMaxLogSize,
+		},
+	}, nil
+}
+
+// CreateContainerFromSpec completes a decoded spec generator the same way
+// for all the APIs and creates the container.
+func CreateContainerFromSpec(ctx context.Context, runtime *libpod.Runtime, sg *specgen.SpecGenerator) (*entities.ContainerCreateResponse, error) {
+	if sg.Passwd == nil {
+		t := true
+		sg.Passwd = &t
+	}
+
+	// need to check for memory limit to adjust swap
+	if sg.ResourceLimits != nil && sg.ResourceLimits.Memory != nil {
+		s := ""
+		var l int64
+		if sg.ResourceLimits.Memory.Swap != nil {
+			s = strconv.Itoa(int(*sg.ResourceLimits.Memory.Swap))
+		}
+		if sg.ResourceLimits.Memory.Limit != nil {
+			l = *sg.ResourceLimits.Memory.Limit
+		}
+		specgenutil.LimitToSwap(sg.ResourceLimits.Memory, s, l)
+	}
+
+	warn, err := generate.CompleteSpec(ctx, runtime, sg)
+	if err != nil {
+		return nil, err
+	}
+	rtSpec, spec, opts, err := generate.MakeContainer(ctx, runtime, sg, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	ctr, err := generate.ExecuteCreate(ctx, runtime, rtSpec, spec, false, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.ContainerCreateResponse{ID: ctr.ID(), Warnings: warn}, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/containers/podman/v6/libpod"
 	"github.com/containers/podman/v6/pkg/api/handlers"
 	"github.com/containers/podman/v6/pkg/api/handlers/utils/apiutil"
+	"github.com/containers/podman/v6/pkg/api/metrics"
 	api "github.com/containers/podman/v6/pkg/api/types"
 	"github.com/containers/podman/v6/pkg/errorhandling"
 	"github.com/docker/distribution/registry/api/errcode"
@@ -190,7 +191,9 @@ func CompatPull(r *http.Request, w http.ResponseWriter, runtime *libpod.Runtime,
 
 	pullResChan := make(chan pullResult)
 	go func() {
+		start := time.Now()
 		pulledImages, err := runtime.LibimageRuntime().Pull(ctx, reference, pullPolicy, pullOptions)
+		metrics.ObserveImagePull(start, err)
 		pullResChan <- pullResult{images: pulledImages, err: err}
 	}()
 
@@ -0,0 +1,165 @@
+//go:build !remote && (linux || freebsd)
+
+package metrics
+
+import (
+	"errors"
+
+	"github.com/containers/podman/v6/libpod"
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ctrLabels = []string{"id", "name"}
+
+	containersDesc = prometheus.NewDesc(prometheus.BuildFQName(Namespace, "", "containers"),
+		"Number of containers by state.", []string{"state"}, nil)
+	restartsDesc = prometheus.NewDesc(prometheus.BuildFQName(Namespace, "container", "restarts_total"),
+		"Number of times the container has been restarted by its restart policy.", ctrLabels, nil)
+	healthDesc = prometheus.NewDesc(prometheus.BuildFQName(Namespace, "container", "health_status"),
+		"Result of the container health check, 1 for the current status.", append(ctrLabels, "status"), nil)
+	cpuDesc = prometheus.NewDesc(prometheus.BuildFQName(Namespace, "container", "cpu_seconds_total"),
+		"Total CPU time consumed by the container in seconds.", ctrLabels, nil)
+	cpuSystemDesc = prometheus.NewDesc(prometheus.BuildFQName(Namespace, "container", "cpu_system_seconds_total"),
+		"CPU time consumed by the container in kernel mode in seconds.", ctrLabels, nil)
+	memUsageDesc = prometheus.NewDesc(prometheus.BuildFQName(Namespace, "container", "memory_usage_bytes"),
+		"Memory used by the container in bytes.", ctrLabels, nil)
+	memLimitDesc = prometheus.NewDesc(prometheus.BuildFQName(Namespace, "container", "memory_limit_bytes"),
+		"Memory limit of the container in bytes.", ctrLabels, nil)
+	netRxBytesDesc = prometheus.NewDesc(prometheus.BuildFQName(Namespace, "container", "network_receive_bytes_total"),
+		"Bytes received by the container per interface.", append(ctrLabels, "interface"), nil)
+	netTxBytesDesc = prometheus.NewDesc(prometheus.BuildFQName(Namespace, "container", "network_transmit_bytes_total"),
+		"Bytes transmitted by the container per interface.", append(ctrLabels, "interface"), nil)
+	netRxErrorsDesc = prometheus.NewDesc(prometheus.BuildFQName(Namespace, "container", "network_receive_errors_total"),
+		"Receive errors of the container per interface.", append(ctrLabels, "interface"), nil)
+	netTxErrorsDesc = prometheus.NewDesc(prometheus.BuildFQName(Namespace, "container", "network_transmit_errors_total"),
+		"Transmit errors of the container per interface.", append(ctrLabels, "interface"), nil)
+	blockReadDesc = prometheus.NewDesc(prometheus.BuildFQName(Namespace, "container", "block_read_bytes_total"),
+		"Bytes read from block devices by the container.", ctrLabels, nil)
+	blockWriteDesc = prometheus.NewDesc(prometheus.BuildFQName(Namespace, "container", "block_write_bytes_total"),
+		"Bytes written to block devices by the container.", ctrLabels, nil)
+	pidsDesc = prometheus.NewDesc(prometheus.BuildFQName(Namespace, "container", "pids"),
+		"Number of processes running in the container.", ctrLabels, nil)
+)
+
+// containerStates are the states reported by the podman_containers metric.
+var containerStates = []define.ContainerStatus{
+	define.ContainerStateConfigured,
+	define.ContainerStateCreated,
+	define.ContainerStateRunning,
+	define.ContainerStateStopped,
+	define.ContainerStatePaused,
+	define.ContainerStateExited,
+	define.ContainerStateRemoving,
+	define.ContainerStateStopping,
+}
+
+var healthStates = []string{define.HealthCheckHealthy, define.HealthCheckUnhealthy, define.HealthCheckStarting}
+
+// Collector exports the state and resource usage of all containers of a
+// runtime. The stats are gathered when the metrics are scraped.
+type Collector struct {
+	runtime *libpod.Runtime
+}
+
+// NewCollector returns a Collector for the containers of runtime.
+func NewCollector(runtime *libpod.Runtime) *Collector {
+	return &Collector{runtime: runtime}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		containersDesc, restartsDesc, healthDesc, cpuDesc, cpuSystemDesc, memUsageDesc, memLimitDesc,
+		netRxBytesDesc, netTxBytesDesc, netRxErrorsDesc, netTxErrorsDesc, blockReadDesc, blockWriteDesc, pidsDesc,
+	} {
+		ch <- desc
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	ctrs, err := c.runtime.GetAllContainers()
+	if err != nil {
+		logrus.Errorf("Collecting container metrics: %v", err)
+		return
+	}
+
+	counts := make(map[define.ContainerStatus]int, len(containerStates))
+	for _, ctr := range ctrs {
+		state, err := ctr.State()
+		if err != nil {
+			if !errors.Is(err, define.ErrNoSuchCtr) && !errors.Is(err, define.ErrCtrRemoved) {
+				logrus.Warnf("Collecting metrics of container %s: %v", ctr.ID(), err)
+			}
+			continue
+		}
+		counts[state]++
+		labels := []string{ctr.ID(), ctr.Name()}
+
+		if restarts, err := ctr.RestartCount(); err == nil {
+			ch <- prometheus.MustNewConstMetric(restartsDesc, prometheus.CounterValue, float64(restarts), labels...)
+		}
+
+		if status, err := ctr.HealthCheckStatus(); err == nil && status != "" {
+			for _, s := range healthStates {
+				value := 0.0
+				if s == status {
+					value = 1
+				}
+				ch <- prometheus.MustNewConstMetric(healthDesc, prometheus.GaugeValue, value, append(labels, s)...)
+			}
+		}
+
+		if state != define.ContainerStateRunning && state != define.ContainerStatePaused {
+			continue
+		}
+		stats, err := ctr.GetContainerStats(nil)
+		if err != nil {
+			if !errors.Is(err, define.ErrNoCgroups) && !errors.Is(err, define.ErrCtrRemoved) {
+				logrus.Warnf("Collecting stats of container %s: %v", ctr.ID(), err)
+			}
+			continue
+		}
+		collectStats(ch, labels, stats)
+	}
+
+	for _, state := range containerStates {
+		ch <- prometheus.MustNewConstMetric(containersDesc, prometheus.GaugeValue, float64(counts[state]), state.String())
+	}
+}
+
+// collectStats exports the resource usage of a single container.
+func collectStats(ch chan<- prometheus.Metric, labels []string, stats *define.ContainerStats) {
+	ch <- prometheus.MustNewConstMetric(cpuDesc, prometheus.CounterValue, float64(stats.CPUNano)/1e9, labels...)
+	ch <- prometheus.MustNewConstMetric(cpuSystemDesc, prometheus.CounterValue, float64(stats.CPUSystemNano)/1e9, labels...)
+	ch <- prometheus.MustNewConstMetric(memUsageDesc, prometheus.GaugeValue, float64(stats.MemUsage), labels...)
+	ch <- prometheus.MustNewConstMetric(memLimitDesc, prometheus.GaugeValue, float64(stats.MemLimit), labels...)
+	ch <- prometheus.MustNewConstMetric(blockReadDesc, prometheus.CounterValue, float64(stats.BlockInput), labels...)
+	ch <- prometheus.MustNewConstMetric(blockWriteDesc, prometheus.CounterValue, float64(stats.BlockOutput), labels...)
+	ch <- prometheus.MustNewConstMetric(pidsDesc, prometheus.GaugeValue, float64(stats.PIDs), labels...)
+	for iface, net := range stats.Network {
+		ifLabels := append(labels, iface)
+		ch <- prometheus.MustNewConstMetric(netRxBytesDesc, prometheus.CounterValue, float64(net.RxBytes), ifLabels...)
+		ch <- prometheus.MustNewConstMetric(netTxBytesDesc, prometheus.CounterValue, float64(net.TxBytes), ifLabels...)
+		ch <- prometheus.MustNewConstMetric(netRxErrorsDesc, prometheus.CounterValue, float64(net.RxErrors), ifLabels...)
+		ch <- prometheus.MustNewConstMetric(netTxErrorsDesc, prometheus.CounterValue, float64(net.TxErrors), ifLabels...)
+	}
+}
+
+// NewRegistry returns a registry with the API and container metrics of
+// runtime as well as the Go runtime and process metrics of the service.
+func NewRegistry(runtime *libpod.Runtime) *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		APIRequestDuration,
+		ImagePullDuration,
+		NewCollector(runtime),
+	)
+	return reg
+}
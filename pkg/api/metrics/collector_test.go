@@ -0,0 +1,88 @@
+//go:build !remote && (linux || freebsd)
+
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gather drains ch and returns the metrics keyed by name and labels.
+func gather(t *testing.T, ch chan prometheus.Metric) map[string]*dto.Metric {
+	close(ch)
+	metrics := make(map[string]*dto.Metric)
+	for m := range ch {
+		out := &dto.Metric{}
+		require.NoError(t, m.Write(out))
+		labels := make([]string, 0, len(out.GetLabel()))
+		for _, l := range out.GetLabel() {
+			labels = append(labels, l.GetName()+"="+l.GetValue())
+		}
+		name := m.Desc().String()
+		name = name[strings.Index(name, `"`)+1:]
+		name = name[:strings.Index(name, `"`)]
+		metrics[name+"{"+strings.Join(labels, ",")+"}"] = out
+	}
+	return metrics
+}
+
+func TestCollectStats(t *testing.T) {
+	stats := &define.ContainerStats{
+		CPUNano:       2_500_000_000,
+		CPUSystemNano: 500_000_000,
+		MemUsage:      1024,
+		MemLimit:      4096,
+		BlockInput:    10,
+		BlockOutput:   20,
+		PIDs:          3,
+		Network: map[string]define.ContainerNetworkStats{
+			"eth0": {RxBytes: 100, TxBytes: 200, RxErrors: 1},
+		},
+	}
+
+	ch := make(chan prometheus.Metric, 32)
+	collectStats(ch, []string{"abc", "web"}, stats)
+	metrics := gather(t, ch)
+	assert.Len(t, metrics, 11)
+
+	ctr := "{id=abc,name=web}"
+	assert.InDelta(t, 2.5, metrics["podman_container_cpu_seconds_total"+ctr].GetCounter().GetValue(), 0.001)
+	assert.InDelta(t, 0.5, metrics["podman_container_cpu_system_seconds_total"+ctr].GetCounter().GetValue(), 0.001)
+	assert.Equal(t, 1024.0, metrics["podman_container_memory_usage_bytes"+ctr].GetGauge().GetValue())
+	assert.Equal(t, 4096.0, metrics["podman_container_memory_limit_bytes"+ctr].GetGauge().GetValue())
+	assert.Equal(t, 10.0, metrics["podman_container_block_read_bytes_total"+ctr].GetCounter().GetValue())
+	assert.Equal(t, 20.0, metrics["podman_container_block_write_bytes_total"+ctr].GetCounter().GetValue())
+	assert.Equal(t, 3.0, metrics["podman_container_pids"+ctr].GetGauge().GetValue())
+
+	iface := "{id=abc,interface=eth0,name=web}"
+	assert.Equal(t, 100.0, metrics["podman_container_network_receive_bytes_total"+iface].GetCounter().GetValue())
+	assert.Equal(t, 200.0, metrics["podman_container_network_transmit_bytes_total"+iface].GetCounter().GetValue())
+	assert.Equal(t, 1.0, metrics["podman_container_network_receive_errors_total"+iface].GetCounter().GetValue())
+}
+
+func TestObserveImagePull(t *testing.T) {
+	ImagePullDuration.Reset()
+	defer ImagePullDuration.Reset()
+
+	start := time.Now().Add(-2 * time.Second)
+	ObserveImagePull(start, nil)
+	ObserveImagePull(start, nil)
+	ObserveImagePull(start, errors.New("pull failed"))
+
+	ch := make(chan prometheus.Metric, 4)
+	ImagePullDuration.Collect(ch)
+	metrics := gather(t, ch)
+
+	success := metrics["podman_image_pull_duration_seconds{result=success}"].GetHistogram()
+	assert.Equal(t, uint64(2), success.GetSampleCount())
+	assert.GreaterOrEqual(t, success.GetSampleSum(), 4.0)
+	assert.Equal(t, uint64(1), metrics["podman_image_pull_duration_seconds{result=error}"].GetHistogram().GetSampleCount())
+}
@@ -0,0 +1,46 @@
+// Package metrics provides the Prometheus metrics exported by the API service.
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Namespace is the prefix of all metrics exported by podman.
+const Namespace = "podman"
+
+var (
+	// APIRequestDuration records the latency of API requests by route.
+	APIRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of API requests in seconds.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "code"})
+
+	// ImagePullDuration records the time taken by image pulls through the API.
+	ImagePullDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "image",
+		Name:      "pull_duration_seconds",
+		Help:      "Duration of image pulls in seconds.",
+		Buckets:   []float64{0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600},
+	}, []string{"result"})
+)
+
+// ObserveAPIRequest records the duration of an API request.
+func ObserveAPIRequest(method, route string, code int, d time.Duration) {
+	APIRequestDuration.WithLabelValues(method, route, strconv.Itoa(code)).Observe(d.Seconds())
+}
+
+// ObserveImagePull records the duration of an image pull started at start.
+func ObserveImagePull(start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	ImagePullDuration.WithLabelValues(result).Observe(time.Since(start).Seconds())
+}
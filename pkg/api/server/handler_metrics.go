@@ -0,0 +1,28 @@
+//go:build !remote && (linux || freebsd)
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v6/pkg/api/metrics"
+	"github.com/felixge/httpsnoop"
+	"github.com/gorilla/mux"
+)
+
+// metricsHandler records the latency of each request.  Requests are labelled
+// with the matched route rather than the URL to keep the cardinality low.
+func metricsHandler() mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			m := httpsnoop.CaptureMetrics(h, w, r)
+			route := "other"
+			if current := mux.CurrentRoute(r); current != nil {
+				if tmpl, err := current.GetPathTemplate(); err == nil {
+					route = tmpl
+				}
+			}
+			metrics.ObserveAPIRequest(r.Method, route, m.Code, m.Duration)
+		})
+	}
+}
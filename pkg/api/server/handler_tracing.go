@@ -0,0 +1,36 @@
+//go:build !remote && (linux || freebsd)
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingHandler creates a span for each request, continuing the trace
+// propagated by the client if any. Spans are named after the matched route
+// rather than the URL to keep their cardinality low.
+func tracingHandler() mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		tagged := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			span := trace.SpanFromContext(r.Context())
+			span.SetAttributes(attribute.String("podman.api.reference_id", r.Header.Get("X-Reference-Id")))
+			h.ServeHTTP(w, r)
+		})
+		return otelhttp.NewHandler(tagged, "api",
+			otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+				path := r.URL.Path
+				if route := mux.CurrentRoute(r); route != nil {
+					if tmpl, err := route.GetPathTemplate(); err == nil {
+						path = tmpl
+					}
+				}
+				return r.Method + " " + path
+			}),
+		)
+	}
+}
@@ -76,6 +76,129 @@ func (s *APIServer) registerQuadletHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.HandleFunc(VersionedPath("/libpod/quadlets/{name}/exists"), s.APIHandler(libpod.QuadletExists)).Methods(http.MethodGet)
+	// swagger:operation POST /libpod/quadlets/{name}/start libpod QuadletStartLibpod
+	// ---
+	// tags:
+	//   - quadlets
+	// summary: Start a quadlet
+	// description: Start the systemd service generated from a quadlet and wait for the job to complete.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the quadlet with extension (e.g., "myapp.container")
+	// responses:
+	//   204:
+	//     description: no error
+	//   404:
+	//     $ref: "#/responses/quadletNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/quadlets/{name}/start"), s.APIHandler(libpod.StartQuadlet)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/quadlets/{name}/stop libpod QuadletStopLibpod
+	// ---
+	// tags:
+	//   - quadlets
+	// summary: Stop a quadlet
+	// description: Stop the systemd service generated from a quadlet and wait for the job to complete.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the quadlet with extension (e.g., "myapp.container")
+	// responses:
+	//   204:
+	//     description: no error
+	//   404:
+	//     $ref: "#/responses/quadletNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/quadlets/{name}/stop"), s.APIHandler(libpod.StopQuadlet)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/quadlets/{name}/restart libpod QuadletRestartLibpod
+	// ---
+	// tags:
+	//   - quadlets
+	// summary: Restart a quadlet
+	// description: Restart the systemd service generated from a quadlet and wait for the job to complete.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the quadlet with extension (e.g., "myapp.container")
+	// responses:
+	//   204:
+	//     description: no error
+	//   404:
+	//     $ref: "#/responses/quadletNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/quadlets/{name}/restart"), s.APIHandler(libpod.RestartQuadlet)).Methods(http.MethodPost)
+	// swagger:operation GET /libpod/quadlets/{name}/status libpod QuadletStatusLibpod
+	// ---
+	// tags:
+	//   - quadlets
+	// summary: Get quadlet status
+	// description: Get the state of the systemd service generated from a quadlet
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the quadlet with extension (e.g., "myapp.container")
+	// responses:
+	//   200:
+	//     $ref: "#/responses/quadletStatusResponse"
+	//   404:
+	//     $ref: "#/responses/quadletNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/quadlets/{name}/status"), s.APIHandler(libpod.QuadletStatus)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/quadlets/{name}/logs libpod QuadletLogsLibpod
+	// ---
+	// tags:
+	//   - quadlets
+	// summary: Get quadlet logs
+	// description: Get the journal entries of the systemd service generated from a quadlet
+	// produces:
+	// - text/plain
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the quadlet with extension (e.g., "myapp.container")
+	//  - in: query
+	//    name: follow
+	//    type: boolean
+	//    default: false
+	//    description: Keep streaming new journal entries
+	//  - in: query
+	//    name: since
+	//    type: string
+	//    description: Only return entries since the given time, in any format understood by journalctl
+	//  - in: query
+	//    name: tail
+	//    type: string
+	//    default: all
+	//    description: Only return this number of entries from the end of the journal, or "all"
+	// responses:
+	//   200:
+	//     description: logs returned as a stream
+	//     schema:
+	//       type: string
+	//       format: binary
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/quadletNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/quadlets/{name}/logs"), s.APIHandler(libpod.QuadletLogs)).Methods(http.MethodGet)
 	// swagger:operation POST /libpod/quadlets libpod QuadletInstallLibpod
 	// ---
 	// tags:
@@ -127,6 +127,41 @@ func (s *APIServer) registerSecretHandlers(r *mux.Router) error {
 	//   '500':
 	//     "$ref": "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/secrets/{name}/exists"), s.APIHandler(libpod.SecretExists)).Methods(http.MethodGet)
+	// swagger:operation POST /libpod/secrets/{name}/rotate libpod SecretRotateLibpod
+	// ---
+	// tags:
+	//  - secrets
+	// summary: Rotate secret
+	// description: Replace the data of a secret and update the containers mounting it as a file.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the secret
+	//  - in: query
+	//    name: signal
+	//    type: string
+	//    description: Signal sent to the running containers mounting the secret
+	//  - in: query
+	//    name: exec
+	//    type: string
+	//    description: Command run in the running containers mounting the secret, either a JSON array or a command run by /bin/sh
+	//  - in: body
+	//    name: request
+	//    description: New secret data
+	//    schema:
+	//      type: string
+	// produces:
+	// - application/json
+	// responses:
+	//   '200':
+	//     "$ref": "#/responses/SecretRotateResponse"
+	//   '404':
+	//     "$ref": "#/responses/NoSuchSecret"
+	//   '500':
+	//     "$ref": "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/secrets/{name}/rotate"), s.APIHandler(libpod.RotateSecret)).Methods(http.MethodPost)
 	// swagger:operation DELETE /libpod/secrets/{name} libpod SecretDeleteLibpod
 	// ---
 	// tags:
@@ -22,6 +22,7 @@ import (
 	"github.com/containers/podman/v6/pkg/api/grpcpb"
 	"github.com/containers/podman/v6/pkg/api/handlers"
 	grpchandlers "github.com/containers/podman/v6/pkg/api/handlers/grpc"
+	"github.com/containers/podman/v6/pkg/api/metrics"
 	"github.com/containers/podman/v6/pkg/api/server/idle"
 	"github.com/containers/podman/v6/pkg/api/types"
 	"github.com/containers/podman/v6/pkg/domain/entities"
@@ -29,6 +30,7 @@ import (
 	"github.com/coreos/go-systemd/v22/daemon"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/schema"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -44,6 +46,8 @@ type APIServer struct {
 	context.Context                  // Context to carry objects to handlers
 	CorsHeaders        string        // Inject Cross-Origin Resource Sharing (CORS) headers
 	PProfAddr          string        // Binding network address for pprof profiles
+	MetricsAddr        string        // Binding network address for Prometheus metrics
+	metricsServer      *http.Server  // Serves the Prometheus metrics on MetricsAddr
 	idleTracker        *idle.Tracker // Track connections to support idle shutdown
 	tlsCertFile        string        // TLS serving certificate PEM file
 	tlsKeyFile         string        // TLS serving certificate private key PEM file
@@ -55,6 +59,11 @@ const (
 	DefaultCorsHeaders       = ""
 	DefaultServiceDuration   = 300 * time.Second
 	UnlimitedServiceDuration = 0 * time.Second
+
+	// Timeouts of the connections to the metrics service, which is
+	// scraped periodically
+	metricsReadHeaderTimeout = 10 * time.Second
+	metricsIdleTimeout       = 2 * time.Minute
 )
 
 // shutdownOnce ensures Shutdown() may safely be called from several go routines
@@ -95,6 +104,7 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 		CorsHeaders:     opts.CorsHeaders,
 		Listener:        listener,
 		PProfAddr:       opts.PProfAddr,
+		MetricsAddr:     opts.MetricsAddr,
 		idleTracker:     tracker,
 		tlsCertFile:     opts.TLSCertFile,
 		tlsKeyFile:      opts.TLSKeyFile,
@@ -126,7 +136,11 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 
 	// Capture panics and print stack traces for diagnostics,
 	// additionally process X-Reference-Id Header to support event correlation
-	router.Use(panicHandler(), referenceIDHandler())
+	// and record a trace span for each request
+	router.Use(panicHandler(), referenceIDHandler(), tracingHandler())
+	if opts.MetricsAddr != "" {
+		router.Use(metricsHandler())
+	}
 	router.NotFoundHandler = http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
 			// We can track user errors...
@@ -175,7 +189,11 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 		}
 	}
 
-	grpcpb.RegisterNoopServer(server.grpc, grpchandlers.NewNoopServer(runtime)) // TODO: make this table-driven instead of a one-off?
+	grpcpb.RegisterNoopServer(server.grpc, grpchandlers.NewNoopServer(runtime))
+	grpcpb.RegisterContainersServer(server.grpc, grpchandlers.NewContainersServer(runtime))
+	grpcpb.RegisterPodsServer(server.grpc, grpchandlers.NewPodsServer(runtime))
+	grpcpb.RegisterImagesServer(server.grpc, grpchandlers.NewImagesServer(runtime))
+	grpcpb.RegisterEventsServer(server.grpc, grpchandlers.NewEventsServer(runtime))
 
 	if logrus.IsLevelEnabled(logrus.TraceLevel) {
 		// If in trace mode log request and response bodies
@@ -225,6 +243,7 @@ func (s *APIServer) setupSystemd() {
 // Serve starts responding to HTTP requests.
 func (s *APIServer) Serve() error {
 	s.setupPprof()
+	s.setupMetrics()
 
 	if err := shutdown.Register("service", func(_ os.Signal) error {
 		s.grpc.GracefulStop()
@@ -305,6 +324,37 @@ func (s *APIServer) setupPprof() {
 	}()
 }
 
+// setupMetrics exposes the Prometheus metrics of the service and its
+// containers on a separate listener
+//
+// Example:
+// curl http://localhost:9882/metrics
+func (s *APIServer) setupMetrics() {
+	if s.MetricsAddr == "" {
+		return
+	}
+
+	router := mux.NewRouter()
+	router.Handle("/metrics", promhttp.HandlerFor(metrics.NewRegistry(s.Runtime), promhttp.HandlerOpts{
+		ErrorLog: logrus.StandardLogger(),
+	}))
+	s.metricsServer = &http.Server{
+		Addr:              s.MetricsAddr,
+		ErrorLog:          log.New(logrus.StandardLogger().Out, "", 0),
+		Handler:           router,
+		ReadHeaderTimeout: metricsReadHeaderTimeout,
+		IdleTimeout:       metricsIdleTimeout,
+	}
+
+	logrus.Infof("metrics service listening on %q", s.MetricsAddr)
+	go func() {
+		err := s.metricsServer.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			logrus.Warnf("metrics service failed: %v", err)
+		}
+	}()
+}
+
 // Shutdown is a clean shutdown waiting on existing clients
 func (s *APIServer) Shutdown(halt bool) error {
 	switch {
@@ -324,6 +374,13 @@ func (s *APIServer) Shutdown(halt bool) error {
 		if s.idleTracker.Duration > 0 {
 			deadline = s.idleTracker.Duration
 		}
+		// The metrics service is only scraped, do not wait on it
+		if s.metricsServer != nil {
+			if err := s.metricsServer.Close(); err != nil {
+				logrus.Errorf("Failed to close metrics service: %v", err)
+			}
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), deadline)
 		go func() {
 			defer cancel()
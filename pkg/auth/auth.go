@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 
@@ -28,8 +29,21 @@ const xRegistryConfigHeader = "X-Registry-Config"
 // the necessary authentication information for libpod operations, possibly
 // creating a config file. If that is the case, the caller must call RemoveAuthFile.
 func GetCredentials(r *http.Request) (*types.DockerAuthConfig, string, error) {
+	return getCredentials(r.Header, r.URL.Query(), r.URL.String())
+}
+
+// GetCredentialsFromHeader is like GetCredentials for the X-Registry-.*
+// headers of a request which is not an HTTP request, like the metadata of a
+// gRPC call.
+func GetCredentialsFromHeader(header http.Header) (*types.DockerAuthConfig, string, error) {
+	return getCredentials(header, nil, "request")
+}
+
+// getCredentials extracts the authentication information from the headers
+// of a request, query holds its parameters and description names it in errors.
+func getCredentials(header http.Header, query url.Values, description string) (*types.DockerAuthConfig, string, error) {
 	nonemptyHeaderValue := func(key string) ([]string, bool) {
-		hdr := r.Header.Values(key)
+		hdr := header.Values(key)
 		return hdr, len(hdr) > 0
 	}
 	var override *types.DockerAuthConfig
@@ -38,7 +52,7 @@ func GetCredentials(r *http.Request) (*types.DockerAuthConfig, string, error) {
 	var err error
 	if hdr, ok := nonemptyHeaderValue(xRegistryConfigHeader); ok {
 		headerName = xRegistryConfigHeader
-		override, fileContents, err = getConfigCredentials(r, hdr)
+		override, fileContents, err = getConfigCredentials(query, hdr)
 	} else if hdr, ok := nonemptyHeaderValue(xRegistryAuthHeader); ok {
 		headerName = xRegistryAuthHeader
 		override, fileContents, err = getAuthCredentials(hdr)
@@ -46,7 +60,7 @@ func GetCredentials(r *http.Request) (*types.DockerAuthConfig, string, error) {
 		return nil, "", nil
 	}
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to parse %q header for %s: %w", headerName, r.URL.String(), err)
+		return nil, "", fmt.Errorf("failed to parse %q header for %s: %w", headerName, description, err)
 	}
 
 	var authFile string
@@ -55,16 +69,16 @@ func GetCredentials(r *http.Request) (*types.DockerAuthConfig, string, error) {
 	} else {
 		authFile, err = authConfigsToAuthFile(fileContents)
 		if err != nil {
-			return nil, "", fmt.Errorf("failed to parse %q header for %s: %w", headerName, r.URL.String(), err)
+			return nil, "", fmt.Errorf("failed to parse %q header for %s: %w", headerName, description, err)
 		}
 	}
 	return override, authFile, nil
 }
 
-// getConfigCredentials extracts one or more docker.AuthConfig from a request and its
+// getConfigCredentials extracts one or more docker.AuthConfig from the query parameters of a request and its
 // xRegistryConfigHeader value.  An empty key will be used as default while a named registry will be
 // returned as types.DockerAuthConfig
-func getConfigCredentials(r *http.Request, headers []string) (*types.DockerAuthConfig, map[string]types.DockerAuthConfig, error) {
+func getConfigCredentials(query url.Values, headers []string) (*types.DockerAuthConfig, map[string]types.DockerAuthConfig, error) {
 	var auth *types.DockerAuthConfig
 	configs := make(map[string]types.DockerAuthConfig)
 
@@ -91,7 +105,7 @@ func getConfigCredentials(r *http.Request, headers []string) (*types.DockerAuthC
 	}
 
 	// Override any default given above if specialized credentials provided
-	if registries, found := r.URL.Query()["registry"]; found {
+	if registries, found := query["registry"]; found {
 		for _, r := range registries {
 			for k, v := range configs {
 				if strings.Contains(k, r) {
@@ -157,6 +157,26 @@ func TestMakeXRegistryAuthHeaderGetCredentialsRoundtrip(t *testing.T) {
 	}
 }
 
+func TestGetCredentialsFromHeader(t *testing.T) {
+	sys := systemContextForAuthFile(t, "")
+	headers, err := MakeXRegistryAuthHeader(sys, "user", "pass")
+	require.NoError(t, err)
+
+	override, resPath, err := GetCredentialsFromHeader(headers)
+	require.NoError(t, err)
+	defer RemoveAuthfile(resPath)
+	require.NotNil(t, override)
+	assert.Equal(t, types.DockerAuthConfig{Username: "user", Password: "pass"}, *override)
+
+	override, resPath, err = GetCredentialsFromHeader(http.Header{})
+	require.NoError(t, err)
+	assert.Nil(t, override)
+	assert.Empty(t, resPath)
+
+	_, _, err = GetCredentialsFromHeader(http.Header{xRegistryAuthHeader: []string{"not base64!"}})
+	assert.ErrorContains(t, err, "failed to parse \"X-Registry-Auth\" header for request")
+}
+
 func TestMakeXRegistryConfigHeader(t *testing.T) {
 	for _, tc := range []struct {
 		name               string
@@ -7,19 +7,35 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/blang/semver/v4"
 	"github.com/containers/podman/v6/libpod"
 	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/libpod/events"
 	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/rootless"
 	"github.com/containers/podman/v6/pkg/systemd"
 	systemdDefine "github.com/containers/podman/v6/pkg/systemd/define"
+	"github.com/containers/podman/v6/pkg/systemd/generate"
+	"github.com/containers/podman/v6/pkg/util"
 	"github.com/coreos/go-systemd/v22/dbus"
+	"github.com/opencontainers/go-digest"
 	"github.com/sirupsen/logrus"
 	"go.podman.io/common/libimage"
 	"go.podman.io/common/pkg/config"
 	"go.podman.io/image/v5/docker"
+	"go.podman.io/image/v5/docker/reference"
+	"go.podman.io/image/v5/image"
+	"go.podman.io/image/v5/manifest"
+	"go.podman.io/image/v5/signature"
+	"go.podman.io/image/v5/types"
+	"go.podman.io/storage/pkg/fileutils"
 )
 
 // Policy represents an auto-update policy.
@@ -48,7 +64,7 @@ type updater struct {
 	conn             *dbus.Conn                  // DBUS connection
 	options          *entities.AutoUpdateOptions // User-specified options
 	unitToTasks      map[string][]*task          // Keeps track of tasks per unit
-	updatedRawImages map[string]bool             // Keeps track of updated images
+	updatedRawImages map[string]string           // Keeps track of updated images and their digests
 	runtime          *libpod.Runtime             // The libpod runtime
 }
 
@@ -58,20 +74,36 @@ const (
 	statusNotUpdated = "false"       // No update was needed
 	statusPending    = "pending"     // The update is pending (see options.DryRun)
 	statusRolledBack = "rolled back" // Rollback after a failed update
+	statusHalted     = "halted"      // A unit of an earlier stage failed to update
 )
 
+// healthPollInterval is the interval at which the health of updated
+// containers is checked.
+const healthPollInterval = time.Second
+
 // task includes data and state for updating a container
 type task struct {
-	authfile     string            // Container-specific authfile
-	auto         *updater          // Reverse pointer to the updater
-	container    *libpod.Container // Container to update
-	policy       Policy            // Update policy
-	image        *libimage.Image   // Original image before the update
-	rawImageName string            // The container's raw image name
-	status       string            // Auto-update status
-	unit         string            // Name of the systemd unit
+	authfile        string            // Container-specific authfile
+	auto            *updater          // Reverse pointer to the updater
+	container       *libpod.Container // Container to update
+	digest          string            // Digest of the new image
+	healthTimeout   time.Duration     // Time for the updated container to become healthy
+	policy          Policy            // Update policy
+	image           *libimage.Image   // Original image before the update
+	rawImageName    string            // The container's raw image name
+	semver          semver.Range      // Version range of the tags to update to
+	signaturePolicy string            // Container-specific signature policy
+	stage           int               // Stage in which the unit gets updated
+	status          string            // Auto-update status
+	unit            string            // Name of the systemd unit
+	updateTag       string            // Tag matching the version range to update to
+	dropIn          []byte            // Previous content of the unit's auto-update drop-in, nil if none
 }
 
+// dropInName is the name of the drop-in of a systemd unit that points the
+// unit at the image tag matching the io.containers.autoupdate.semver label.
+const dropInName = "podman-auto-update.conf"
+
 // LookupPolicy looks up the corresponding Policy for the specified
 // string. If none is found, an errors is returned including the list of
 // supported policies.
@@ -107,6 +139,10 @@ func LookupPolicy(s string) (Policy, error) {
 // of a running container is different than the local one. If the image digests
 // differ, it restarts the systemd unit with the new image.
 //
+// Units are updated in stages, ordered by the io.containers.autoupdate.stage
+// label of their containers.  If a unit fails to update, the units of later
+// stages are not updated.
+//
 // It returns a slice of successfully restarted systemd units and a slice of
 // errors encountered during auto update.
 func AutoUpdate(ctx context.Context, runtime *libpod.Runtime, options entities.AutoUpdateOptions) ([]*entities.AutoUpdateReport, []error) {
@@ -117,7 +153,7 @@ func AutoUpdate(ctx context.Context, runtime *libpod.Runtime, options entities.A
 	auto := updater{
 		options:          &options,
 		runtime:          runtime,
-		updatedRawImages: make(map[string]bool),
+		updatedRawImages: make(map[string]string),
 	}
 
 	// Find auto-update tasks and assemble them by unit.
@@ -142,17 +178,64 @@ func AutoUpdate(ctx context.Context, runtime *libpod.Runtime, options entities.A
 
 	// Update all images/container according to their auto-update policy.
 	var allReports []*entities.AutoUpdateReport
-	for unit, tasks := range auto.unitToTasks {
-		unitErrors := auto.updateUnit(ctx, unit, tasks)
-		allErrors = append(allErrors, unitErrors...)
-		for _, task := range tasks {
-			allReports = append(allReports, task.report())
+	halted := false
+	for _, units := range auto.stages() {
+		stageFailed := false
+		for _, unit := range units {
+			tasks := auto.unitToTasks[unit]
+			if halted {
+				for _, task := range tasks {
+					task.status = statusHalted
+				}
+			} else {
+				unitErrors := auto.updateUnit(ctx, unit, tasks)
+				allErrors = append(allErrors, unitErrors...)
+				for _, task := range tasks {
+					if task.status == statusFailed || task.status == statusRolledBack {
+						stageFailed = true
+					}
+				}
+			}
+			for _, task := range tasks {
+				allReports = append(allReports, task.report())
+			}
+		}
+		if stageFailed && !halted {
+			logrus.Warnf("Not updating the units of later stages as the update of a unit failed")
+			halted = true
 		}
 	}
 
 	return allReports, allErrors
 }
 
+// stages returns the units to update grouped by stage in ascending order.
+// The stage of a unit is the lowest stage of its containers.
+func (u *updater) stages() [][]string {
+	unitStages := make(map[int][]string)
+	for unit, tasks := range u.unitToTasks {
+		stage := tasks[0].stage
+		for _, task := range tasks[1:] {
+			stage = min(stage, task.stage)
+		}
+		unitStages[stage] = append(unitStages[stage], unit)
+	}
+
+	keys := make([]int, 0, len(unitStages))
+	for stage := range unitStages {
+		keys = append(keys, stage)
+	}
+	sort.Ints(keys)
+
+	stages := make([][]string, 0, len(keys))
+	for _, stage := range keys {
+		units := unitStages[stage]
+		sort.Strings(units)
+		stages = append(stages, units)
+	}
+	return stages
+}
+
 // updateUnit auto updates the tasks in the specified systemd unit.
 func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) []error {
 	var errors []error
@@ -195,6 +278,9 @@ func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) []
 	}
 
 	updateError := u.restartSystemdUnit(ctx, unit)
+	if updateError == nil {
+		updateError = u.waitForHealthyUnit(ctx, unit, tasks)
+	}
 	for _, task := range tasks {
 		if updateError == nil {
 			task.status = statusUpdated
@@ -213,7 +299,7 @@ func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) []
 
 	// The update has failed and rollbacks are enabled.
 	for _, task := range tasks {
-		if err := task.rollbackImage(); err != nil {
+		if err := task.rollbackImage(ctx); err != nil {
 			err = fmt.Errorf("rolling back image for container %s in unit %s: %w", task.container.ID(), unit, err)
 			errors = append(errors, err)
 		}
@@ -243,6 +329,7 @@ func (t *task) report() *entities.AutoUpdateReport {
 		ImageName:     t.container.RawImageName(),
 		Policy:        string(t.policy),
 		SystemdUnit:   t.unit,
+		Digest:        t.digest,
 		Updated:       t.status,
 	}
 }
@@ -272,42 +359,282 @@ func (t *task) update(ctx context.Context) error {
 	}
 }
 
-// registryUpdateAvailable returns whether a new image on the registry is available.
+// systemContext returns the system context to contact the registry of the
+// task's image.
+func (t *task) systemContext() *types.SystemContext {
+	sys := *t.auto.runtime.SystemContext()
+	if t.authfile != "" {
+		sys.AuthFilePath = t.authfile
+	}
+	if t.auto.options.InsecureSkipTLSVerify != types.OptionalBoolUndefined {
+		sys.DockerInsecureSkipTLSVerify = t.auto.options.InsecureSkipTLSVerify
+	}
+	return &sys
+}
+
+// remoteReference returns the reference of the image to update to.  If the
+// task has a version range, it is the highest tag of the repository matching
+// the range, otherwise it is the container's raw image name.
+func (t *task) remoteReference(ctx context.Context) (reference.Named, error) {
+	named, err := reference.ParseNormalizedNamed(t.rawImageName)
+	if err != nil {
+		return nil, err
+	}
+	if t.semver == nil {
+		return named, nil
+	}
+
+	repoRef, err := docker.NewReference(reference.TrimNamed(named))
+	if err != nil {
+		return nil, err
+	}
+	tags, err := docker.GetRepositoryTags(ctx, t.systemContext(), repoRef)
+	if err != nil {
+		return nil, fmt.Errorf("listing tags of %s: %w", reference.TrimNamed(named), err)
+	}
+	type candidate struct {
+		tag     string
+		version semver.Version
+	}
+	var candidates []candidate
+	for _, tag := range tags {
+		version, err := semver.ParseTolerant(tag)
+		// Pre-releases are never updated to.
+		if err != nil || len(version.Pre) > 0 || !t.semver(version) {
+			continue
+		}
+		candidates = append(candidates, candidate{tag: tag, version: version})
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no tag of %s matches the version range %q", reference.TrimNamed(named), t.container.Labels()[define.AutoUpdateSemverLabel])
+	}
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		return b.version.Compare(a.version)
+	})
+
+	// Update to the highest version whose image satisfies the signature
+	// policy.
+	for _, c := range candidates {
+		tagged, err := reference.WithTag(reference.TrimNamed(named), c.tag)
+		if err != nil {
+			return nil, err
+		}
+		ref, err := docker.NewReference(tagged)
+		if err != nil {
+			return nil, err
+		}
+		if err := t.checkSignaturePolicy(ctx, ref); err != nil {
+			logrus.Warnf("Not updating container %s to %s: %v", t.container.ID(), tagged, err)
+			continue
+		}
+		t.updateTag = c.tag
+		return tagged, nil
+	}
+	return nil, fmt.Errorf("no tag of %s matching the version range %q satisfies the signature policy", reference.TrimNamed(named), t.container.Labels()[define.AutoUpdateSemverLabel])
+}
+
+// checkSignaturePolicy returns an error if the remote image does not satisfy
+// the task's signature policy or, if the task has none, the default policy.
+func (t *task) checkSignaturePolicy(ctx context.Context, ref types.ImageReference) error {
+	sys := t.systemContext()
+	var (
+		policy *signature.Policy
+		err    error
+	)
+	if t.signaturePolicy != "" {
+		policy, err = signature.NewPolicyFromFile(t.signaturePolicy)
+	} else {
+		policy, err = signature.DefaultPolicy(sys)
+	}
+	if err != nil {
+		return err
+	}
+	policyContext, err := signature.NewPolicyContext(policy)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := policyContext.Destroy(); err != nil {
+			logrus.Errorf("Destroying signature policy context: %v", err)
+		}
+	}()
+
+	src, err := ref.NewImageSource(ctx, sys)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	if _, err := policyContext.IsRunningImageAllowed(ctx, image.UnparsedInstance(src, nil)); err != nil {
+		return fmt.Errorf("signature policy: %w", err)
+	}
+	return nil
+}
+
+// registryUpdateAvailable returns whether a new image on the registry is
+// available.  The digest of the new image is pinned such that exactly this
+// image is pulled on update.
 func (t *task) registryUpdateAvailable(ctx context.Context) (bool, error) {
 	// The newer image has already been pulled for another task, so we know
-	// there's a newer one available.
-	if _, exists := t.auto.updatedRawImages[t.rawImageName]; exists {
+	// there's a newer one available.  Tasks with a version range look up
+	// the tag to update to.
+	if pulled, exists := t.auto.updatedRawImages[t.rawImageName]; exists && t.semver == nil {
+		t.digest = pulled
 		return true, nil
 	}
 
-	remoteRef, err := docker.ParseReference("//" + t.rawImageName)
+	named, err := t.remoteReference(ctx)
 	if err != nil {
 		return false, err
 	}
+	remoteRef, err := docker.NewReference(named)
+	if err != nil {
+		return false, err
+	}
+	remoteDigest, err := t.remoteDigest(ctx, remoteRef)
+	if err != nil {
+		return false, err
+	}
+	pinned, err := reference.WithDigest(reference.TrimNamed(named), remoteDigest)
+	if err != nil {
+		return false, err
+	}
+	if remoteRef, err = docker.NewReference(pinned); err != nil {
+		return false, err
+	}
+
 	options := &libimage.HasDifferentDigestOptions{
 		AuthFilePath:          t.authfile,
 		InsecureSkipTLSVerify: t.auto.options.InsecureSkipTLSVerify,
 	}
-	return t.image.HasDifferentDigest(ctx, remoteRef, options)
+	updateAvailable, err := t.image.HasDifferentDigest(ctx, remoteRef, options)
+	if err != nil || !updateAvailable {
+		return false, err
+	}
+	t.digest = remoteDigest.String()
+	return true, nil
 }
 
-// registryUpdate pulls down the image from the registry.
+// remoteDigest returns the digest of the manifest (list) of the remote image.
+func (t *task) remoteDigest(ctx context.Context, remoteRef types.ImageReference) (digest.Digest, error) {
+	src, err := remoteRef.NewImageSource(ctx, t.systemContext())
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+	manifestBlob, _, err := src.GetManifest(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	return manifest.Digest(manifestBlob)
+}
+
+// registryUpdate pulls down the image with the pinned digest from the
+// registry and names it after the container's raw image name, so that
+// restarting the systemd unit uses it.  If the task has a version range, the
+// image is named after the tag matching the range instead and the systemd
+// unit is pointed at it.  If the task has a signature policy, the image must
+// satisfy it.
 func (t *task) registryUpdate(ctx context.Context) error {
 	// The newer image has already been pulled for another task.
-	if _, exists := t.auto.updatedRawImages[t.rawImageName]; exists {
+	if _, exists := t.auto.updatedRawImages[t.rawImageName]; exists && t.semver == nil {
 		return nil
 	}
 
+	named, err := reference.ParseNormalizedNamed(t.rawImageName)
+	if err != nil {
+		return err
+	}
+	pinned := reference.TrimNamed(named).String() + "@" + t.digest
+
 	pullOptions := &libimage.PullOptions{}
 	pullOptions.AuthFilePath = t.authfile
 	pullOptions.Writer = os.Stderr
 	pullOptions.InsecureSkipTLSVerify = t.auto.options.InsecureSkipTLSVerify
-	if _, err := t.auto.runtime.LibimageRuntime().Pull(ctx, t.rawImageName, config.PullPolicyAlways, pullOptions); err != nil {
+	pullOptions.SignaturePolicyPath = t.signaturePolicy
+	pulled, err := t.auto.runtime.LibimageRuntime().Pull(ctx, pinned, config.PullPolicyAlways, pullOptions)
+	if err != nil {
+		return err
+	}
+	if len(pulled) == 0 {
+		return fmt.Errorf("internal error: no image pulled for %s", pinned)
+	}
+	if t.updateTag == "" {
+		if err := pulled[0].Tag(t.rawImageName); err != nil {
+			return err
+		}
+		t.auto.updatedRawImages[t.rawImageName] = t.digest
+		return nil
+	}
+
+	tagged, err := reference.WithTag(reference.TrimNamed(named), t.updateTag)
+	if err != nil {
+		return err
+	}
+	if err := pulled[0].Tag(tagged.String()); err != nil {
 		return err
 	}
+	return t.pointUnitAt(ctx, tagged.String())
+}
 
-	t.auto.updatedRawImages[t.rawImageName] = true
-	return nil
+// dropInPath returns the path of the drop-in of the task's systemd unit that
+// points the unit at the image matching the version range.  It is written to
+// the runtime directory of systemd, which is not configured by the admin and
+// does not outlive a reboot.
+func (t *task) dropInPath() (string, error) {
+	dir := "/run/systemd/system"
+	if rootless.IsRootless() {
+		runtimeDir, err := util.GetRootlessRuntimeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(runtimeDir, "systemd", "user")
+	}
+	return filepath.Join(dir, t.unit+".d", dropInName), nil
+}
+
+// imageIndex returns the index of the image in the create command of a
+// container: the image is the first positional argument, followed by the
+// command of the container.
+func imageIndex(createCommand, command []string, imageName string) (int, bool) {
+	index := len(createCommand) - len(command) - 1
+	if index < 1 || createCommand[index] != imageName || !slices.Equal(createCommand[index+1:], command) {
+		return 0, false
+	}
+	return index, true
+}
+
+// pointUnitAt writes a drop-in for the task's systemd unit that runs the
+// container's create command with the image replaced by imageName and
+// reloads systemd.  The previous drop-in is kept for a rollback.
+func (t *task) pointUnitAt(ctx context.Context, imageName string) error {
+	command := slices.Clone(t.container.ConfigNoCopy().CreateCommand)
+	index, ok := imageIndex(command, t.container.Command(), t.rawImageName)
+	if !ok {
+		return fmt.Errorf("the %s label requires a container created by the command of its systemd unit", define.AutoUpdateSemverLabel)
+	}
+	command[index] = imageName
+
+	path, err := t.dropInPath()
+	if err != nil {
+		return err
+	}
+	previous, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	content := fmt.Sprintf("# Written by podman auto-update, see the %s label.\n[Service]\nExecStart=\nExecStart=%s\n",
+		define.AutoUpdateSemverLabel, strings.Join(generate.EscapeSystemdArguments(command), " "))
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return err
+	}
+	if previous == nil {
+		previous = []byte{}
+	}
+	t.dropIn = previous
+	return t.auto.conn.ReloadContext(ctx)
 }
 
 // localUpdateAvailable returns whether a new image in the local storage is available.
@@ -320,12 +647,30 @@ func (t *task) localUpdateAvailable() (bool, error) {
 }
 
 // rollbackImage rolls back the task's image to the previous version before the update.
-func (t *task) rollbackImage() error {
+func (t *task) rollbackImage(ctx context.Context) error {
+	// A unit pointed at the tag matching the version range is pointed
+	// back at the previous image by restoring its drop-in.
+	if t.dropIn != nil {
+		path, err := t.dropInPath()
+		if err != nil {
+			return err
+		}
+		if len(t.dropIn) == 0 {
+			err = os.Remove(path)
+		} else {
+			err = os.WriteFile(path, t.dropIn, 0o644)
+		}
+		if err != nil {
+			return err
+		}
+		return t.auto.conn.ReloadContext(ctx)
+	}
+
 	// To fallback, simply retag the old image and restart the service.
 	if err := t.image.Tag(t.rawImageName); err != nil {
 		return err
 	}
-	t.auto.updatedRawImages[t.rawImageName] = false
+	t.auto.updatedRawImages[t.rawImageName] = ""
 	return nil
 }
 
@@ -350,6 +695,76 @@ func (u *updater) restartSystemdUnit(ctx context.Context, unit string) error {
 	}
 }
 
+// waitForHealthyUnit waits until the containers of the unit with a health
+// check are healthy.  It fails if a container becomes unhealthy or stops, or
+// if the containers are not healthy within the health timeout of the unit.
+func (u *updater) waitForHealthyUnit(ctx context.Context, unit string, tasks []*task) error {
+	var timeout time.Duration
+	for _, task := range tasks {
+		timeout = max(timeout, task.healthTimeout)
+	}
+	if timeout == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		healthy, err := u.unitHealthy(unit)
+		if err != nil || healthy {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("containers of systemd unit %q not healthy within %s", unit, timeout)
+		case <-time.After(healthPollInterval):
+		}
+	}
+}
+
+// unitHealthy returns whether all containers with a health check running in
+// the systemd unit are healthy.  An error is returned if one of them is
+// unhealthy or not running.
+func (u *updater) unitHealthy(unit string) (bool, error) {
+	allContainers, err := u.runtime.GetAllContainers()
+	if err != nil {
+		return false, err
+	}
+
+	healthy := true
+	for _, ctr := range allContainers {
+		if !ctr.HasHealthCheck() {
+			continue
+		}
+		ctrUnit, exists, err := u.systemdUnitForContainer(ctr, ctr.Labels())
+		if err != nil || !exists || ctrUnit != unit {
+			continue
+		}
+		state, err := ctr.State()
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+				continue
+			}
+			return false, err
+		}
+		if state != define.ContainerStateRunning {
+			return false, fmt.Errorf("container %s in systemd unit %q is %s", ctr.ID(), unit, state)
+		}
+		status, err := ctr.HealthCheckStatus()
+		if err != nil {
+			return false, err
+		}
+		switch status {
+		case define.HealthCheckHealthy:
+		case define.HealthCheckUnhealthy:
+			return false, fmt.Errorf("container %s in systemd unit %q is unhealthy", ctr.ID(), unit)
+		default:
+			healthy = false
+		}
+	}
+	return healthy, nil
+}
+
 // assembleTasks assembles update tasks per unit and populates a mapping from
 // `unit -> []*task` such that multiple containers _can_ run in a single unit.
 func (u *updater) assembleTasks(ctx context.Context) []error {
@@ -431,15 +846,61 @@ func (u *updater) assembleTasks(ctx context.Context) []error {
 		if fromContainer, ok := labels[define.AutoUpdateAuthfileLabel]; ok {
 			authfile = fromContainer
 		}
+		healthTimeout := u.options.HealthTimeout
+		if value, ok := labels[define.AutoUpdateHealthTimeoutLabel]; ok {
+			healthTimeout, err = time.ParseDuration(value)
+			if err != nil || healthTimeout < 0 {
+				errs = append(errs, fmt.Errorf("auto-updating container %q: invalid %s label %q", ctr.ID(), define.AutoUpdateHealthTimeoutLabel, value))
+				continue
+			}
+		}
+
+		signaturePolicy := labels[define.AutoUpdateSignaturePolicyLabel]
+		var versionRange semver.Range
+		if value, ok := labels[define.AutoUpdateSemverLabel]; ok {
+			versionRange, err = semver.ParseRange(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("auto-updating container %q: invalid %s label %q: %w", ctr.ID(), define.AutoUpdateSemverLabel, value, err))
+				continue
+			}
+		}
+		if (signaturePolicy != "" || versionRange != nil) && policy != PolicyRegistryImage {
+			errs = append(errs, fmt.Errorf("auto-updating container %q: the %s and %s labels require the %s policy", ctr.ID(), define.AutoUpdateSignaturePolicyLabel, define.AutoUpdateSemverLabel, PolicyRegistryImage))
+			continue
+		}
+		if versionRange != nil && ctr.PodID() != "" {
+			errs = append(errs, fmt.Errorf("auto-updating container %q: the %s label is not supported for containers in a pod", ctr.ID(), define.AutoUpdateSemverLabel))
+			continue
+		}
+		if signaturePolicy != "" {
+			if err := fileutils.Exists(signaturePolicy); err != nil {
+				errs = append(errs, fmt.Errorf("auto-updating container %q: signature policy: %w", ctr.ID(), err))
+				continue
+			}
+		}
+
+		stage := 0
+		if value, ok := labels[define.AutoUpdateStageLabel]; ok {
+			stage, err = strconv.Atoi(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("auto-updating container %q: invalid %s label %q", ctr.ID(), define.AutoUpdateStageLabel, value))
+				continue
+			}
+		}
+
 		t := task{
-			authfile:     authfile,
-			auto:         u,
-			container:    ctr,
-			policy:       policy,
-			image:        image,
-			unit:         unit,
-			rawImageName: rawImageName,
-			status:       statusFailed, // must be updated later on
+			authfile:        authfile,
+			auto:            u,
+			container:       ctr,
+			healthTimeout:   healthTimeout,
+			policy:          policy,
+			image:           image,
+			unit:            unit,
+			rawImageName:    rawImageName,
+			semver:          versionRange,
+			signaturePolicy: signaturePolicy,
+			stage:           stage,
+			status:          statusFailed, // must be updated later on
 		}
 
 		// Add the task to the unit.
@@ -0,0 +1,31 @@
+//go:build !remote && (linux || freebsd)
+
+package autoupdate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageIndex(t *testing.T) {
+	tests := []struct {
+		createCommand []string
+		command       []string
+		index         int
+		ok            bool
+	}{
+		{[]string{"podman", "run", "-d", "quay.io/app:1.2"}, nil, 3, true},
+		{[]string{"podman", "run", "--name", "quay.io/app:1.2", "quay.io/app:1.2", "quay.io/app:1.2"}, []string{"quay.io/app:1.2"}, 4, true},
+		{[]string{"podman", "run", "--label", "img=quay.io/app:1.2", "quay.io/app:1.2", "sh", "-c", "sleep inf"}, []string{"sh", "-c", "sleep inf"}, 4, true},
+		{[]string{"podman", "kube", "play", "app.yaml"}, nil, 0, false},
+		{[]string{"podman", "run", "quay.io/app:1.2", "sleep"}, []string{"top"}, 0, false},
+		{[]string{"quay.io/app:1.2"}, nil, 0, false},
+	}
+
+	for _, test := range tests {
+		index, ok := imageIndex(test.createCommand, test.command, "quay.io/app:1.2")
+		assert.Equal(t, test.ok, ok, "%q", test.createCommand)
+		assert.Equal(t, test.index, index, "%q", test.createCommand)
+	}
+}
@@ -18,10 +18,12 @@ import (
 	"time"
 
 	"github.com/blang/semver/v4"
+	"github.com/containers/podman/v6/pkg/tracing"
 	"github.com/containers/podman/v6/pkg/util/tlsutil"
 	"github.com/containers/podman/v6/version"
 	"github.com/kevinburke/ssh_config"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	"go.podman.io/common/pkg/ssh"
 	"go.podman.io/storage/pkg/fileutils"
 	"golang.org/x/net/proxy"
@@ -426,12 +428,17 @@ func unixClient(_url *url.URL) Connection {
 
 // DoRequest assembles the http request and returns the response.
 // The caller must close the response body.
-func (c *Connection) DoRequest(ctx context.Context, httpBody io.Reader, httpMethod, endpoint string, queryParams url.Values, headers http.Header, pathValues ...string) (*APIResponse, error) {
+func (c *Connection) DoRequest(ctx context.Context, httpBody io.Reader, httpMethod, endpoint string, queryParams url.Values, headers http.Header, pathValues ...string) (_ *APIResponse, retErr error) {
 	var (
 		err      error
 		response *http.Response
 	)
 
+	// The endpoint is used as span name before the path values are
+	// filled in to keep the cardinality of span names low.
+	ctx, span := tracing.StartClient(ctx, httpMethod+" "+endpoint)
+	defer func() { tracing.End(span, retErr) }()
+
 	params := make([]any, len(pathValues)+1)
 
 	if v := headers.Values("API-Version"); len(v) > 0 {
@@ -479,6 +486,7 @@ func (c *Connection) DoRequest(ctx context.Context, httpBody io.Reader, httpMeth
 			req.Header.Add(key, v)
 		}
 	}
+	tracing.Inject(ctx, req.Header)
 
 	// Give the Do three chances in the case of a comm/service hiccup
 	for i := 1; i <= 3; i++ {
@@ -488,6 +496,9 @@ func (c *Connection) DoRequest(ctx context.Context, httpBody io.Reader, httpMeth
 		}
 		time.Sleep(time.Duration(i*100) * time.Millisecond)
 	}
+	if err == nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", response.StatusCode))
+	}
 	return &APIResponse{response, req}, err
 }
 
@@ -0,0 +1,81 @@
+package quadlets
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/containers/podman/v6/pkg/bindings"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+)
+
+// Start starts the systemd service of a quadlet.
+func Start(ctx context.Context, name string) error {
+	return unitAction(ctx, name, "start")
+}
+
+// Stop stops the systemd service of a quadlet.
+func Stop(ctx context.Context, name string) error {
+	return unitAction(ctx, name, "stop")
+}
+
+// Restart restarts the systemd service of a quadlet.
+func Restart(ctx context.Context, name string) error {
+	return unitAction(ctx, name, "restart")
+}
+
+func unitAction(ctx context.Context, name, action string) error {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/quadlets/%s/"+action, nil, nil, name)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return response.Process(nil)
+}
+
+// Status returns the state of the systemd service of a quadlet.
+func Status(ctx context.Context, name string) (*entities.QuadletStatusReport, error) {
+	var report *entities.QuadletStatusReport
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/quadlets/%s/status", nil, nil, name)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return report, response.Process(&report)
+}
+
+// Logs writes the journal entries of the systemd service of a quadlet to w.
+func Logs(ctx context.Context, name string, options *LogsOptions, w io.Writer) error {
+	if options == nil {
+		options = new(LogsOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/quadlets/%s/logs", params, nil, name)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if !response.IsSuccess() {
+		return response.Process(nil)
+	}
+	_, err = io.Copy(w, response.Body)
+	return err
+}
@@ -0,0 +1,10 @@
+package quadlets
+
+// LogsOptions are optional options for reading the logs of a quadlet
+//
+//go:generate go run ../generator/generator.go LogsOptions
+type LogsOptions struct {
+	Follow *bool
+	Since  *string
+	Tail   *string
+}
@@ -89,6 +89,29 @@ func Create(ctx context.Context, reader io.Reader, options *CreateOptions) (*ent
 	return create, response.Process(&create)
 }
 
+// Rotate replaces the data of a secret with the data read from reader and
+// updates the containers mounting the secret.
+func Rotate(ctx context.Context, nameOrID string, reader io.Reader, options *RotateOptions) (*entitiesTypes.SecretRotateReport, error) {
+	var report *entitiesTypes.SecretRotateReport
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := conn.DoRequest(ctx, reader, http.MethodPost, "/secrets/%s/rotate", params, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return report, response.Process(&report)
+}
+
 func Exists(ctx context.Context, nameOrID string) (bool, error) {
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
@@ -30,3 +30,11 @@ type CreateOptions struct {
 	Replace    *bool
 	Ignore     *bool
 }
+
+// RotateOptions are optional options for rotating secrets
+//
+//go:generate go run ../generator/generator.go RotateOptions
+type RotateOptions struct {
+	Signal *string
+	Exec   *string
+}
@@ -65,8 +65,9 @@ func CRImportCheckpoint(ctx context.Context, runtime *libpod.Runtime, restoreOpt
 		return nil, errors.New("cannot restore non pod container into pod")
 	}
 
-	// This should not happen as checkpoints with these options are not exported.
-	if len(ctrConfig.Dependencies) > 0 {
+	// Only containers checkpointed together with their pod can have
+	// dependencies, see below.
+	if len(ctrConfig.Dependencies) > 0 && restoreOptions.Pod == "" {
 		return nil, errors.New("cannot import checkpoints of containers with dependencies")
 	}
 
@@ -114,6 +115,17 @@ func CRImportCheckpoint(ctx context.Context, runtime *libpod.Runtime, restoreOpt
 		// Restoring into an existing Pod
 		ctrConfig.Pod = pod.ID()
 
+		// The dependencies have to be restored into the pod first.
+		for _, dep := range ctrConfig.Dependencies {
+			depCtr, err := runtime.LookupContainer(dep)
+			if err != nil {
+				return nil, fmt.Errorf("dependency %s of container %s: %w", dep, ctrConfig.Name, err)
+			}
+			if depCtr.PodID() != ctrConfig.Pod {
+				return nil, fmt.Errorf("dependency %s of container %s is not part of pod %s", dep, ctrConfig.Name, ctrConfig.Pod)
+			}
+		}
+
 		infraContainer, err := pod.InfraContainer()
 		if err != nil {
 			return nil, fmt.Errorf("cannot retrieve infra container from pod %q: %w", ctrConfig.Pod, err)
@@ -0,0 +1,279 @@
+//go:build !remote && (linux || freebsd)
+
+package checkpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	metadata "github.com/checkpoint-restore/checkpointctl/lib"
+	"github.com/containers/podman/v6/libpod"
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/specgen"
+	"github.com/containers/podman/v6/pkg/specgen/generate"
+	"github.com/containers/podman/v6/pkg/specgenutil"
+	"github.com/sirupsen/logrus"
+	nettypes "go.podman.io/common/libnetwork/types"
+	"go.podman.io/storage/pkg/archive"
+)
+
+// A pod checkpoint archive contains the spec to re-create the pod with its
+// infra container, the IDs of the containers in the order they are started
+// and the checkpoint archive of each container named after its ID.
+const (
+	podSpecDumpFile       = "pod.dump"
+	podContainersDumpFile = "containers.dump"
+)
+
+func podContainerArchive(dir, ctrID string) string {
+	return filepath.Join(dir, ctrID+".tar")
+}
+
+// CRCheckpointPod checkpoints all containers of the pod and exports them
+// together with the configuration of the pod into a single archive.  It
+// returns the checkpointed containers in the order they are started.
+func CRCheckpointPod(ctx context.Context, runtime *libpod.Runtime, pod *libpod.Pod, options entities.PodCheckpointOptions) ([]*libpod.Container, error) {
+	if !pod.HasInfraContainer() {
+		return nil, fmt.Errorf("cannot checkpoint pod %s without infra container", pod.Name())
+	}
+	infra, err := pod.InfraContainer()
+	if err != nil {
+		return nil, err
+	}
+
+	podSpec := specgen.NewPodSpecGenerator()
+	if _, err := generate.PodConfigToSpec(runtime, podSpec, &entities.ContainerCreateOptions{}, pod.ID()); err != nil {
+		return nil, fmt.Errorf("retrieving configuration of pod %s: %w", pod.Name(), err)
+	}
+	// Keep the names, not the ones of a clone.
+	podSpec.Name = pod.Name()
+	podSpec.Hostname = pod.Hostname()
+	podSpec.InfraContainerSpec.Name = infra.Name()
+
+	allCtrs, err := pod.AllContainers()
+	if err != nil {
+		return nil, err
+	}
+	graph, err := libpod.BuildContainerGraph(allCtrs)
+	if err != nil {
+		return nil, fmt.Errorf("generating dependency graph for pod %s: %w", pod.Name(), err)
+	}
+	ctrs := make([]*libpod.Container, 0, len(allCtrs))
+	ctrIDs := make([]string, 0, len(allCtrs))
+	for _, ctr := range graph.StartOrder() {
+		if ctr.IsInfra() {
+			continue
+		}
+		state, err := ctr.State()
+		if err != nil {
+			return nil, err
+		}
+		if state != define.ContainerStateRunning {
+			return nil, fmt.Errorf("container %s of pod %s is %s, cannot checkpoint: %w", ctr.Name(), pod.Name(), state, define.ErrCtrStateInvalid)
+		}
+		ctrs = append(ctrs, ctr)
+		ctrIDs = append(ctrIDs, ctr.ID())
+	}
+	if len(ctrs) == 0 {
+		return nil, fmt.Errorf("pod %s has no containers to checkpoint", pod.Name())
+	}
+
+	dir, err := os.MkdirTemp("", "pod-checkpoint")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("Could not recursively remove %s: %q", dir, err)
+		}
+	}()
+
+	// Checkpoint the containers in the reverse start order, the same way
+	// they are stopped.
+	checkpointed := make([]*libpod.Container, 0, len(ctrs))
+	for i := len(ctrs) - 1; i >= 0; i-- {
+		ctr := ctrs[i]
+		_, _, err := ctr.Checkpoint(ctx, libpod.ContainerCheckpointOptions{
+			TargetFile:     podContainerArchive(dir, ctr.ID()),
+			Pod:            pod.ID(),
+			Keep:           options.Keep,
+			KeepRunning:    options.LeaveRunning,
+			TCPEstablished: options.TCPEstablished,
+			FileLocks:      options.FileLocks,
+			IgnoreRootfs:   options.IgnoreRootFS,
+			IgnoreVolumes:  options.IgnoreVolumes,
+			Compression:    archive.Uncompressed,
+		})
+		if err != nil {
+			if !options.LeaveRunning {
+				restorePodContainers(ctx, checkpointed, options)
+			}
+			return nil, fmt.Errorf("checkpointing container %s of pod %s: %w", ctr.Name(), pod.Name(), err)
+		}
+		checkpointed = append(checkpointed, ctr)
+	}
+
+	if _, err := metadata.WriteJSONFile(podSpec, dir, podSpecDumpFile); err != nil {
+		return nil, err
+	}
+	if _, err := metadata.WriteJSONFile(ctrIDs, dir, podContainersDumpFile); err != nil {
+		return nil, err
+	}
+
+	input, err := archive.TarWithOptions(dir, &archive.TarOptions{
+		Compression: options.Compression,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading pod checkpoint directory: %w", err)
+	}
+	outFile, err := os.Create(options.Export)
+	if err != nil {
+		return nil, fmt.Errorf("creating pod checkpoint export file %q: %w", options.Export, err)
+	}
+	defer outFile.Close()
+	if err := os.Chmod(options.Export, 0o600); err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(outFile, input); err != nil {
+		return nil, err
+	}
+
+	if !options.LeaveRunning {
+		if err := infra.Stop(); err != nil {
+			return nil, fmt.Errorf("stopping infra container of pod %s: %w", pod.Name(), err)
+		}
+	}
+	return ctrs, nil
+}
+
+// restorePodContainers restores the containers of a failed pod checkpoint,
+// which are ordered as they have been checkpointed.
+func restorePodContainers(ctx context.Context, ctrs []*libpod.Container, options entities.PodCheckpointOptions) {
+	for i := len(ctrs) - 1; i >= 0; i-- {
+		_, _, err := ctrs[i].Restore(ctx, libpod.ContainerCheckpointOptions{
+			TCPEstablished: options.TCPEstablished,
+			FileLocks:      options.FileLocks,
+		})
+		if err != nil {
+			logrus.Errorf("Restoring container %s after failed pod checkpoint: %v", ctrs[i].ID(), err)
+		}
+	}
+}
+
+// CRImportPodCheckpoint re-creates the pod from the pod checkpoint archive and
+// restores its containers into it in the order they are started.
+func CRImportPodCheckpoint(ctx context.Context, runtime *libpod.Runtime, options entities.PodRestoreOptions) (_ *libpod.Pod, _ []*libpod.Container, retErr error) {
+	dir, err := os.MkdirTemp("", "pod-checkpoint")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("Could not recursively remove %s: %q", dir, err)
+		}
+	}()
+
+	archiveFile, err := os.Open(options.Import)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open pod checkpoint archive for import: %w", err)
+	}
+	defer archiveFile.Close()
+	if err := archive.Untar(archiveFile, dir, nil); err != nil {
+		return nil, nil, fmt.Errorf("unpacking of pod checkpoint archive %s failed: %w", options.Import, err)
+	}
+
+	podSpec := specgen.NewPodSpecGenerator()
+	if _, err := metadata.ReadJSONFile(podSpec, dir, podSpecDumpFile); err != nil {
+		return nil, nil, err
+	}
+	var ctrIDs []string
+	if _, err := metadata.ReadJSONFile(&ctrIDs, dir, podContainersDumpFile); err != nil {
+		return nil, nil, err
+	}
+	if podSpec.InfraContainerSpec == nil {
+		return nil, nil, errors.New("pod checkpoint archive does not contain an infra container")
+	}
+
+	if len(options.PublishPorts) > 0 {
+		ports, err := specgenutil.CreatePortBindings(options.PublishPorts)
+		if err != nil {
+			return nil, nil, err
+		}
+		podSpec.PortMappings = ports
+	}
+	if options.IgnoreStaticIP || options.IgnoreStaticMAC {
+		for _, networks := range []map[string]nettypes.PerNetworkOptions{podSpec.Networks, podSpec.InfraContainerSpec.Networks} {
+			for name, opts := range networks {
+				if options.IgnoreStaticIP {
+					opts.StaticIPs = nil
+				}
+				if options.IgnoreStaticMAC {
+					opts.StaticMAC = nil
+				}
+				networks[name] = opts
+			}
+		}
+	}
+
+	pod, err := generate.MakePod(&entities.PodSpec{PodSpecGen: *podSpec}, runtime)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating pod from checkpoint: %w", err)
+	}
+	defer func() {
+		if retErr != nil {
+			if _, err := runtime.RemovePod(ctx, pod, true, true, nil); err != nil {
+				logrus.Errorf("Removing pod %s after failed restore: %v", pod.Name(), err)
+			}
+		}
+	}()
+
+	// The containers join the namespaces of the running infra container.
+	infra, err := pod.InfraContainer()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := infra.Start(ctx, false); err != nil {
+		return nil, nil, fmt.Errorf("starting infra container of pod %s: %w", pod.Name(), err)
+	}
+
+	ctrs := make([]*libpod.Container, 0, len(ctrIDs))
+	for _, id := range ctrIDs {
+		restoreOptions := entities.RestoreOptions{
+			Import:          podContainerArchive(dir, id),
+			Pod:             pod.ID(),
+			IgnoreRootFS:    options.IgnoreRootFS,
+			IgnoreVolumes:   options.IgnoreVolumes,
+			IgnoreStaticIP:  options.IgnoreStaticIP,
+			IgnoreStaticMAC: options.IgnoreStaticMAC,
+		}
+		imported, err := CRImportCheckpointTar(ctx, runtime, restoreOptions)
+		if err != nil {
+			return nil, nil, fmt.Errorf("importing container %s of pod %s: %w", id, pod.Name(), err)
+		}
+		if len(imported) == 0 {
+			return nil, nil, fmt.Errorf("importing container %s of pod %s: %w", id, pod.Name(), define.ErrNoSuchCtr)
+		}
+		ctr := imported[0]
+		_, _, err = ctr.Restore(ctx, libpod.ContainerCheckpointOptions{
+			TargetFile:      restoreOptions.Import,
+			Pod:             pod.ID(),
+			Keep:            options.Keep,
+			TCPEstablished:  options.TCPEstablished,
+			FileLocks:       options.FileLocks,
+			IgnoreRootfs:    options.IgnoreRootFS,
+			IgnoreVolumes:   options.IgnoreVolumes,
+			IgnoreStaticIP:  options.IgnoreStaticIP,
+			IgnoreStaticMAC: options.IgnoreStaticMAC,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("restoring container %s of pod %s: %w", ctr.Name(), pod.Name(), err)
+		}
+		ctrs = append(ctrs, ctr)
+	}
+	return pod, ctrs, nil
+}
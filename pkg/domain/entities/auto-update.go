@@ -1,6 +1,10 @@
 package entities
 
-import "go.podman.io/image/v5/types"
+import (
+	"time"
+
+	"go.podman.io/image/v5/types"
+)
 
 // AutoUpdateOptions are the options for running auto-update.
 type AutoUpdateOptions struct {
@@ -13,6 +17,11 @@ type AutoUpdateOptions struct {
 	// If restarting the service with the new image failed, restart it
 	// another time with the previous image.
 	Rollback bool
+	// Wait for the updated containers with a health check to be healthy
+	// and consider the update failed if they are not within the
+	// timeout.  Zero disables waiting.  Can be overridden per container
+	// with the io.containers.autoupdate.health-timeout label.
+	HealthTimeout time.Duration
 	// Allow contacting registries over HTTP, or HTTPS with failed TLS
 	// verification. Note that this does not affect other TLS connections.
 	InsecureSkipTLSVerify types.OptionalBool
@@ -30,7 +39,11 @@ type AutoUpdateReport struct {
 	Policy string
 	// SystemdUnit running a container configured for auto updates.
 	SystemdUnit string
+	// Digest of the image the container was updated to, or of the pending
+	// update (see DryRun).
+	Digest string
 	// Indicates the update status: true, false, failed, pending (see
-	// DryRun).
+	// DryRun), rolled back or halted (a unit of an earlier stage failed
+	// to update).
 	Updated string
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/containers/podman/v6/pkg/domain/entities/types"
 	"github.com/containers/podman/v6/pkg/specgen"
 	nettypes "go.podman.io/common/libnetwork/types"
+	"go.podman.io/common/pkg/ssh"
 	imageTypes "go.podman.io/image/v5/types"
 	"go.podman.io/storage/pkg/archive"
 )
@@ -228,7 +229,7 @@ type RestoreOptions struct {
 	Name            string
 	TCPEstablished  bool
 	TCPClose        bool
-	ImportPrevious  string
+	ImportPrevious  []string
 	PublishPorts    []string
 	Pod             string
 	PrintStats      bool
@@ -237,6 +238,29 @@ type RestoreOptions struct {
 
 type RestoreReport = types.RestoreReport
 
+// ContainerMigrateOptions describes the options for migrating a container to
+// another host
+type ContainerMigrateOptions struct {
+	// Destination is the name of the system connection or the
+	// [user@]host of the host to migrate the container to.
+	Destination string
+	// PreDumps is the number of pre-checkpoints transferred to the
+	// destination while the container keeps running.
+	PreDumps       int
+	TCPEstablished bool
+	FileLocks      bool
+	IgnoreVolumes  bool
+	// SSHMode is the ssh.EngineMode used to connect to the destination.
+	SSHMode ssh.EngineMode
+}
+
+// ContainerMigrateReport describes the result of a container migration
+type ContainerMigrateReport struct {
+	Id string
+	// RemoteID is the ID of the restored container on the destination.
+	RemoteID string
+}
+
 type ContainerCreateReport struct {
 	Id string
 }
@@ -485,6 +509,14 @@ type ContainerRenameOptions struct {
 	NewName string
 }
 
+// ContainerRotateLogOptions describes input options for rotating the log
+// file of a container.
+type ContainerRotateLogOptions struct {
+	// All rotates the log files of all containers registered for log
+	// rotation.
+	All bool
+}
+
 // ContainerCloneOptions contains options for cloning an existing container
 type ContainerCloneOptions struct {
 	ID           string
@@ -36,16 +36,19 @@ type ContainerEngine interface { //nolint:interfacebloat
 	ContainerList(ctx context.Context, options ContainerListOptions) ([]ListContainer, error)
 	ContainerListExternal(ctx context.Context) ([]ListContainer, error)
 	ContainerLogs(ctx context.Context, containers []string, options ContainerLogsOptions) error
+	ContainerMigrate(ctx context.Context, nameOrID string, options ContainerMigrateOptions) (*ContainerMigrateReport, error)
 	ContainerMount(ctx context.Context, nameOrIDs []string, options ContainerMountOptions) ([]*ContainerMountReport, error)
 	ContainerPause(ctx context.Context, namesOrIds []string, options PauseUnPauseOptions) ([]*PauseUnpauseReport, error)
 	ContainerPort(ctx context.Context, nameOrID string, options ContainerPortOptions) ([]*ContainerPortReport, error)
 	ContainerPrune(ctx context.Context, options ContainerPruneOptions) ([]*reports.PruneReport, error)
 	ContainerRename(ctr context.Context, nameOrID string, options ContainerRenameOptions) error
 	ContainerRestart(ctx context.Context, namesOrIds []string, options RestartOptions) ([]*RestartReport, error)
+	ContainerRotateLog(ctx context.Context, nameOrID string, options ContainerRotateLogOptions) error
 	ContainerRestore(ctx context.Context, namesOrIds []string, options RestoreOptions) ([]*RestoreReport, error)
 	ContainerRm(ctx context.Context, namesOrIds []string, options RmOptions) ([]*reports.RmReport, error)
 	ContainerRun(ctx context.Context, opts ContainerRunOptions) (*ContainerRunReport, error)
 	ContainerRunlabel(ctx context.Context, label string, image string, args []string, opts ContainerRunlabelOptions) error
+	ContainerShipLogs(ctx context.Context, nameOrID string) error
 	ContainerStart(ctx context.Context, namesOrIds []string, options ContainerStartOptions) ([]*ContainerStartReport, error)
 	ContainerStat(ctx context.Context, nameOrDir string, path string) (*ContainerStatReport, error)
 	ContainerStats(ctx context.Context, namesOrIds []string, options ContainerStatsOptions) (chan ContainerStatsReport, error)
@@ -80,6 +83,7 @@ type ContainerEngine interface { //nolint:interfacebloat
 	PlayKube(ctx context.Context, body io.Reader, opts PlayKubeOptions) (*PlayKubeReport, error)
 	PlayKubeDown(ctx context.Context, body io.Reader, opts PlayKubeDownOptions) (*PlayKubeReport, error)
 	PodCreate(ctx context.Context, specg PodSpec) (*PodCreateReport, error)
+	PodCheckpoint(ctx context.Context, nameOrID string, options PodCheckpointOptions) (*PodCheckpointReport, error)
 	PodClone(ctx context.Context, podClone PodCloneOptions) (*PodCloneReport, error)
 	PodExists(ctx context.Context, nameOrID string) (*BoolReport, error)
 	PodInspect(ctx context.Context, namesOrID []string, options InspectOptions) ([]*PodInspectReport, []error, error)
@@ -89,6 +93,7 @@ type ContainerEngine interface { //nolint:interfacebloat
 	PodPrune(ctx context.Context, options PodPruneOptions) ([]*PodPruneReport, error)
 	PodPs(ctx context.Context, options PodPSOptions) ([]*ListPodsReport, error)
 	PodRestart(ctx context.Context, namesOrIds []string, options PodRestartOptions) ([]*PodRestartReport, error)
+	PodRestore(ctx context.Context, options PodRestoreOptions) (*PodRestoreReport, error)
 	PodRm(ctx context.Context, namesOrIds []string, options PodRmOptions) ([]*PodRmReport, error)
 	PodStart(ctx context.Context, namesOrIds []string, options PodStartOptions) ([]*PodStartReport, error)
 	PodStats(ctx context.Context, namesOrIds []string, options PodStatsOptions) ([]*PodStatsReport, error)
@@ -98,8 +103,14 @@ type ContainerEngine interface { //nolint:interfacebloat
 	QuadletExists(ctx context.Context, name string) (*BoolReport, error)
 	QuadletInstall(ctx context.Context, pathsOrURLs []string, options QuadletInstallOptions) (*QuadletInstallReport, error)
 	QuadletList(ctx context.Context, options QuadletListOptions) ([]*ListQuadlet, error)
+	QuadletLogs(ctx context.Context, quadlet string, options QuadletLogsOptions) error
 	QuadletPrint(ctx context.Context, quadlet string) (string, error)
 	QuadletRemove(ctx context.Context, quadlets []string, options QuadletRemoveOptions) (*QuadletRemoveReport, error)
+	QuadletRestart(ctx context.Context, quadlets []string) ([]*QuadletUnitReport, error)
+	QuadletStart(ctx context.Context, quadlets []string) ([]*QuadletUnitReport, error)
+	QuadletStatus(ctx context.Context, quadlets []string) ([]*QuadletStatusReport, error)
+	QuadletStop(ctx context.Context, quadlets []string) ([]*QuadletUnitReport, error)
+	QuadletValidate(ctx context.Context, paths []string) (*QuadletValidateReport, error)
 	Renumber(ctx context.Context) error
 	Reset(ctx context.Context) error
 	SetupRootless(ctx context.Context, noMoveProcess bool, cgroupMode string) error
@@ -107,6 +118,7 @@ type ContainerEngine interface { //nolint:interfacebloat
 	SecretInspect(ctx context.Context, nameOrIDs []string, options SecretInspectOptions) ([]*SecretInfoReport, []error, error)
 	SecretList(ctx context.Context, opts SecretListRequest) ([]*SecretInfoReport, error)
 	SecretRm(ctx context.Context, nameOrID []string, opts SecretRmOptions) ([]*SecretRmReport, error)
+	SecretRotate(ctx context.Context, nameOrID string, reader io.Reader, options SecretRotateOptions) (*SecretRotateReport, error)
 	SecretExists(ctx context.Context, nameOrID string) (*BoolReport, error)
 	Shutdown(ctx context.Context)
 	SystemDf(ctx context.Context, options SystemDfOptions) (*SystemDfReport, error)
@@ -125,4 +137,8 @@ type ContainerEngine interface { //nolint:interfacebloat
 	VolumeReload(ctx context.Context) (*VolumeReloadReport, error)
 	VolumeExport(ctx context.Context, nameOrID string, options VolumeExportOptions) error
 	VolumeImport(ctx context.Context, nameOrID string, options VolumeImportOptions) error
+	VolumeSnapshotCreate(ctx context.Context, nameOrID, snapshot string) (*VolumeSnapshotReport, error)
+	VolumeSnapshotList(ctx context.Context, namesOrIDs []string) ([]*VolumeSnapshotReport, error)
+	VolumeSnapshotRestore(ctx context.Context, nameOrID, snapshot string) error
+	VolumeSnapshotRm(ctx context.Context, nameOrID, snapshot string) error
 }
@@ -251,6 +251,11 @@ type ImageLoadOptions struct {
 	Input           string
 	Quiet           bool
 	SignaturePolicy string
+	// ReuseLayers takes the layers missing from an OCI archive from the
+	// layers in local storage. Used by image scp to send only the layers
+	// the destination does not have. The archive at Input is completed in
+	// place.
+	ReuseLayers bool
 }
 
 type ImageLoadReport = entitiesTypes.ImageLoadReport
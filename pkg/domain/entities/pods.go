@@ -10,6 +10,7 @@ import (
 	"github.com/containers/podman/v6/pkg/util"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	commonFlag "go.podman.io/common/pkg/flag"
+	"go.podman.io/storage/pkg/archive"
 )
 
 type PodKillOptions struct {
@@ -127,6 +128,48 @@ type PodCloneOptions struct {
 	Start               bool
 }
 
+// PodCheckpointOptions contains options for checkpointing a pod into an
+// archive
+type PodCheckpointOptions struct {
+	Export         string
+	Compression    archive.Compression
+	FileLocks      bool
+	IgnoreRootFS   bool
+	IgnoreVolumes  bool
+	Keep           bool
+	LeaveRunning   bool
+	TCPEstablished bool
+}
+
+// PodCheckpointReport contains the result of checkpointing a pod
+type PodCheckpointReport struct {
+	Id string
+	// Containers are the IDs of the checkpointed containers in the
+	// order they are restored in.
+	Containers []string
+}
+
+// PodRestoreOptions contains options for restoring a pod from an archive
+type PodRestoreOptions struct {
+	Import          string
+	FileLocks       bool
+	IgnoreRootFS    bool
+	IgnoreStaticIP  bool
+	IgnoreStaticMAC bool
+	IgnoreVolumes   bool
+	Keep            bool
+	PublishPorts    []string
+	TCPEstablished  bool
+}
+
+// PodRestoreReport contains the result of restoring a pod
+type PodRestoreReport struct {
+	Id string
+	// Containers are the IDs of the restored containers in the order
+	// they have been restored in.
+	Containers []string
+}
+
 type ContainerMode string
 
 const (
@@ -220,6 +263,9 @@ type ContainerCreateOptions struct {
 	ReadOnly             bool
 	ReadWriteTmpFS       bool
 	Restart              string
+	RestartDelay         string
+	RestartMaxDelay      string
+	RestartResetWindow   string
 	Replace              bool
 	Requires             []string
 	Retry                *uint  `json:"retry,omitempty"`
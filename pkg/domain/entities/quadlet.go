@@ -1,5 +1,10 @@
 package entities
 
+import (
+	"io"
+	"time"
+)
+
 // QuadletInstallOptions contains options to the `podman quadlet install` command
 type QuadletInstallOptions struct {
 	// Whether to reload systemd after installation is completed
@@ -62,3 +67,89 @@ type QuadletRemoveReport struct {
 	// Errors is a map of Quadlet name to error that occurred during removal.
 	Errors map[string]error
 }
+
+// QuadletUnitReport contains the result of starting, stopping or restarting
+// the systemd unit of a Quadlet
+type QuadletUnitReport struct {
+	// Name is the name of the Quadlet file
+	Name string
+	// UnitName is the name of the systemd unit created from the Quadlet
+	UnitName string
+	// Err is the error that occurred acting on the unit, if any
+	Err error
+}
+
+// QuadletStatusReport describes the state of the systemd unit of a Quadlet,
+// as displayed by `podman quadlet status`
+type QuadletStatusReport struct {
+	// Name is the name of the Quadlet file
+	Name string
+	// UnitName is the name of the systemd unit created from the Quadlet
+	UnitName string
+	// Path to the Quadlet on disk
+	Path string
+	// LoadState is the systemd load state of the unit, "not-found" if
+	// systemd has not been reloaded since the Quadlet was installed
+	LoadState string
+	// ActiveState is the systemd active state of the unit
+	ActiveState string
+	// SubState is the systemd sub state of the unit
+	SubState string
+	// MainPID is the PID of the main process of the unit, 0 if not running
+	MainPID uint32
+	// Since is when the unit entered its current state
+	Since time.Time
+	// Err is the error that occurred looking up the unit, if any
+	Err error `json:"-"`
+}
+
+// QuadletLogsOptions contains options to the `podman quadlet logs` command
+type QuadletLogsOptions struct {
+	// Follow keeps printing new log entries as they are written
+	Follow bool
+	// Since shows only log entries since the given time
+	Since string
+	// Tail is the number of log entries to show from the end, -1 for all
+	Tail int
+	// Writer is where the log entries are written to
+	Writer io.Writer
+}
+
+// QuadletValidateReport contains the results of `podman quadlet validate`
+type QuadletValidateReport struct {
+	// Problems lists the errors and warnings found converting the Quadlets
+	Problems []QuadletProblem
+	// Units lists the systemd units generated from the Quadlets which
+	// converted without errors
+	Units []QuadletValidateUnit
+}
+
+// QuadletProblem is an error or a warning found converting a Quadlet
+type QuadletProblem struct {
+	// Severity is either "error" or "warning"
+	Severity string
+	// File is the path of the Quadlet or drop-in file the problem is in
+	File string
+	// Line is the line of the file the problem is at, 0 if unknown
+	Line int
+	// Group is the group of the key the problem is about, if any
+	Group string
+	// Key is the key the problem is about, empty if it is not about a key
+	Key string
+	// Message describes the problem
+	Message string
+}
+
+// QuadletValidateUnit is a systemd unit generated from a validated Quadlet
+type QuadletValidateUnit struct {
+	// Quadlet is the path of the Quadlet the unit is generated from
+	Quadlet string
+	// UnitName is the name of the generated systemd unit
+	UnitName string
+	// Path is the path of the currently generated unit, empty if the unit
+	// is not generated yet
+	Path string
+	// Diff is a unified diff from the currently generated unit to the new
+	// one, empty if they are identical
+	Diff string
+}
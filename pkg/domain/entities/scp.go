@@ -57,6 +57,11 @@ type ScpTransferOptions struct {
 
 type ScpTransferReport struct{}
 
+// ScpLoadToRemoteOptions are the options of loading a saved image on a remote
+// host.
+//
+// Deprecated: images are streamed between hosts, they are no longer loaded
+// from a local file. This type is unused.
 type ScpLoadToRemoteOptions struct {
 	// Dest contains data relating to the destination of the image to transfer
 	Dest ScpTransferImageOptions
@@ -72,6 +77,11 @@ type ScpLoadToRemoteOptions struct {
 	SSHMode ssh.EngineMode
 }
 
+// ScpLoadToRemoteReport is the report of loading a saved image on a remote
+// host.
+//
+// Deprecated: images are streamed between hosts, they are no longer loaded
+// from a local file. This type is unused.
 type ScpLoadToRemoteReport struct {
 	// Response contains any additional information from the executed load command
 	Response string
@@ -79,6 +89,11 @@ type ScpLoadToRemoteReport struct {
 	ID string
 }
 
+// ScpSaveToRemoteOptions are the options of saving an image on a remote host
+// to a local file.
+//
+// Deprecated: images are streamed between hosts, they are no longer saved to
+// a local file. This type is unused.
 type ScpSaveToRemoteOptions struct {
 	Image string
 	// LocalFile is a path to a local file to copy the saved image to
@@ -95,4 +110,9 @@ type ScpSaveToRemoteOptions struct {
 	Format string
 }
 
+// ScpSaveToRemoteReport is the report of saving an image on a remote host to
+// a local file.
+//
+// Deprecated: images are streamed between hosts, they are no longer saved to
+// a local file. This type is unused.
 type ScpSaveToRemoteReport struct{}
@@ -15,6 +15,19 @@ type SecretCreateOptions struct {
 	Ignore     bool
 }
 
+type SecretRotateOptions struct {
+	// Signal is sent to the running containers mounting the secret once
+	// the new data is in place.
+	Signal string
+	// ExecHook is a command run in the running containers mounting the
+	// secret once the new data is in place.
+	ExecHook string
+}
+
+type SecretRotateReport = types.SecretRotateReport
+
+type SecretRotateContainerReport = types.SecretRotateContainerReport
+
 type SecretInspectOptions struct {
 	ShowSecret bool
 }
@@ -63,6 +76,15 @@ type SwagSecretCreateResponse struct {
 	}
 }
 
+// Secret rotate response
+// swagger:response SecretRotateResponse
+type SwagSecretRotateResponse struct {
+	// in:body
+	Body struct {
+		SecretRotateReport
+	}
+}
+
 // Secret list response
 // swagger:response SecretListResponse
 type SwagSecretListResponse struct {
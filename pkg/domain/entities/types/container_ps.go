@@ -59,6 +59,12 @@ type ListContainer struct {
 	PodName string
 	// Port mappings
 	Ports []netTypes.PortMapping
+	// Ready is whether the readiness check of the container passed.
+	// Nil when the container has no readiness check.
+	Ready *bool `json:",omitempty"`
+	// RestartAt is the time a container waiting for its restart backoff
+	// is restarted.
+	RestartAt int64 `json:",omitempty"`
 	// Restarts is how many times the container was restarted by its
 	// restart policy. This is NOT incremented by normal container restarts
 	// (only by restart policy).
@@ -109,6 +109,12 @@ type FarmInspectReport struct {
 	OS                string
 	Arch              string
 	Variant           string
+	// CPUs is the number of CPUs of the node
+	CPUs int
+	// CPUUtilization is the share of CPU time the node spends busy, in percent
+	CPUUtilization float64
+	// RunningBuilds is the number of builds in progress on the node
+	RunningBuilds int
 }
 
 // ImageRemoveReport is the response for removing one or more image(s) from storage
@@ -23,6 +23,26 @@ type SecretCreateReport struct {
 	ID string
 }
 
+// SecretRotateReport describes the result of rotating a secret.
+type SecretRotateReport struct {
+	// ID is the ID of the secret after the rotation.
+	ID string
+	// Containers are the containers mounting the secret.
+	Containers []SecretRotateContainerReport
+}
+
+// SecretRotateContainerReport describes how a container mounting a rotated
+// secret was updated.
+type SecretRotateContainerReport struct {
+	ID   string
+	Name string
+	// Running is set when the new data was pushed into the running
+	// container.
+	Running bool
+	// Error is the reason the container could not be updated.
+	Error string `json:",omitempty"`
+}
+
 type SecretListReport struct {
 	ID        string
 	Name      string
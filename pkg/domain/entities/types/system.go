@@ -11,6 +11,7 @@ import (
 type ServiceOptions struct {
 	CorsHeaders     string        // Cross-Origin Resource Sharing (CORS) headers
 	PProfAddr       string        // Network address to bind pprof profiles service
+	MetricsAddr     string        // Network address to bind Prometheus metrics service
 	Timeout         time.Duration // Duration of inactivity the service should wait before shutting down
 	URI             string        // Path to unix domain socket service should listen on
 	TLSCertFile     string        // Path to serving certificate PEM file
@@ -110,6 +111,8 @@ type SystemDfVolumeReport struct {
 	Links           int
 	Size            int64
 	ReclaimableSize int64
+	// Limit is the size limit of the volume, 0 if it is not limited.
+	Limit int64
 }
 
 // SystemVersionReport describes version information about the running Podman service
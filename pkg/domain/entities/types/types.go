@@ -57,6 +57,9 @@ type FarmBuildOptions struct {
 	Authfile string
 	// SkipTLSVerify skips tls verification when set to true
 	SkipTLSVerify *bool
+	// PushOnly pushes the manifest list straight to the registry without
+	// creating it in local storage
+	PushOnly bool
 }
 
 // BuildOptions describe the options for building container images.
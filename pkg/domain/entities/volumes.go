@@ -3,8 +3,11 @@ package entities
 import (
 	"io"
 	"net/url"
+	"time"
 
+	encconfig "github.com/containers/ocicrypt/config"
 	"github.com/containers/podman/v6/pkg/domain/entities/types"
+	"go.podman.io/storage/pkg/archive"
 )
 
 // VolumeCreateOptions provides details for creating volumes
@@ -49,10 +52,30 @@ type VolumeUnmountReport = types.VolumeUnmountReport
 // VolumeExportOptions describes the options required to export a volume.
 type VolumeExportOptions struct {
 	Output io.Writer
+	// Artifact is the name of an artifact in the local artifact store to
+	// export the volume into instead of Output.
+	Artifact string
+	// Compression of the exported tar, uncompressed by default.
+	Compression archive.Compression
+	// OciEncryptConfig encrypts the exported tar if set.
+	OciEncryptConfig *encconfig.EncryptConfig
 }
 
 // VolumeImportOptions describes the options required to import a volume
 type VolumeImportOptions struct {
 	// Input will be closed upon being fully consumed
 	Input io.Reader
+	// Artifact is the name of an artifact in the local artifact store to
+	// import the volume from instead of Input.
+	Artifact string
+	// OciDecryptConfig decrypts an encrypted tar.
+	OciDecryptConfig *encconfig.DecryptConfig
+}
+
+// VolumeSnapshotReport describes a snapshot of a volume.
+type VolumeSnapshotReport struct {
+	Volume  string
+	Name    string
+	Created time.Time
+	Size    uint64
 }
@@ -62,10 +62,8 @@ func GenerateContainerFilterFuncs(filter string, filterValues []string, r *libpo
 			return false
 		}, nil
 	case "status":
-		for _, filterValue := range filterValues {
-			if _, err := define.StringToContainerStatus(filterValue); err != nil {
-				return nil, err
-			}
+		if err := validateStatusFilter(filterValues); err != nil {
+			return nil, err
 		}
 		return func(c *libpod.Container) bool {
 			status, err := c.State()
@@ -76,6 +74,9 @@ func GenerateContainerFilterFuncs(filter string, filterValues []string, r *libpo
 			if status == define.ContainerStateStopped {
 				state = "exited"
 			}
+			if restartAt, err := c.RestartBackoff(); err == nil && !restartAt.IsZero() {
+				state = define.ContainerStateBackoff
+			}
 			for _, filterValue := range filterValues {
 				if filterValue == "stopped" {
 					filterValue = "exited"
@@ -437,10 +438,8 @@ func GenerateExternalContainerFilterFuncs(filter string, filterValues []string,
 			return false
 		}, nil
 	case "status":
-		for _, filterValue := range filterValues {
-			if _, err := define.StringToContainerStatus(filterValue); err != nil {
-				return nil, err
-			}
+		if err := validateStatusFilter(filterValues); err != nil {
+			return nil, err
 		}
 		return func(listContainer *types.ListContainer) bool {
 			state := listContainer.State
@@ -536,3 +535,18 @@ func GenerateExternalContainerFilterFuncs(filter string, filterValues []string,
 
 	return nil, fmt.Errorf("%s is an invalid filter", filter)
 }
+
+// validateStatusFilter checks that the values of a status filter are valid
+// container states or the backoff state of a container waiting for its
+// restart.
+func validateStatusFilter(filterValues []string) error {
+	for _, filterValue := range filterValues {
+		if filterValue == define.ContainerStateBackoff {
+			continue
+		}
+		if _, err := define.StringToContainerStatus(filterValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
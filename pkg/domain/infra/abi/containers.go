@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"maps"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"sync"
@@ -23,6 +24,7 @@ import (
 	"github.com/containers/podman/v6/pkg/domain/entities/reports"
 	dfilters "github.com/containers/podman/v6/pkg/domain/filters"
 	"github.com/containers/podman/v6/pkg/domain/infra/abi/terminal"
+	domainUtils "github.com/containers/podman/v6/pkg/domain/utils"
 	"github.com/containers/podman/v6/pkg/errorhandling"
 	parallelctr "github.com/containers/podman/v6/pkg/parallel/ctr"
 	"github.com/containers/podman/v6/pkg/ps"
@@ -37,6 +39,7 @@ import (
 	"go.podman.io/common/pkg/config"
 	"go.podman.io/image/v5/manifest"
 	"go.podman.io/storage"
+	"go.podman.io/storage/pkg/archive"
 	"go.podman.io/storage/pkg/unshare"
 	"go.podman.io/storage/types"
 )
@@ -842,6 +845,106 @@ func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []st
 	return reports, nil
 }
 
+func (ic *ContainerEngine) ContainerMigrate(ctx context.Context, nameOrID string, options entities.ContainerMigrateOptions) (*entities.ContainerMigrateReport, error) {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	dest, err := domainUtils.NewMigrationDestination(options.Destination, options.SSHMode)
+	if err != nil {
+		return nil, err
+	}
+	defer dest.Remove()
+
+	tmpDir, err := os.MkdirTemp("", "podman-migrate")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Every archive is exported to a local temporary file and copied to
+	// the destination right away, the pre-checkpoints while the container
+	// keeps running.
+	checkpoint := func(name string, checkOpts libpod.ContainerCheckpointOptions) (string, error) {
+		checkOpts.TargetFile = filepath.Join(tmpDir, name)
+		checkOpts.Compression = archive.Zstd
+		checkOpts.TCPEstablished = options.TCPEstablished
+		checkOpts.FileLocks = options.FileLocks
+		if _, _, err := ctr.Checkpoint(ctx, checkOpts); err != nil {
+			return "", err
+		}
+		return checkOpts.TargetFile, nil
+	}
+	send := func(name, file string) error {
+		defer os.Remove(file)
+		return dest.Send(name, file)
+	}
+
+	previous := make([]string, 0, options.PreDumps)
+	for i := range options.PreDumps {
+		name := fmt.Sprintf("pre-checkpoint-%d.tar.zst", i+1)
+		file, err := checkpoint(name, libpod.ContainerCheckpointOptions{
+			PreCheckPoint: true,
+			WithPrevious:  i > 0,
+			IgnoreRootfs:  true,
+			IgnoreVolumes: true,
+		})
+		if err == nil {
+			err = send(name, file)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("pre-checkpointing container %s: %w", ctr.ID(), err)
+		}
+		previous = append(previous, name)
+	}
+
+	const checkpointName = "checkpoint.tar.zst"
+	file, err := checkpoint(checkpointName, libpod.ContainerCheckpointOptions{
+		// Keep the checkpoint to restore the container on this host
+		// if the migration fails from here on.
+		Keep:          true,
+		WithPrevious:  len(previous) > 0,
+		IgnoreVolumes: options.IgnoreVolumes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("checkpointing container %s: %w", ctr.ID(), err)
+	}
+
+	// The container is stopped, restore it on this host on any failure.
+	restoreLocally := func() {
+		_, _, restoreErr := ctr.Restore(ctx, libpod.ContainerCheckpointOptions{
+			TCPEstablished: options.TCPEstablished,
+			FileLocks:      options.FileLocks,
+		})
+		if restoreErr != nil {
+			logrus.Errorf("Restoring container %s after failed migration: %v", ctr.ID(), restoreErr)
+		}
+	}
+	if err := send(checkpointName, file); err != nil {
+		restoreLocally()
+		return nil, err
+	}
+
+	var restoreArgs []string
+	if options.TCPEstablished {
+		restoreArgs = append(restoreArgs, "--tcp-established")
+	}
+	if options.FileLocks {
+		restoreArgs = append(restoreArgs, "--file-locks")
+	}
+	if options.IgnoreVolumes {
+		restoreArgs = append(restoreArgs, "--ignore-volumes")
+	}
+	remoteID, err := dest.Restore(checkpointName, previous, restoreArgs...)
+	if err != nil {
+		restoreLocally()
+		return nil, err
+	}
+
+	return &entities.ContainerMigrateReport{Id: ctr.ID(), RemoteID: remoteID}, nil
+}
+
 func (ic *ContainerEngine) ContainerCreate(ctx context.Context, s *specgen.SpecGenerator) (*entities.ContainerCreateReport, error) {
 	warn, err := generate.CompleteSpec(ctx, ic.Libpod, s)
 	if err != nil {
@@ -1761,6 +1864,29 @@ func (ic *ContainerEngine) ContainerRename(ctx context.Context, nameOrID string,
 	return nil
 }
 
+// ContainerRotateLog rotates the log file of the container or, with the All
+// option, the log files of all containers registered for log rotation.
+func (ic *ContainerEngine) ContainerRotateLog(_ context.Context, nameOrID string, options entities.ContainerRotateLogOptions) error {
+	if options.All {
+		return ic.Libpod.RotateLogs()
+	}
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return err
+	}
+	return ctr.RotateLog()
+}
+
+// ContainerShipLogs ships the logs of the container to the log server of its
+// log driver.
+func (ic *ContainerEngine) ContainerShipLogs(ctx context.Context, nameOrID string) error {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return err
+	}
+	return ctr.ShipLogs(ctx)
+}
+
 func (ic *ContainerEngine) ContainerClone(ctx context.Context, ctrCloneOpts entities.ContainerCloneOptions) (*entities.ContainerCreateReport, error) {
 	spec := specgen.NewSpecGenerator(ctrCloneOpts.Image, ctrCloneOpts.CreateOpts.RootFS)
 	var c *libpod.Container
@@ -9,7 +9,9 @@ import (
 
 	"github.com/containers/buildah/pkg/parse"
 	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/domain/utils"
 	"github.com/containers/podman/v6/pkg/emulation"
+	"github.com/containers/podman/v6/pkg/ps"
 	lplatform "go.podman.io/common/libimage/platform"
 )
 
@@ -43,16 +45,40 @@ func (ir *ImageEngine) fetchInfo(_ context.Context) (os, arch, variant string, n
 	return os, arch, variant, append([]string{}, nativePlatform), emulatedPlatforms, nil
 }
 
+// fetchLoad returns the current load of the local engine.
+func (ir *ImageEngine) fetchLoad(ctx context.Context) (cpus int, cpuUtilization float64, runningBuilds int, err error) {
+	cpus, cpuUtilization, err = utils.FarmNodeLoad(ctx, ir.Libpod.Info)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("retrieving host info: %w", err)
+	}
+	ctrs, err := ps.GetExternalContainerLists(ir.Libpod)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("listing build containers: %w", err)
+	}
+	return cpus, cpuUtilization, utils.CountBuildContainers(ctrs), nil
+}
+
 // FarmNodeInspect returns information about the remote engines in the farm
 func (ir *ImageEngine) FarmNodeInspect(ctx context.Context) (*entities.FarmInspectReport, error) {
 	ir.platforms.Do(func() {
 		ir.os, ir.arch, ir.variant, ir.nativePlatforms, ir.emulatedPlatforms, ir.platformsErr = ir.fetchInfo(ctx)
 	})
+	if ir.platformsErr != nil {
+		return nil, ir.platformsErr
+	}
+	// The load changes all the time, do not cache it.
+	cpus, cpuUtilization, runningBuilds, err := ir.fetchLoad(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return &entities.FarmInspectReport{
 		NativePlatforms:   ir.nativePlatforms,
 		EmulatedPlatforms: ir.emulatedPlatforms,
 		OS:                ir.os,
 		Arch:              ir.arch,
 		Variant:           ir.variant,
-	}, ir.platformsErr
+		CPUs:              cpus,
+		CPUUtilization:    cpuUtilization,
+		RunningBuilds:     runningBuilds,
+	}, nil
 }
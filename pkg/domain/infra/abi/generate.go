@@ -117,8 +117,11 @@ func (ic *ContainerEngine) GenerateKube(ctx context.Context, nameOrIDs []string,
 		content     [][]byte
 	)
 
-	if options.Replicas > 1 && options.Type != define.K8sKindDeployment {
-		return nil, fmt.Errorf("--replicas can only be set when --type is set to deployment")
+	if options.Replicas > 1 && options.Type != define.K8sKindDeployment && options.Type != define.K8sKindStatefulSet {
+		return nil, fmt.Errorf("--replicas can only be set when --type is set to deployment or statefulset")
+	}
+	if options.Schedule != "" && options.Type != define.K8sKindCronJob {
+		return nil, fmt.Errorf("--schedule can only be set when --type is set to cronjob")
 	}
 	if options.Replicas < 1 {
 		return nil, fmt.Errorf("--replicas has to be greater than or equal to 1. By default, --replicas is set to 1")
@@ -259,6 +262,26 @@ func (ic *ContainerEngine) GenerateKube(ctx context.Context, nameOrIDs []string,
 				return nil, err
 			}
 			typeContent = append(typeContent, b)
+		case define.K8sKindStatefulSet:
+			sts, err := libpod.GenerateForKubeStatefulSet(ctx, libpod.ConvertV1PodToYAMLPod(po), options)
+			if err != nil {
+				return nil, err
+			}
+			b, err := generateKubeYAML(sts)
+			if err != nil {
+				return nil, err
+			}
+			typeContent = append(typeContent, b)
+		case define.K8sKindCronJob:
+			cronJob, err := libpod.GenerateForKubeCronJob(ctx, libpod.ConvertV1PodToYAMLPod(po), options)
+			if err != nil {
+				return nil, err
+			}
+			b, err := generateKubeYAML(cronJob)
+			if err != nil {
+				return nil, err
+			}
+			typeContent = append(typeContent, b)
 		case define.K8sKindPod:
 			b, err := generateKubeYAML(libpod.ConvertV1PodToYAMLPod(po))
 			if err != nil {
@@ -266,7 +289,7 @@ func (ic *ContainerEngine) GenerateKube(ctx context.Context, nameOrIDs []string,
 			}
 			typeContent = append(typeContent, b)
 		default:
-			return nil, fmt.Errorf("invalid generation type - only pods, deployments, jobs, and daemonsets are currently supported: %+v", options.Type)
+			return nil, fmt.Errorf("invalid generation type - only pods, deployments, jobs, daemonsets, statefulsets, and cronjobs are currently supported: %+v", options.Type)
 		}
 
 		if options.Service {
@@ -336,6 +359,26 @@ func getKubePods(ctx context.Context, pods []*libpod.Pod, options entities.Gener
 				return nil, nil, err
 			}
 			out = append(out, b)
+		case define.K8sKindStatefulSet:
+			sts, err := libpod.GenerateForKubeStatefulSet(ctx, libpod.ConvertV1PodToYAMLPod(po), options)
+			if err != nil {
+				return nil, nil, err
+			}
+			b, err := generateKubeYAML(sts)
+			if err != nil {
+				return nil, nil, err
+			}
+			out = append(out, b)
+		case define.K8sKindCronJob:
+			cronJob, err := libpod.GenerateForKubeCronJob(ctx, libpod.ConvertV1PodToYAMLPod(po), options)
+			if err != nil {
+				return nil, nil, err
+			}
+			b, err := generateKubeYAML(cronJob)
+			if err != nil {
+				return nil, nil, err
+			}
+			out = append(out, b)
 		case define.K8sKindPod:
 			b, err := generateKubeYAML(libpod.ConvertV1PodToYAMLPod(po))
 			if err != nil {
@@ -343,7 +386,7 @@ func getKubePods(ctx context.Context, pods []*libpod.Pod, options entities.Gener
 			}
 			out = append(out, b)
 		default:
-			return nil, nil, fmt.Errorf("invalid generation type - only pods, deployments, jobs, and daemonsets are currently supported")
+			return nil, nil, fmt.Errorf("invalid generation type - only pods, deployments, jobs, daemonsets, statefulsets, and cronjobs are currently supported")
 		}
 
 		if options.Service {
@@ -9,7 +9,18 @@ import (
 	"github.com/containers/podman/v6/pkg/domain/entities"
 )
 
-func (ic *ContainerEngine) HealthCheckRun(ctx context.Context, nameOrID string, _ entities.HealthCheckOptions) (*define.HealthCheckResults, error) {
+func (ic *ContainerEngine) HealthCheckRun(ctx context.Context, nameOrID string, options entities.HealthCheckOptions) (*define.HealthCheckResults, error) {
+	if options.Readiness {
+		ready, err := ic.Libpod.ReadinessCheck(ctx, nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		status := define.ReadinessCheckNotReady
+		if ready {
+			status = define.ReadinessCheckReady
+		}
+		return &define.HealthCheckResults{Status: status}, nil
+	}
 	status, err := ic.Libpod.HealthCheck(ctx, nameOrID)
 	if err != nil {
 		return nil, err
@@ -27,6 +27,7 @@ import (
 	domainUtils "github.com/containers/podman/v6/pkg/domain/utils"
 	"github.com/containers/podman/v6/pkg/errorhandling"
 	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/containers/podman/v6/pkg/tracing"
 	"github.com/opencontainers/go-digest"
 	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/sirupsen/logrus"
@@ -308,7 +309,9 @@ func (ir *ImageEngine) Pull(ctx context.Context, rawImage string, options entiti
 		pullOptions.Writer = os.Stderr
 	}
 
+	ctx, span := tracing.Start(ctx, "libpod.image.pull", tracing.ImageKey.String(rawImage))
 	pulledImages, err := ir.Libpod.LibimageRuntime().Pull(ctx, rawImage, options.PullPolicy, pullOptions)
+	tracing.End(span, err)
 	if err != nil {
 		return nil, err
 	}
@@ -481,6 +484,12 @@ func (ir *ImageEngine) Load(ctx context.Context, options entities.ImageLoadOptio
 		loadOptions.Writer = os.Stderr
 	}
 
+	if options.ReuseLayers {
+		if err := ir.reuseLocalLayers(options.Input); err != nil {
+			return nil, err
+		}
+	}
+
 	loadedImages, err := ir.Libpod.LibimageRuntime().Load(ctx, options.Input, loadOptions)
 	if err != nil {
 		return nil, err
@@ -789,6 +798,7 @@ func (ir *ImageEngine) Scp(ctx context.Context, src, dst string, opts entities.I
 		transferOpts := entities.ScpTransferOptions{}
 		transferOpts.ParentFlags = report.ParentFlags
 		transferOpts.SaveFormat = report.SaveFormat
+		defer os.Remove(report.Source.File)
 		_, err := Transfer(ctx, *report.Source, *report.Dest, transferOpts)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,218 @@
+//go:build !remote && (linux || freebsd)
+
+package abi
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// ociArchiveEntry is the location of the contents of an entry in an OCI
+// archive.
+type ociArchiveEntry struct {
+	offset, size int64
+}
+
+// ociArchive is the index of the entries of an OCI archive.
+type ociArchive struct {
+	f     *os.File
+	index []byte
+	blobs map[digest.Digest]ociArchiveEntry
+	// end is the offset of the end-of-archive marker.
+	end int64
+}
+
+// reuseLocalLayers completes the OCI archive at path in place, appending the
+// layer blobs missing from it. They are generated from the layers in local
+// storage with the same uncompressed digest. Image transfers leave out the
+// layers the destination has already. Only the missing blobs are written, the
+// archive is not unpacked.
+func (ir *ImageEngine) reuseLocalLayers(path string) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	archive, err := readOCIArchive(f)
+	if err != nil {
+		return fmt.Errorf("reading OCI archive %s: %w", path, err)
+	}
+	var index imgspecv1.Index
+	if err := json.Unmarshal(archive.index, &index); err != nil {
+		return fmt.Errorf("parsing OCI index of %s: %w", path, err)
+	}
+	var missing []digest.Digest
+	for _, desc := range index.Manifests {
+		if missing, err = archive.missingLayers(desc, missing); err != nil {
+			return err
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if err := f.Truncate(archive.end); err != nil {
+		return err
+	}
+	if _, err := f.Seek(archive.end, io.SeekStart); err != nil {
+		return err
+	}
+	tw := tar.NewWriter(f)
+	for _, layerDigest := range missing {
+		if err := ir.appendLayer(tw, layerDigest); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// readOCIArchive indexes the OCI archive in f. Only the index is read, the
+// contents of the other entries are skipped.
+func readOCIArchive(f *os.File) (*ociArchive, error) {
+	archive := &ociArchive{f: f, blobs: make(map[digest.Digest]ociArchiveEntry)}
+	tr := tar.NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		// The reader is positioned at the contents of the entry, which
+		// are padded to the block size.
+		offset, err := f.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		archive.end = offset + (hdr.Size+511)/512*512
+
+		name := path.Clean(strings.TrimPrefix(hdr.Name, "./"))
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if name == imgspecv1.ImageIndexFile {
+			if archive.index, err = io.ReadAll(tr); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		dir, encoded := path.Split(name)
+		alg, ok := strings.CutPrefix(path.Clean(dir), imgspecv1.ImageBlobsDir+"/")
+		if !ok {
+			continue
+		}
+		if d := digest.NewDigestFromEncoded(digest.Algorithm(alg), encoded); d.Validate() == nil {
+			archive.blobs[d] = ociArchiveEntry{offset: offset, size: hdr.Size}
+		}
+	}
+	if archive.index == nil {
+		return nil, fmt.Errorf("no %s found", imgspecv1.ImageIndexFile)
+	}
+	return archive, nil
+}
+
+// readBlob returns the contents of the blob with digest d.
+func (a *ociArchive) readBlob(d digest.Digest) ([]byte, error) {
+	entry, ok := a.blobs[d]
+	if !ok {
+		return nil, fmt.Errorf("blob %s not found in archive", d)
+	}
+	return io.ReadAll(io.NewSectionReader(a.f, entry.offset, entry.size))
+}
+
+// missingLayers appends the layers of the manifest or index described by desc
+// which are missing from the archive to missing.
+func (a *ociArchive) missingLayers(desc imgspecv1.Descriptor, missing []digest.Digest) ([]digest.Digest, error) {
+	data, err := a.readBlob(desc.Digest)
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest %s: %w", desc.Digest, err)
+	}
+
+	if desc.MediaType == imgspecv1.MediaTypeImageIndex {
+		var index imgspecv1.Index
+		if err := json.Unmarshal(data, &index); err != nil {
+			return nil, fmt.Errorf("parsing index %s: %w", desc.Digest, err)
+		}
+		for _, d := range index.Manifests {
+			if missing, err = a.missingLayers(d, missing); err != nil {
+				return nil, err
+			}
+		}
+		return missing, nil
+	}
+
+	var manifest imgspecv1.Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("parsing manifest %s: %w", desc.Digest, err)
+	}
+	for _, layer := range manifest.Layers {
+		if err := layer.Digest.Validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := a.blobs[layer.Digest]; ok {
+			continue
+		}
+		// Record the blob, images can share layers.
+		a.blobs[layer.Digest] = ociArchiveEntry{offset: -1}
+		missing = append(missing, layer.Digest)
+	}
+	return missing, nil
+}
+
+// appendLayer writes the blob of the layer with the given uncompressed digest
+// to the archive written by tw.
+func (ir *ImageEngine) appendLayer(tw *tar.Writer, layerDigest digest.Digest) error {
+	rc, size, err := ir.Libpod.LayerByUncompressedDigest(layerDigest)
+	if err != nil {
+		return fmt.Errorf("layer %s is neither in the archive nor usable from local storage: %w", layerDigest, err)
+	}
+	defer rc.Close()
+
+	var layer io.Reader = rc
+	if size < 0 {
+		// The size goes into the header of the entry, so layers of
+		// unknown size are spooled first.
+		spool, err := os.CreateTemp("", "podman-layer")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(spool.Name())
+		defer spool.Close()
+		if size, err = io.Copy(spool, rc); err != nil {
+			return fmt.Errorf("reading layer %s: %w", layerDigest, err)
+		}
+		if _, err := spool.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		layer = spool
+	}
+
+	hdr := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     path.Join(imgspecv1.ImageBlobsDir, layerDigest.Algorithm().String(), layerDigest.Encoded()),
+		Mode:     0o644,
+		Size:     size,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	verifier := layerDigest.Verifier()
+	if _, err := io.Copy(io.MultiWriter(tw, verifier), layer); err != nil {
+		return fmt.Errorf("writing layer %s: %w", layerDigest, err)
+	}
+	if !verifier.Verified() {
+		return fmt.Errorf("layer %s in local storage does not match its digest, it cannot be reused", layerDigest)
+	}
+	return nil
+}
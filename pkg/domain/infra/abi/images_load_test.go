@@ -0,0 +1,81 @@
+//go:build !remote && (linux || freebsd)
+
+package abi
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTarEntry(t *testing.T, tw *tar.Writer, name string, data []byte) {
+	require.NoError(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Mode: 0o644, Size: int64(len(data))}))
+	_, err := tw.Write(data)
+	require.NoError(t, err)
+}
+
+func blobName(d digest.Digest) string {
+	return path.Join(imgspecv1.ImageBlobsDir, d.Algorithm().String(), d.Encoded())
+}
+
+func TestReadOCIArchive(t *testing.T) {
+	sent := []byte("sent layer")
+	sentDigest := digest.FromBytes(sent)
+	skippedDigest := digest.FromString("skipped layer")
+	manifest, err := json.Marshal(imgspecv1.Manifest{
+		Layers: []imgspecv1.Descriptor{{Digest: sentDigest}, {Digest: skippedDigest}, {Digest: skippedDigest}},
+	})
+	require.NoError(t, err)
+	manifestDigest := digest.FromBytes(manifest)
+	index, err := json.Marshal(imgspecv1.Index{
+		Manifests: []imgspecv1.Descriptor{{MediaType: imgspecv1.MediaTypeImageManifest, Digest: manifestDigest}},
+	})
+	require.NoError(t, err)
+
+	archivePath := filepath.Join(t.TempDir(), "archive.tar")
+	f, err := os.Create(archivePath)
+	require.NoError(t, err)
+	defer f.Close()
+	tw := tar.NewWriter(f)
+	writeTarEntry(t, tw, blobName(sentDigest), sent)
+	writeTarEntry(t, tw, blobName(manifestDigest), manifest)
+	writeTarEntry(t, tw, imgspecv1.ImageIndexFile, index)
+	require.NoError(t, tw.Close())
+	_, err = f.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+
+	archive, err := readOCIArchive(f)
+	require.NoError(t, err)
+	assert.Equal(t, index, archive.index)
+	assert.Contains(t, archive.blobs, sentDigest)
+
+	missing, err := archive.missingLayers(imgspecv1.Descriptor{Digest: manifestDigest}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []digest.Digest{skippedDigest}, missing)
+
+	// Entries appended at the end of the archive are read back.
+	require.NoError(t, f.Truncate(archive.end))
+	_, err = f.Seek(archive.end, io.SeekStart)
+	require.NoError(t, err)
+	tw = tar.NewWriter(f)
+	writeTarEntry(t, tw, blobName(skippedDigest), []byte("skipped layer"))
+	require.NoError(t, tw.Close())
+	_, err = f.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+
+	archive, err = readOCIArchive(f)
+	require.NoError(t, err)
+	assert.Len(t, archive.blobs, 3)
+	data, err := archive.readBlob(skippedDigest)
+	require.NoError(t, err)
+	assert.Equal(t, "skipped layer", string(data))
+}
@@ -79,6 +79,14 @@ func VolumeOptions(opts map[string]string) ([]libpod.VolumeCreateOption, error)
 					libpodOptions = append(libpodOptions, libpod.WithVolumeDisableQuota())
 					// set option "NOQUOTA": "true"
 					volumeOptions["NOQUOTA"] = "true"
+				case "quota":
+					if !hasVal {
+						return nil, fmt.Errorf("quota option must provide a quota backend: %w", define.ErrInvalidArg)
+					}
+					logrus.Debugf("Removing quota from options and adding WithVolumeQuota for backend %s", val)
+					libpodOptions = append(libpodOptions, libpod.WithVolumeQuota(val))
+					// set option "QUOTA": "$backend"
+					volumeOptions["QUOTA"] = val
 				case "timeout":
 					if !hasVal {
 						return nil, fmt.Errorf("timeout option must provide a valid timeout in seconds: %w", define.ErrInvalidArg)
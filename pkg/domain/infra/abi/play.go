@@ -11,6 +11,7 @@ import (
 	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -31,6 +32,7 @@ import (
 	"github.com/containers/podman/v6/pkg/specgen/generate"
 	"github.com/containers/podman/v6/pkg/specgen/generate/kube"
 	"github.com/containers/podman/v6/pkg/specgenutil"
+	"github.com/containers/podman/v6/pkg/systemd"
 	"github.com/containers/podman/v6/pkg/systemd/notifyproxy"
 	"github.com/containers/podman/v6/pkg/util"
 	"github.com/coreos/go-systemd/v22/daemon"
@@ -327,7 +329,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 		}
 
 		// TODO: create constants for the various "kinds" of yaml files.
-		if options.ServiceContainer && serviceContainer == nil && (kind == "Pod" || kind == "Deployment") {
+		if options.ServiceContainer && serviceContainer == nil && (kind == "Pod" || kind == "Deployment" || kind == "StatefulSet") {
 			ctr, err := ic.createServiceContainer(ctx, k8sName(content, "service"), options)
 			if err != nil {
 				return nil, err
@@ -429,6 +431,37 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 			report.Pods = append(report.Pods, r.Pods...)
 			validKinds++
 			setRanContainers(r)
+		case "StatefulSet":
+			var statefulSetYAML v1apps.StatefulSet
+
+			if err := yaml.Unmarshal(document, &statefulSetYAML); err != nil {
+				return nil, fmt.Errorf("unable to read YAML as Kube StatefulSet: %w", err)
+			}
+
+			r, proxies, err := ic.playKubeStatefulSet(ctx, &statefulSetYAML, options, &ipIndex, configMaps, serviceContainer)
+			if err != nil {
+				return nil, err
+			}
+			notifyProxies = append(notifyProxies, proxies...)
+
+			report.Pods = append(report.Pods, r.Pods...)
+			report.Volumes = append(report.Volumes, r.Volumes...)
+			validKinds++
+			setRanContainers(r)
+		case "CronJob":
+			var cronJobYAML v1.CronJob
+
+			if err := yaml.Unmarshal(document, &cronJobYAML); err != nil {
+				return nil, fmt.Errorf("unable to read YAML as Kube CronJob: %w", err)
+			}
+
+			r, err := ic.playKubeCronJob(ctx, &cronJobYAML, options, &ipIndex, configMaps)
+			if err != nil {
+				return nil, err
+			}
+
+			report.Pods = append(report.Pods, r.Pods...)
+			validKinds++
 		case "PersistentVolumeClaim":
 			var pvcYAML v1.PersistentVolumeClaim
 
@@ -617,6 +650,146 @@ func (ic *ContainerEngine) playKubeJob(ctx context.Context, jobYAML *v1.Job, opt
 	return &report, proxies, nil
 }
 
+func (ic *ContainerEngine) playKubeStatefulSet(ctx context.Context, statefulSetYAML *v1apps.StatefulSet, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
+	var (
+		statefulSetName string
+		numReplicas     int32
+		report          entities.PlayKubeReport
+		proxies         []*notifyproxy.NotifyProxy
+	)
+
+	statefulSetName = statefulSetYAML.ObjectMeta.Name
+	if statefulSetName == "" {
+		return nil, nil, errors.New("statefulset does not have a name")
+	}
+	numReplicas = 1
+	if statefulSetYAML.Spec.Replicas != nil {
+		numReplicas = *statefulSetYAML.Spec.Replicas
+	}
+
+	// Pods are created in ordinal order and get a stable name and hostname
+	// as well as their own volume for each of the volume claim templates.
+	for _, podName := range statefulSetPodNames(statefulSetName, numReplicas) {
+		podSpec := statefulSetYAML.Spec.Template
+		podSpec.Spec.Volumes = slices.Clone(podSpec.Spec.Volumes)
+		for _, claim := range statefulSetYAML.Spec.VolumeClaimTemplates {
+			pvc := claim
+			pvc.Name = statefulSetClaimName(claim.Name, podName)
+			for name, val := range options.Annotations {
+				if pvc.Annotations == nil {
+					pvc.Annotations = make(map[string]string)
+				}
+				pvc.Annotations[name] = val
+			}
+			if options.IsRemote {
+				if _, ok := pvc.Annotations[util.VolumeImportSourceAnnotation]; ok {
+					return nil, nil, fmt.Errorf("importing volumes is not supported for remote requests")
+				}
+			}
+			r, err := ic.playKubePVC(ctx, "", &pvc)
+			if err != nil {
+				return nil, nil, fmt.Errorf("encountered while creating volume %s: %w", pvc.Name, err)
+			}
+			report.Volumes = append(report.Volumes, r.Volumes...)
+
+			// The claim templates take precedence over volumes of the
+			// pod template with the same name.
+			podSpec.Spec.Volumes = slices.DeleteFunc(podSpec.Spec.Volumes, func(v v1.Volume) bool {
+				return v.Name == claim.Name
+			})
+			podSpec.Spec.Volumes = append(podSpec.Spec.Volumes, v1.Volume{
+				Name: claim.Name,
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: pvc.Name},
+				},
+			})
+		}
+
+		podReport, podProxies, err := ic.playKubePod(ctx, podName, &podSpec, options, ipIndex, statefulSetYAML.Annotations, configMaps, serviceContainer)
+		if err != nil {
+			return nil, nil, fmt.Errorf("encountered while bringing up pod %s: %w", podName, err)
+		}
+		report.Pods = append(report.Pods, podReport.Pods...)
+		proxies = append(proxies, podProxies...)
+	}
+
+	return &report, proxies, nil
+}
+
+// statefulSetPodNames returns the stable, ordinal names of the pods of a
+// StatefulSet.
+func statefulSetPodNames(statefulSetName string, replicas int32) []string {
+	names := make([]string, 0, replicas)
+	for i := range replicas {
+		names = append(names, fmt.Sprintf("%s-%d", statefulSetName, i))
+	}
+	return names
+}
+
+// statefulSetClaimName returns the name of the volume created from a
+// volume claim template for the given StatefulSet pod.
+func statefulSetClaimName(claimName, podName string) string {
+	return fmt.Sprintf("%s-%s", claimName, podName)
+}
+
+// cronJobUnitName returns the name of the transient systemd unit that starts
+// the pod of a CronJob.
+func cronJobUnitName(podName string) string {
+	return fmt.Sprintf("podman-kube-cronjob-%s", podName)
+}
+
+func (ic *ContainerEngine) playKubeCronJob(ctx context.Context, cronJobYAML *v1.CronJob, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap) (*entities.PlayKubeReport, error) {
+	var (
+		cronJobName string
+		podSpec     v1.PodTemplateSpec
+		report      entities.PlayKubeReport
+	)
+
+	cronJobName = cronJobYAML.ObjectMeta.Name
+	if cronJobName == "" {
+		return nil, errors.New("cronjob does not have a name")
+	}
+	timeZone := ""
+	if cronJobYAML.Spec.TimeZone != nil {
+		timeZone = *cronJobYAML.Spec.TimeZone
+	}
+	onCalendar, err := systemd.CronToOnCalendar(cronJobYAML.Spec.Schedule, timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("cronjob %s: %w", cronJobName, err)
+	}
+	if cronJobYAML.Spec.ConcurrencyPolicy == v1.ReplaceConcurrent {
+		logrus.Warnf("CronJob %s: concurrency policy %q is not supported, a run is skipped while the previous one is still active", cronJobName, v1.ReplaceConcurrent)
+	}
+	podSpec = cronJobYAML.Spec.JobTemplate.Spec.Template
+
+	// The pod is only created here; the systemd timer starts it on schedule.
+	options.Start = types.OptionalBoolFalse
+	podName := fmt.Sprintf("%s-pod", cronJobName)
+	if options.Replace {
+		// The old timer must be gone before a new one with the same unit
+		// name can be created, and it must not start the pod while it is
+		// being replaced.
+		if err := removeCronJobTimer(ctx, cronJobUnitName(podName)); err != nil {
+			return nil, fmt.Errorf("removing timer of pod %s: %w", podName, err)
+		}
+	}
+	podReport, _, err := ic.playKubePod(ctx, podName, &podSpec, options, ipIndex, cronJobYAML.Annotations, configMaps, nil)
+	if err != nil {
+		return nil, fmt.Errorf("encountered while bringing up pod %s: %w", podName, err)
+	}
+	report.Pods = podReport.Pods
+
+	if cronJobYAML.Spec.Suspend != nil && *cronJobYAML.Spec.Suspend {
+		logrus.Infof("CronJob %s is suspended, not scheduling pod %s", cronJobName, podName)
+		return &report, nil
+	}
+	if err := createCronJobTimer(cronJobUnitName(podName), onCalendar, podName); err != nil {
+		return nil, fmt.Errorf("scheduling pod %s: %w", podName, err)
+	}
+
+	return &report, nil
+}
+
 func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podYAML *v1.PodTemplateSpec, options entities.PlayKubeOptions, ipIndex *int, annotations map[string]string, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
 	cfg, err := ic.Libpod.GetConfigNoCopy()
 	if err != nil {
@@ -1619,7 +1792,7 @@ func sortKubeKinds(documentList [][]byte) ([][]byte, error) {
 		}
 
 		switch kind {
-		case "Pod", "Deployment", "DaemonSet", "Job":
+		case "Pod", "Deployment", "DaemonSet", "Job", "StatefulSet", "CronJob":
 			sortedDocumentList = append(sortedDocumentList, document)
 		default:
 			sortedDocumentList = append([][]byte{document}, sortedDocumentList...)
@@ -1677,9 +1850,10 @@ func getBuildFile(imageName string, cwd string) (string, error) {
 
 func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, body io.Reader, options entities.PlayKubeDownOptions) (*entities.PlayKubeReport, error) {
 	var (
-		podNames    []string
-		volumeNames []string
-		secretNames []string
+		podNames     []string
+		volumeNames  []string
+		secretNames  []string
+		cronJobUnits []string
 	)
 	reports := new(entities.PlayKubeReport)
 
@@ -1759,6 +1933,31 @@ func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, body io.Reader, opt
 			jobName := jobYAML.ObjectMeta.Name
 			podName := fmt.Sprintf("%s-pod", jobName)
 			podNames = append(podNames, podName)
+		case "StatefulSet":
+			var statefulSetYAML v1apps.StatefulSet
+
+			if err := yaml.Unmarshal(document, &statefulSetYAML); err != nil {
+				return nil, fmt.Errorf("unable to read YAML as Kube StatefulSet: %w", err)
+			}
+			var numReplicas int32 = 1
+			if statefulSetYAML.Spec.Replicas != nil {
+				numReplicas = *statefulSetYAML.Spec.Replicas
+			}
+			for _, podName := range statefulSetPodNames(statefulSetYAML.ObjectMeta.Name, numReplicas) {
+				podNames = append(podNames, podName)
+				for _, claim := range statefulSetYAML.Spec.VolumeClaimTemplates {
+					volumeNames = append(volumeNames, statefulSetClaimName(claim.Name, podName))
+				}
+			}
+		case "CronJob":
+			var cronJobYAML v1.CronJob
+
+			if err := yaml.Unmarshal(document, &cronJobYAML); err != nil {
+				return nil, fmt.Errorf("unable to read YAML as Kube CronJob: %w", err)
+			}
+			podName := fmt.Sprintf("%s-pod", cronJobYAML.ObjectMeta.Name)
+			podNames = append(podNames, podName)
+			cronJobUnits = append(cronJobUnits, cronJobUnitName(podName))
 		case "PersistentVolumeClaim":
 			var pvcYAML v1.PersistentVolumeClaim
 			if err := yaml.Unmarshal(document, &pvcYAML); err != nil {
@@ -1796,6 +1995,14 @@ func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, body io.Reader, opt
 		serviceCtrIDs = append(serviceCtrIDs, ctr.ID())
 	}
 
+	// Remove the timers of cron jobs before stopping their pods so they
+	// cannot be started again.
+	for _, unit := range cronJobUnits {
+		if err := removeCronJobTimer(ctx, unit); err != nil {
+			return nil, err
+		}
+	}
+
 	// Add the reports
 	reports.StopReport, err = ic.PodStop(ctx, podNames, entities.PodStopOptions{
 		Ignore:  true,
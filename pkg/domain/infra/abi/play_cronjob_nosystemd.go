@@ -0,0 +1,18 @@
+//go:build !remote && (freebsd || (linux && !systemd))
+
+package abi
+
+import (
+	"context"
+	"errors"
+)
+
+// createCronJobTimer is not supported without systemd.
+func createCronJobTimer(_, _, _ string) error {
+	return errors.New("kube CronJobs require systemd to schedule the pod")
+}
+
+// removeCronJobTimer is a no-op without systemd.
+func removeCronJobTimer(_ context.Context, _ string) error {
+	return nil
+}
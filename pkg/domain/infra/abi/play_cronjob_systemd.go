@@ -0,0 +1,92 @@
+//go:build !remote && linux && systemd
+
+package abi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/containers/podman/v6/pkg/errorhandling"
+	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/containers/podman/v6/pkg/systemd"
+	"github.com/sirupsen/logrus"
+	systemdCommon "go.podman.io/common/pkg/systemd"
+)
+
+// createCronJobTimer creates a transient systemd timer which starts the pod
+// of a kube CronJob on the given calendar schedule.
+func createCronJobTimer(unitName, onCalendar, podName string) error {
+	if !systemdCommon.RunsOnSystemd() {
+		return errors.New("kube CronJobs require systemd to schedule the pod")
+	}
+
+	podman, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to get path for podman for a cron job timer: %w", err)
+	}
+
+	cmd := []string{}
+	if rootless.IsRootless() {
+		cmd = append(cmd, "--user")
+	}
+	path := os.Getenv("PATH")
+	if path != "" {
+		cmd = append(cmd, "--setenv=PATH="+path)
+	}
+	cmd = append(cmd, "--unit", unitName, "--on-calendar="+onCalendar, "--timer-property=AccuracySec=1s", podman)
+	if logrus.IsLevelEnabled(logrus.DebugLevel) {
+		cmd = append(cmd, "--log-level=debug", "--syslog")
+	}
+	cmd = append(cmd, "pod", "start", podName)
+
+	logrus.Debugf("creating systemd-transient files: %s %s", "systemd-run", cmd)
+	systemdRun := exec.Command("systemd-run", cmd...)
+	if output, err := systemdRun.CombinedOutput(); err != nil {
+		exitError := &exec.ExitError{}
+		if errors.As(err, &exitError) {
+			return fmt.Errorf("systemd-run failed: %w: output: %s", err, strings.TrimSpace(string(output)))
+		}
+		return fmt.Errorf("failed to execute systemd-run: %w", err)
+	}
+	return nil
+}
+
+// removeCronJobTimer stops and removes the transient systemd timer and
+// service of a kube CronJob.
+func removeCronJobTimer(ctx context.Context, unitName string) error {
+	if !systemdCommon.RunsOnSystemd() {
+		return nil
+	}
+	conn, err := systemd.ConnectToDBUS()
+	if err != nil {
+		return fmt.Errorf("unable to get systemd connection to remove cron job timer: %w", err)
+	}
+	defer conn.Close()
+
+	stopErrors := []error{}
+	// Stop the timer before the service to make sure the timer does not
+	// fire after the service is stopped.
+	for _, unit := range []string{unitName + ".timer", unitName + ".service"} {
+		ch := make(chan string)
+		if _, err := conn.StopUnitContext(ctx, unit, "ignore-dependencies", ch); err != nil {
+			if !strings.HasSuffix(err.Error(), " not loaded.") {
+				stopErrors = append(stopErrors, fmt.Errorf("removing cron job unit %q: %w", unit, err))
+			}
+			continue
+		}
+		if msg := <-ch; msg != "done" {
+			stopErrors = append(stopErrors, fmt.Errorf("stopping cron job unit %q: expected %q but received %q", unit, "done", msg))
+		}
+	}
+	// systemd keeps failed transient services around, reset them so they
+	// do not leak.
+	if err := conn.ResetFailedUnitContext(ctx, unitName+".service"); err != nil {
+		logrus.Debugf("Failed to reset unit file: %q", err)
+	}
+
+	return errorhandling.JoinErrors(stopErrors)
+}
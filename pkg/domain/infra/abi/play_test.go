@@ -278,3 +278,29 @@ items:
 		})
 	}
 }
+
+func TestSortKubeKinds(t *testing.T) {
+	docs := [][]byte{
+		[]byte("apiVersion: apps/v1\nkind: StatefulSet\n"),
+		[]byte("apiVersion: v1\nkind: PersistentVolumeClaim\n"),
+		[]byte("apiVersion: batch/v1\nkind: CronJob\n"),
+		[]byte("apiVersion: v1\nkind: Secret\n"),
+	}
+	sorted, err := sortKubeKinds(docs)
+	assert.NoError(t, err)
+
+	kinds := make([]string, 0, len(sorted))
+	for _, doc := range sorted {
+		kind, err := getKubeKind(doc)
+		assert.NoError(t, err)
+		kinds = append(kinds, kind)
+	}
+	assert.Equal(t, []string{"Secret", "PersistentVolumeClaim", "StatefulSet", "CronJob"}, kinds)
+}
+
+func TestStatefulSetNames(t *testing.T) {
+	podNames := statefulSetPodNames("web", 3)
+	assert.Equal(t, []string{"web-0", "web-1", "web-2"}, podNames)
+	assert.Equal(t, "data-web-1", statefulSetClaimName("data", podNames[1]))
+	assert.Empty(t, statefulSetPodNames("web", 0))
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/containers/podman/v6/libpod"
 	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/pkg/checkpoint"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	dfilters "github.com/containers/podman/v6/pkg/domain/filters"
 	"github.com/containers/podman/v6/pkg/signal"
@@ -319,6 +320,34 @@ func (ic *ContainerEngine) PodCreate(_ context.Context, specg entities.PodSpec)
 	return &entities.PodCreateReport{Id: pod.ID()}, nil
 }
 
+func (ic *ContainerEngine) PodCheckpoint(ctx context.Context, nameOrID string, options entities.PodCheckpointOptions) (*entities.PodCheckpointReport, error) {
+	pod, err := ic.Libpod.LookupPod(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	ctrs, err := checkpoint.CRCheckpointPod(ctx, ic.Libpod, pod, options)
+	if err != nil {
+		return nil, err
+	}
+	report := &entities.PodCheckpointReport{Id: pod.ID()}
+	for _, ctr := range ctrs {
+		report.Containers = append(report.Containers, ctr.ID())
+	}
+	return report, nil
+}
+
+func (ic *ContainerEngine) PodRestore(ctx context.Context, options entities.PodRestoreOptions) (*entities.PodRestoreReport, error) {
+	pod, ctrs, err := checkpoint.CRImportPodCheckpoint(ctx, ic.Libpod, options)
+	if err != nil {
+		return nil, err
+	}
+	report := &entities.PodRestoreReport{Id: pod.ID()}
+	for _, ctr := range ctrs {
+		report.Containers = append(report.Containers, ctr.ID())
+	}
+	return report, nil
+}
+
 func (ic *ContainerEngine) PodClone(ctx context.Context, podClone entities.PodCloneOptions) (*entities.PodCloneReport, error) {
 	spec := specgen.NewPodSpecGenerator()
 	p, err := generate.PodConfigToSpec(ic.Libpod, spec, &podClone.InfraOptions, podClone.ID)
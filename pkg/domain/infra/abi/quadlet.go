@@ -689,6 +689,8 @@ func (ic *ContainerEngine) QuadletList(ctx context.Context, options entities.Qua
 	reports := make([]*entities.ListQuadlet, 0, len(quadletPaths))
 	allServiceNames := make([]string, 0, len(quadletPaths))
 	partialReports := make(map[string]entities.ListQuadlet)
+	templates := make(map[string]entities.ListQuadlet)
+	quadletNames := make(map[string]bool)
 
 	for _, path := range quadletPaths {
 		appName := ""
@@ -702,12 +704,17 @@ func (ic *ContainerEngine) QuadletList(ctx context.Context, options entities.Qua
 			App:  appName,
 		}
 
+		quadletNames[report.Name] = true
+
 		serviceName, err := getQuadletServiceName(path)
 		if err != nil {
 			report.Status = err.Error()
 			reports = append(reports, &report)
 			continue
 		}
+		if prefix, ok := strings.CutSuffix(serviceName, "@.service"); ok {
+			templates[prefix] = report
+		}
 		partialReports[serviceName] = report
 		allServiceNames = append(allServiceNames, serviceName)
 	}
@@ -739,6 +746,12 @@ func (ic *ContainerEngine) QuadletList(ctx context.Context, options entities.Qua
 		delete(partialReports, unitStatus.Name)
 	}
 
+	instanceReports, err := listQuadletInstances(ctx, conn, templates, quadletNames)
+	if err != nil {
+		return nil, err
+	}
+	reports = append(reports, instanceReports...)
+
 	// This should not happen.
 	// Systemd will give us output for everything we sent to them, even if it's not a valid unit.
 	// We can find them with LoadState, as we do above.
@@ -0,0 +1,293 @@
+//go:build !remote && (linux || freebsd)
+
+package abi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/containers/podman/v6/pkg/systemd"
+	"github.com/containers/podman/v6/pkg/systemd/parser"
+	systemdquadlet "github.com/containers/podman/v6/pkg/systemd/quadlet"
+	"github.com/coreos/go-systemd/v22/dbus"
+	"github.com/sirupsen/logrus"
+)
+
+// getQuadletUnit returns the path of a Quadlet given its full name including
+// extension, and the name of the systemd service generated from it.
+// An instance of a template, like foo@bar.container, does not need a Quadlet
+// of its own: the path is then the one of the template and the service is
+// the instance of the template service.
+func getQuadletUnit(name string) (string, string, error) {
+	quadletPath, err := getQuadletPathByName(name)
+	if err == nil {
+		serviceName, err := getQuadletServiceName(quadletPath)
+		if err != nil {
+			return "", "", err
+		}
+		return quadletPath, serviceName, nil
+	}
+
+	templateName, instance, ok := splitQuadletInstance(name)
+	if !ok || !errors.Is(err, define.ErrNoSuchQuadlet) {
+		return "", "", err
+	}
+	quadletPath, templateErr := getQuadletPathByName(templateName)
+	if templateErr != nil {
+		// Report the instance as missing, not its template
+		return "", "", err
+	}
+	serviceName, err := getInstanceServiceName(quadletPath, instance)
+	if err != nil {
+		return "", "", err
+	}
+	return quadletPath, serviceName, nil
+}
+
+// getInstanceServiceName returns the name of the service of an instance of the
+// template Quadlet at templatePath without a Quadlet of its own.
+func getInstanceServiceName(templatePath, instance string) (string, error) {
+	templateName := filepath.Base(templatePath)
+	unit, err := parser.ParseUnitFile(templatePath)
+	if err != nil {
+		return "", fmt.Errorf("parsing Quadlet file %s: %w", templatePath, err)
+	}
+	if systemdquadlet.RequiresInstanceFiles(unit) {
+		ext := filepath.Ext(templateName)
+		instanceName := strings.TrimSuffix(templateName, "@"+ext) + "@" + instance + ext
+		return "", fmt.Errorf("%s: %w, the ports of quadlet %s depend on the instance so each instance needs a symlink to it", instanceName, define.ErrNoSuchQuadlet, templateName)
+	}
+
+	serviceName, err := systemdquadlet.GetUnitServiceName(unit)
+	if err != nil {
+		return "", fmt.Errorf("generating service name for Quadlet %s: %w", templateName, err)
+	}
+	serviceName, err = instanceServiceName(serviceName+".service", instance)
+	if err != nil {
+		return "", fmt.Errorf("quadlet %s: %w", templateName, err)
+	}
+	return serviceName, nil
+}
+
+// splitQuadletInstance returns the name of the template of an instance of a
+// template Quadlet, foo@.container for foo@bar.container, and the instance.
+func splitQuadletInstance(name string) (string, string, bool) {
+	ext := filepath.Ext(name)
+	prefix, instance, found := strings.Cut(strings.TrimSuffix(name, ext), "@")
+	if !found || instance == "" {
+		return "", "", false
+	}
+	return prefix + "@" + ext, instance, true
+}
+
+// instanceServiceName returns the name of the instance of a template
+// service, foo@bar.service for foo@.service.
+func instanceServiceName(templateServiceName, instance string) (string, error) {
+	prefix, ok := strings.CutSuffix(templateServiceName, "@.service")
+	if !ok {
+		return "", fmt.Errorf("systemd unit %s is not a template", templateServiceName)
+	}
+	return prefix + "@" + instance + ".service", nil
+}
+
+// listQuadletInstances returns the instances of template Quadlets known to
+// systemd which have no Quadlet of their own, as if they had one. templates
+// maps the prefix of the template services to the templates.
+func listQuadletInstances(ctx context.Context, conn *dbus.Conn, templates map[string]entities.ListQuadlet, quadletNames map[string]bool) ([]*entities.ListQuadlet, error) {
+	if len(templates) == 0 {
+		return nil, nil
+	}
+	patterns := make([]string, 0, len(templates))
+	for prefix := range templates {
+		patterns = append(patterns, prefix+"@*.service")
+	}
+	statuses, err := conn.ListUnitsByPatternsContext(ctx, nil, patterns)
+	if err != nil {
+		return nil, fmt.Errorf("querying systemd for instances of template units: %w", err)
+	}
+
+	reports := make([]*entities.ListQuadlet, 0, len(statuses))
+	for _, unitStatus := range statuses {
+		prefix, instance, found := strings.Cut(strings.TrimSuffix(unitStatus.Name, ".service"), "@")
+		template, ok := templates[prefix]
+		if !found || instance == "" || !ok {
+			continue
+		}
+		ext := filepath.Ext(template.Name)
+		name := strings.TrimSuffix(template.Name, "@"+ext) + "@" + instance + ext
+		if quadletNames[name] {
+			// Already listed with its own Quadlet
+			continue
+		}
+
+		report := template
+		report.Name = name
+		report.UnitName = unitStatus.Name
+		report.Status = fmt.Sprintf("%s/%s", unitStatus.ActiveState, unitStatus.SubState)
+		reports = append(reports, &report)
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Name < reports[j].Name })
+	return reports, nil
+}
+
+// QuadletStart starts the systemd services of one or more Quadlets.
+func (ic *ContainerEngine) QuadletStart(ctx context.Context, quadlets []string) ([]*entities.QuadletUnitReport, error) {
+	return quadletUnitJobs(ctx, quadlets, "starting", func(conn *dbus.Conn) systemd.UnitJob { return conn.StartUnitContext })
+}
+
+// QuadletStop stops the systemd services of one or more Quadlets.
+func (ic *ContainerEngine) QuadletStop(ctx context.Context, quadlets []string) ([]*entities.QuadletUnitReport, error) {
+	return quadletUnitJobs(ctx, quadlets, "stopping", func(conn *dbus.Conn) systemd.UnitJob { return conn.StopUnitContext })
+}
+
+// QuadletRestart restarts the systemd services of one or more Quadlets.
+func (ic *ContainerEngine) QuadletRestart(ctx context.Context, quadlets []string) ([]*entities.QuadletUnitReport, error) {
+	return quadletUnitJobs(ctx, quadlets, "restarting", func(conn *dbus.Conn) systemd.UnitJob { return conn.RestartUnitContext })
+}
+
+// quadletUnitJobs runs the job returned by getJob on the systemd service of
+// each Quadlet and waits for it to complete.
+func quadletUnitJobs(ctx context.Context, quadlets []string, action string, getJob func(conn *dbus.Conn) systemd.UnitJob) ([]*entities.QuadletUnitReport, error) {
+	reports := make([]*entities.QuadletUnitReport, 0, len(quadlets))
+	for _, quadlet := range quadlets {
+		report := &entities.QuadletUnitReport{Name: quadlet}
+		reports = append(reports, report)
+		_, report.UnitName, report.Err = getQuadletUnit(quadlet)
+	}
+
+	// Is systemd available to the current user?
+	// We cannot proceed if not.
+	conn, err := systemd.ConnectToDBUS()
+	if err != nil {
+		return nil, fmt.Errorf("connecting to systemd dbus: %w", err)
+	}
+	defer conn.Close()
+
+	for _, report := range reports {
+		if report.Err != nil {
+			continue
+		}
+		quadlet := report.Name
+
+		statuses, err := conn.ListUnitsByNamesContext(ctx, []string{report.UnitName})
+		if err != nil {
+			return nil, fmt.Errorf("querying systemd for unit status: %w", err)
+		}
+		if len(statuses) != 1 || statuses[0].LoadState != "loaded" {
+			report.Err = fmt.Errorf("systemd unit %s of quadlet %s is not loaded, reload systemd to generate it", report.UnitName, quadlet)
+			continue
+		}
+
+		logrus.Infof("Quadlet %s: %s systemd unit %s", quadlet, action, report.UnitName)
+		if err := systemd.RunUnitJob(ctx, getJob(conn), report.UnitName); err != nil {
+			report.Err = fmt.Errorf("%s quadlet %s: %w", action, quadlet, err)
+		}
+	}
+	return reports, nil
+}
+
+// QuadletStatus returns the state of the systemd services of one or more
+// Quadlets.
+func (ic *ContainerEngine) QuadletStatus(ctx context.Context, quadlets []string) ([]*entities.QuadletStatusReport, error) {
+	reports := make([]*entities.QuadletStatusReport, 0, len(quadlets))
+	for _, quadlet := range quadlets {
+		report := &entities.QuadletStatusReport{Name: quadlet}
+		reports = append(reports, report)
+		report.Path, report.UnitName, report.Err = getQuadletUnit(quadlet)
+	}
+
+	// Is systemd available to the current user?
+	// We cannot proceed if not.
+	conn, err := systemd.ConnectToDBUS()
+	if err != nil {
+		return nil, fmt.Errorf("connecting to systemd dbus: %w", err)
+	}
+	defer conn.Close()
+
+	for _, report := range reports {
+		if report.Err != nil {
+			continue
+		}
+		serviceName := report.UnitName
+		props, err := conn.GetUnitPropertiesContext(ctx, serviceName)
+		if err != nil {
+			return nil, fmt.Errorf("querying systemd for status of unit %s: %w", serviceName, err)
+		}
+		report.LoadState, _ = props["LoadState"].(string)
+		report.ActiveState, _ = props["ActiveState"].(string)
+		report.SubState, _ = props["SubState"].(string)
+		if usec, ok := props["StateChangeTimestamp"].(uint64); ok && usec != 0 {
+			report.Since = time.UnixMicro(int64(usec))
+		}
+		if report.LoadState == "loaded" {
+			prop, err := conn.GetUnitTypePropertyContext(ctx, serviceName, "Service", "MainPID")
+			if err != nil {
+				return nil, fmt.Errorf("querying systemd for main PID of unit %s: %w", serviceName, err)
+			}
+			report.MainPID, _ = prop.Value.Value().(uint32)
+		}
+	}
+	return reports, nil
+}
+
+// QuadletLogs writes the journal entries of the systemd service of a Quadlet
+// to options.Writer.
+func (ic *ContainerEngine) QuadletLogs(ctx context.Context, quadlet string, options entities.QuadletLogsOptions) error {
+	_, serviceName, err := getQuadletUnit(quadlet)
+	if err != nil {
+		return err
+	}
+	journalctl, err := exec.LookPath("journalctl")
+	if err != nil {
+		return fmt.Errorf("quadlet logs are read from the systemd journal: %w", err)
+	}
+
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, journalctl, journalctlArgs(serviceName, rootless.IsRootless(), options)...)
+	cmd.Stdout = options.Writer
+	cmd.Stderr = stderr
+	logrus.Debugf("Executing journalctl command: %q", cmd)
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil && options.Follow {
+			// Following ends when the caller goes away.
+			return nil
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("reading journal of unit %s: %s: %w", serviceName, msg, err)
+		}
+		return fmt.Errorf("reading journal of unit %s: %w", serviceName, err)
+	}
+	return nil
+}
+
+// journalctlArgs returns the arguments of journalctl printing the journal
+// entries of the systemd unit with the given name.
+func journalctlArgs(unit string, rootless bool, options entities.QuadletLogsOptions) []string {
+	args := []string{"--no-pager"}
+	if rootless {
+		args = append(args, "--user-unit", unit)
+	} else {
+		args = append(args, "--unit", unit)
+	}
+	if options.Since != "" {
+		args = append(args, "--since", options.Since)
+	}
+	if options.Tail >= 0 {
+		args = append(args, "--lines", strconv.Itoa(options.Tail))
+	}
+	if options.Follow {
+		args = append(args, "--follow")
+	}
+	return args
+}
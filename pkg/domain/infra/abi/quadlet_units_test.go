@@ -0,0 +1,70 @@
+//go:build !remote && (linux || freebsd)
+
+package abi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_journalctlArgs(t *testing.T) {
+	assert.Equal(t, []string{"--no-pager", "--unit", "app.service"},
+		journalctlArgs("app.service", false, entities.QuadletLogsOptions{Tail: -1}))
+	assert.Equal(t, []string{"--no-pager", "--user-unit", "app-pod.service", "--since", "1h ago", "--lines", "10", "--follow"},
+		journalctlArgs("app-pod.service", true, entities.QuadletLogsOptions{Since: "1h ago", Tail: 10, Follow: true}))
+	assert.Equal(t, []string{"--no-pager", "--unit", "app-build.service", "--lines", "0"},
+		journalctlArgs("app-build.service", false, entities.QuadletLogsOptions{Tail: 0}))
+}
+
+func Test_splitQuadletInstance(t *testing.T) {
+	template, instance, ok := splitQuadletInstance("web@3.container")
+	assert.True(t, ok)
+	assert.Equal(t, "web@.container", template)
+	assert.Equal(t, "3", instance)
+
+	template, instance, ok = splitQuadletInstance("data@customer-a.volume")
+	assert.True(t, ok)
+	assert.Equal(t, "data@.volume", template)
+	assert.Equal(t, "customer-a", instance)
+
+	_, _, ok = splitQuadletInstance("web@.container")
+	assert.False(t, ok)
+	_, _, ok = splitQuadletInstance("web.container")
+	assert.False(t, ok)
+}
+
+func Test_instanceServiceName(t *testing.T) {
+	name, err := instanceServiceName("web@.service", "3")
+	assert.NoError(t, err)
+	assert.Equal(t, "web@3.service", name)
+
+	name, err = instanceServiceName("data-volume@.service", "customer-a")
+	assert.NoError(t, err)
+	assert.Equal(t, "data-volume@customer-a.service", name)
+
+	_, err = instanceServiceName("web.service", "3")
+	assert.ErrorContains(t, err, "is not a template")
+}
+
+func Test_getInstanceServiceName(t *testing.T) {
+	dir := t.TempDir()
+	template := filepath.Join(dir, "web@.container")
+	require.NoError(t, os.WriteFile(template, []byte("[Container]\nImage=quay.io/libpod/alpine\nContainerName=web-%i\n"), 0o644))
+	name, err := getInstanceServiceName(template, "3")
+	require.NoError(t, err)
+	assert.Equal(t, "web@3.service", name)
+
+	// Templates with port offsets only generate the services of the
+	// instances with a symlink.
+	offsetTemplate := filepath.Join(dir, "api@.container")
+	require.NoError(t, os.WriteFile(offsetTemplate, []byte("[Container]\nImage=quay.io/libpod/alpine\nPublishPortOffset=%i\nPublishPort=8000:80\n"), 0o644))
+	_, err = getInstanceServiceName(offsetTemplate, "3")
+	assert.ErrorIs(t, err, define.ErrNoSuchQuadlet)
+	assert.ErrorContains(t, err, "api@3.container")
+}
@@ -0,0 +1,245 @@
+//go:build !remote && (linux || freebsd)
+
+package abi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/rootless"
+	"github.com/containers/podman/v6/pkg/systemd/parser"
+	systemdquadlet "github.com/containers/podman/v6/pkg/systemd/quadlet"
+	"github.com/containers/podman/v6/pkg/util"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/sirupsen/logrus"
+)
+
+// QuadletValidate converts the Quadlets at the given paths, files or
+// directories, the same way the generator would if they were installed, and
+// reports the problems found and the differences to the units currently
+// generated from them.
+func (ic *ContainerEngine) QuadletValidate(_ context.Context, paths []string) (*entities.QuadletValidateReport, error) {
+	isUser := rootless.IsRootless()
+	report := &entities.QuadletValidateReport{Problems: []entities.QuadletProblem{}, Units: []entities.QuadletValidateUnit{}}
+
+	addProblem := func(severity, file string, line int, err error) {
+		report.Problems = append(report.Problems, entities.QuadletProblem{
+			Severity: severity,
+			File:     file,
+			Line:     line,
+			Message:  err.Error(),
+		})
+	}
+
+	quadletPaths, err := getValidateQuadletPaths(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	// The Quadlets to validate take the place of installed ones with the same
+	// name, and may refer to the other installed ones.
+	unitDirs := systemdquadlet.GetUnitDirs(isUser)
+	var units []*parser.UnitFile
+	validated := make(map[*parser.UnitFile]bool)
+	seen := make(map[string]bool)
+	for _, quadletPath := range quadletPaths {
+		if seen[filepath.Base(quadletPath)] {
+			continue
+		}
+		seen[filepath.Base(quadletPath)] = true
+
+		unit, err := parser.ParseUnitFile(quadletPath)
+		if err != nil {
+			line := 0
+			var lineErr *parser.LineError
+			if errors.As(err, &lineErr) {
+				line = lineErr.Line
+			}
+			addProblem("error", quadletPath, line, err)
+			continue
+		}
+		sourcePaths := append([]string{filepath.Dir(quadletPath)}, unitDirs...)
+		if err := systemdquadlet.LoadUnitDropins(unit, sourcePaths); err != nil {
+			addProblem("error", quadletPath, 0, err)
+			continue
+		}
+		units = append(units, unit)
+		validated[unit] = true
+	}
+	for _, quadletPath := range getAllQuadletPaths() {
+		if seen[filepath.Base(quadletPath)] {
+			continue
+		}
+		seen[filepath.Base(quadletPath)] = true
+
+		unit, err := parser.ParseUnitFile(quadletPath)
+		if err != nil {
+			logrus.Debugf("Skipping installed Quadlet %s: %v", quadletPath, err)
+			continue
+		}
+		if err := systemdquadlet.LoadUnitDropins(unit, unitDirs); err != nil {
+			logrus.Debugf("Loading drop-ins of installed Quadlet %s: %v", quadletPath, err)
+		}
+		units = append(units, unit)
+	}
+
+	generatorDir, err := getGeneratorDir(isUser)
+	if err != nil {
+		return nil, err
+	}
+
+	systemdquadlet.SortUnits(units)
+	unitsInfoMap := systemdquadlet.GenerateUnitsInfoMap(units)
+	for _, unit := range units {
+		generated, warnings, err := systemdquadlet.ConvertUnit(unit, unitsInfoMap, isUser)
+		if !validated[unit] {
+			continue
+		}
+
+		addKeyProblems := func(severity string, err error) {
+			for _, keyErr := range systemdquadlet.SplitKeyErrors(err) {
+				problem := entities.QuadletProblem{
+					Severity: severity,
+					File:     unit.Path,
+					Group:    keyErr.Group,
+					Key:      keyErr.Key,
+					Message:  keyErr.Error(),
+				}
+				if path, line, ok := unit.LookupLocation(keyErr.Group, keyErr.Key); ok {
+					problem.File = path
+					problem.Line = line
+				}
+				report.Problems = append(report.Problems, problem)
+			}
+		}
+		addKeyProblems("warning", warnings)
+		addKeyProblems("error", err)
+		if err != nil {
+			continue
+		}
+
+		for _, service := range generated {
+			validateUnit, err := diffGeneratedUnit(unit, service, generatorDir)
+			if err != nil {
+				return nil, err
+			}
+			report.Units = append(report.Units, *validateUnit)
+		}
+	}
+
+	return report, nil
+}
+
+// getValidateQuadletPaths returns the paths of the Quadlet files given on
+// the command line, or found in the directories given on it.
+func getValidateQuadletPaths(paths []string) ([]string, error) {
+	var quadletPaths []string
+	for _, p := range paths {
+		p, err := filepath.Abs(p)
+		if err != nil {
+			return nil, err
+		}
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			if !systemdquadlet.IsExtSupported(p) {
+				return nil, fmt.Errorf("%q is not a supported Quadlet file", p)
+			}
+			quadletPaths = append(quadletPaths, p)
+			continue
+		}
+
+		dents, err := os.ReadDir(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, dent := range dents {
+			if systemdquadlet.IsExtSupported(dent.Name()) && !dent.IsDir() {
+				quadletPaths = append(quadletPaths, filepath.Join(p, dent.Name()))
+			}
+		}
+	}
+	return quadletPaths, nil
+}
+
+// getGeneratorDir returns the directory systemd runs the generator with, in
+// which the currently generated units are.
+func getGeneratorDir(isUser bool) (string, error) {
+	if !isUser {
+		return "/run/systemd/generator", nil
+	}
+	runtimeDir, err := util.GetRootlessRuntimeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(runtimeDir, "systemd", "generator"), nil
+}
+
+// diffGeneratedUnit compares a unit generated from a Quadlet being validated
+// to the unit currently generated.
+func diffGeneratedUnit(quadlet, unit *parser.UnitFile, generatorDir string) (*entities.QuadletValidateUnit, error) {
+	content, err := unit.ToString()
+	if err != nil {
+		return nil, fmt.Errorf("generating unit %s: %w", unit.Filename, err)
+	}
+
+	report := &entities.QuadletValidateUnit{
+		Quadlet:  quadlet.Path,
+		UnitName: unit.Filename,
+	}
+
+	fromFile := "/dev/null"
+	current := ""
+	currentPath := filepath.Join(generatorDir, unit.Filename)
+	data, err := os.ReadFile(currentPath)
+	switch {
+	case err == nil:
+		report.Path = currentPath
+		fromFile = currentPath
+		current = stripGeneratorHeader(string(data))
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, fmt.Errorf("reading generated unit: %w", err)
+	}
+
+	if current == content {
+		return report, nil
+	}
+	report.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(current),
+		B:        splitLines(content),
+		FromFile: fromFile,
+		ToFile:   unit.Filename,
+		Context:  3,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("comparing unit %s: %w", unit.Filename, err)
+	}
+	return report, nil
+}
+
+// splitLines splits a file into its lines, keeping the newlines.
+func splitLines(content string) []string {
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// stripGeneratorHeader removes the comment the generator adds at the top of
+// the units it writes.
+func stripGeneratorHeader(content string) string {
+	lines := strings.SplitAfterN(content, "\n", 4)
+	if len(lines) == 4 && lines[0] == "# \n" && strings.HasPrefix(lines[1], "# Automatically generated by ") && lines[2] == "# \n" {
+		return lines[3]
+	}
+	return content
+}
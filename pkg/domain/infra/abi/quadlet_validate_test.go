@@ -0,0 +1,48 @@
+//go:build !remote && (linux || freebsd)
+
+package abi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/podman/v6/pkg/systemd/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_diffGeneratedUnit(t *testing.T) {
+	generatorDir := t.TempDir()
+
+	quadlet := parser.NewUnitFile()
+	quadlet.Path = "/home/user/app/app.container"
+	unit := parser.NewUnitFile()
+	unit.Filename = "app.service"
+	require.NoError(t, unit.Parse("[Service]\nExecStart=/usr/bin/podman run alpine\nRestart=always\n"))
+
+	// Not generated yet
+	report, err := diffGeneratedUnit(quadlet, unit, generatorDir)
+	require.NoError(t, err)
+	assert.Equal(t, quadlet.Path, report.Quadlet)
+	assert.Equal(t, "app.service", report.UnitName)
+	assert.Empty(t, report.Path)
+	assert.Contains(t, report.Diff, "--- /dev/null\n+++ app.service\n")
+	assert.Contains(t, report.Diff, "+Restart=always\n")
+
+	// Generated identically, apart from the header of the generator
+	currentPath := filepath.Join(generatorDir, "app.service")
+	current := "# \n# Automatically generated by /usr/lib/systemd/system-generators/podman-system-generator\n# \n[Service]\nExecStart=/usr/bin/podman run alpine\nRestart=always\n"
+	require.NoError(t, os.WriteFile(currentPath, []byte(current), 0o644))
+	report, err = diffGeneratedUnit(quadlet, unit, generatorDir)
+	require.NoError(t, err)
+	assert.Equal(t, currentPath, report.Path)
+	assert.Empty(t, report.Diff)
+
+	// Changed
+	unit.Set("Service", "Restart", "on-failure")
+	report, err = diffGeneratedUnit(quadlet, unit, generatorDir)
+	require.NoError(t, err)
+	assert.Contains(t, report.Diff, "--- "+currentPath+"\n+++ app.service\n")
+	assert.Contains(t, report.Diff, "-Restart=always\n+Restart=on-failure\n")
+}
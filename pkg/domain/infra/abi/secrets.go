@@ -3,16 +3,24 @@
 package abi
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
+	"github.com/containers/podman/v6/libpod"
+	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/libpod/events"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/containers/podman/v6/pkg/domain/utils"
+	"github.com/containers/podman/v6/pkg/secrets/vaultdriver"
+	"github.com/containers/podman/v6/pkg/signal"
 	"go.podman.io/common/pkg/secrets"
 )
 
@@ -45,6 +53,17 @@ func (ic *ContainerEngine) SecretCreate(_ context.Context, name string, reader i
 			options.DriverOpts["path"] = filepath.Join(secretsPath, "filedriver")
 		}
 	}
+	// The secrets manager runs the vault driver through its shell driver.
+	if options.Driver == vaultdriver.DriverName {
+		podmanPath, err := os.Executable()
+		if err != nil {
+			return nil, err
+		}
+		if options.DriverOpts, err = vaultdriver.ShellOptions(podmanPath, options.DriverOpts); err != nil {
+			return nil, err
+		}
+		options.Driver = "shell"
+	}
 
 	storeOpts := secrets.StoreOptions{
 		DriverOpts:     options.DriverOpts,
@@ -65,6 +84,146 @@ func (ic *ContainerEngine) SecretCreate(_ context.Context, name string, reader i
 	}, nil
 }
 
+func (ic *ContainerEngine) SecretRotate(_ context.Context, nameOrID string, reader io.Reader, options entities.SecretRotateOptions) (*entities.SecretRotateReport, error) {
+	var sig uint
+	if options.Signal != "" {
+		s, err := signal.ParseSignalNameOrNumber(options.Signal)
+		if err != nil {
+			return nil, err
+		}
+		sig = uint(s)
+	}
+	hook, err := parseSecretExecHook(options.ExecHook)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	manager, err := ic.Libpod.SecretsManager()
+	if err != nil {
+		return nil, err
+	}
+	secret, err := manager.Lookup(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The environment of running containers cannot be changed, rotating
+	// a secret they use as environment variable would leave it stale.
+	envCtrs, err := ic.Libpod.GetContainers(false, func(c *libpod.Container) bool {
+		for _, secr := range c.EnvSecrets() {
+			if secr.Name == secret.Name {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, ctr := range envCtrs {
+		state, err := ctr.State()
+		if err != nil {
+			return nil, err
+		}
+		if state == define.ContainerStateRunning || state == define.ContainerStatePaused {
+			return nil, fmt.Errorf("secret %s is used as environment variable by running container %s, stop the container before rotating it: %w", secret.Name, ctr.ID(), define.ErrCtrStateInvalid)
+		}
+	}
+
+	secretID := secret.ID
+	// Secrets referenced by their vault path are rotated on the server,
+	// only the containers need to pick up the new data.
+	vaultOpts, isVault := vaultdriver.FromShellOptions(secret.Driver, secret.DriverOptions)
+	if len(data) > 0 || !isVault || vaultOpts["path"] == "" {
+		storeOpts := secrets.StoreOptions{
+			DriverOpts: secret.DriverOptions,
+			Metadata:   secret.Metadata,
+			Labels:     secret.Labels,
+			Replace:    true,
+		}
+		secretID, err = manager.Store(secret.Name, data, secret.Driver, storeOpts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ic.Libpod.NewSecretEvent(events.SecretRotate, secretID)
+
+	ctrs, err := ic.Libpod.GetContainers(false, func(c *libpod.Container) bool {
+		return slices.ContainsFunc(c.Secrets(), func(secr *libpod.ContainerSecret) bool {
+			return secr.Name == secret.Name
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &entities.SecretRotateReport{
+		ID:         secretID,
+		Containers: make([]entities.SecretRotateContainerReport, 0, len(ctrs)),
+	}
+	for _, ctr := range ctrs {
+		ctrReport := entities.SecretRotateContainerReport{
+			ID:   ctr.ID(),
+			Name: ctr.Name(),
+		}
+		running, err := ctr.UpdateSecret(secret.Name, sig)
+		if err == nil && running && len(hook) > 0 {
+			err = runSecretExecHook(ctr, hook)
+		}
+		ctrReport.Running = running
+		if err != nil {
+			ctrReport.Error = err.Error()
+		}
+		report.Containers = append(report.Containers, ctrReport)
+	}
+	return report, nil
+}
+
+// parseSecretExecHook parses the exec hook of a secret rotation, given either
+// as a JSON array or as a command run by /bin/sh.
+func parseSecretExecHook(hook string) ([]string, error) {
+	hook = strings.TrimSpace(hook)
+	if hook == "" {
+		return nil, nil
+	}
+	if strings.HasPrefix(hook, "[") {
+		var cmd []string
+		if err := json.Unmarshal([]byte(hook), &cmd); err != nil {
+			return nil, fmt.Errorf("parsing exec hook %q: %w", hook, err)
+		}
+		if len(cmd) == 0 || cmd[0] == "" {
+			return nil, fmt.Errorf("exec hook %q has no command", hook)
+		}
+		return cmd, nil
+	}
+	return []string{"/bin/sh", "-c", hook}, nil
+}
+
+// runSecretExecHook runs the exec hook of a secret rotation in a container.
+func runSecretExecHook(ctr *libpod.Container, hook []string) error {
+	output := &bytes.Buffer{}
+	streams := new(define.AttachStreams)
+	streams.OutputStream = output
+	streams.ErrorStream = output
+	streams.AttachOutput = true
+	streams.AttachError = true
+
+	execConfig := new(libpod.ExecConfig)
+	execConfig.Command = hook
+	exitCode, err := ctr.Exec(execConfig, streams, nil)
+	if err != nil {
+		return fmt.Errorf("running exec hook: %w", err)
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("exec hook exited with status %d: %s", exitCode, strings.TrimSpace(output.String()))
+	}
+	return nil
+}
+
 func (ic *ContainerEngine) SecretInspect(_ context.Context, nameOrIDs []string, options entities.SecretInspectOptions) ([]*entities.SecretInfoReport, []error, error) {
 	var (
 		secret *secrets.Secret
@@ -177,6 +336,12 @@ func secretToReport(secret secrets.Secret) *entities.SecretInfoReport {
 }
 
 func secretToReportWithData(secret secrets.Secret, data string) *entities.SecretInfoReport {
+	// Report vault secrets with the options given on creation rather than
+	// the shell driver running the vault driver.
+	if opts, ok := vaultdriver.FromShellOptions(secret.Driver, secret.DriverOptions); ok {
+		secret.Driver = vaultdriver.DriverName
+		secret.DriverOptions = opts
+	}
 	return &entities.SecretInfoReport{
 		ID:        secret.ID,
 		CreatedAt: secret.CreatedAt,
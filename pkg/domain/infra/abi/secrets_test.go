@@ -61,3 +61,28 @@ func Test_secretToReport(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseSecretExecHook(t *testing.T) {
+	tests := []struct {
+		hook    string
+		want    []string
+		wantErr bool
+	}{
+		{hook: "", want: nil},
+		{hook: "nginx -s reload", want: []string{"/bin/sh", "-c", "nginx -s reload"}},
+		{hook: `["nginx", "-s", "reload"]`, want: []string{"nginx", "-s", "reload"}},
+		{hook: `[]`, wantErr: true},
+		{hook: `["nginx"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.hook, func(t *testing.T) {
+			got, err := parseSecretExecHook(tt.hook)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -255,23 +255,37 @@ func (ic *ContainerEngine) SystemDf(ctx context.Context, _ entities.SystemDfOpti
 
 	dfVolumes := make([]*entities.SystemDfVolumeReport, 0, len(vols))
 	for _, v := range vols {
-		var reclaimableSize int64
-		mountPoint, err := v.MountPoint()
+		var reclaimableSize, volSize, limit int64
+		// Volumes with a size limit report their usage without
+		// walking them, even if they are not mounted.
+		usage, err := v.Usage()
 		if err != nil {
-			if errors.Is(err, define.ErrNoSuchVolume) {
+			if errors.Is(err, define.ErrNoSuchVolume) || errors.Is(err, define.ErrVolumeRemoved) {
 				continue
 			}
 			return nil, err
 		}
-		if mountPoint == "" {
-			// We can't get any info on this volume, as it's not
-			// mounted.
-			// TODO: fix this.
-			continue
-		}
-		volSize, err := directory.Size(mountPoint)
-		if err != nil {
-			return nil, err
+		if usage != nil {
+			volSize = int64(usage.Used)
+			limit = int64(usage.Limit)
+		} else {
+			mountPoint, err := v.MountPoint()
+			if err != nil {
+				if errors.Is(err, define.ErrNoSuchVolume) {
+					continue
+				}
+				return nil, err
+			}
+			if mountPoint == "" {
+				// We can't get any info on this volume, as it's not
+				// mounted.
+				// TODO: fix this.
+				continue
+			}
+			volSize, err = directory.Size(mountPoint)
+			if err != nil {
+				return nil, err
+			}
 		}
 		inUse, err := v.VolumeInUse()
 		if err != nil {
@@ -288,6 +302,7 @@ func (ic *ContainerEngine) SystemDf(ctx context.Context, _ entities.SystemDfOpti
 			Links:           len(inUse),
 			Size:            volSize,
 			ReclaimableSize: reclaimableSize,
+			Limit:           limit,
 		}
 		dfVolumes = append(dfVolumes, &report)
 	}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/containers/podman/v6/libpod"
 	"github.com/containers/podman/v6/libpod/define"
@@ -14,7 +15,10 @@ import (
 	"github.com/containers/podman/v6/pkg/domain/entities/reports"
 	"github.com/containers/podman/v6/pkg/domain/filters"
 	"github.com/containers/podman/v6/pkg/domain/infra/abi/parse"
+	domainUtils "github.com/containers/podman/v6/pkg/domain/utils"
 	"github.com/containers/podman/v6/pkg/util"
+	"go.podman.io/common/pkg/libartifact"
+	libartTypes "go.podman.io/common/pkg/libartifact/types"
 )
 
 func (ic *ContainerEngine) VolumeCreate(ctx context.Context, opts entities.VolumeCreateOptions) (*entities.IDOrNameResponse, error) {
@@ -249,7 +253,7 @@ func (ic *ContainerEngine) VolumeReload(ctx context.Context) (*entities.VolumeRe
 	return &entities.VolumeReloadReport{VolumeReload: *report}, nil
 }
 
-func (ic *ContainerEngine) VolumeExport(_ context.Context, nameOrID string, options entities.VolumeExportOptions) error {
+func (ic *ContainerEngine) VolumeExport(ctx context.Context, nameOrID string, options entities.VolumeExportOptions) error {
 	vol, err := ic.Libpod.LookupVolume(nameOrID)
 	if err != nil {
 		return err
@@ -261,22 +265,174 @@ func (ic *ContainerEngine) VolumeExport(_ context.Context, nameOrID string, opti
 	}
 	defer contents.Close()
 
-	if _, err := io.Copy(options.Output, contents); err != nil {
+	if options.Artifact != "" {
+		return ic.volumeExportArtifact(ctx, vol, contents, options)
+	}
+
+	if err := domainUtils.WriteVolumeArchive(options.Output, contents, options.Compression, options.OciEncryptConfig); err != nil {
 		return fmt.Errorf("writing volume %s contents: %w", vol.Name(), err)
 	}
 
 	return nil
 }
 
-func (ic *ContainerEngine) VolumeImport(_ context.Context, nameOrID string, options entities.VolumeImportOptions) error {
+// volumeExportArtifact stores the volume contents as the single blob of an
+// artifact in the local artifact store, replacing an existing artifact of
+// the same name.
+func (ic *ContainerEngine) volumeExportArtifact(ctx context.Context, vol *libpod.Volume, contents io.Reader, options entities.VolumeExportOptions) error {
+	artStore, err := ic.Libpod.ArtifactStore()
+	if err != nil {
+		return err
+	}
+	ref, err := libartifact.NewArtifactReference(options.Artifact)
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(domainUtils.WriteVolumeArchive(pw, contents, options.Compression, options.OciEncryptConfig))
+	}()
+	defer pr.Close()
+
+	blob := entities.ArtifactBlob{BlobReader: pr, FileName: vol.Name() + ".tar"}
+	_, err = artStore.Add(ctx, ref, []entities.ArtifactBlob{blob}, &libartTypes.AddOptions{
+		Annotations:      map[string]string{domainUtils.VolumeNameAnnotation: vol.Name()},
+		ArtifactMIMEType: domainUtils.VolumeArtifactMIMEType,
+		FileMIMEType:     domainUtils.VolumeArchiveMIMEType(options.Compression, options.OciEncryptConfig != nil),
+		Replace:          true,
+	})
+	if err != nil {
+		return fmt.Errorf("exporting volume %s to artifact %s: %w", vol.Name(), options.Artifact, err)
+	}
+	return nil
+}
+
+func (ic *ContainerEngine) VolumeImport(ctx context.Context, nameOrID string, options entities.VolumeImportOptions) error {
 	vol, err := ic.Libpod.LookupVolume(nameOrID)
 	if err != nil {
 		return err
 	}
 
-	if err := vol.Import(options.Input); err != nil {
+	input := options.Input
+	if options.Artifact != "" {
+		blob, err := ic.volumeArtifactBlob(ctx, options.Artifact)
+		if err != nil {
+			return err
+		}
+		defer blob.Close()
+		input = blob
+	}
+
+	contents, err := domainUtils.ReadVolumeArchive(input, options.OciDecryptConfig)
+	if err != nil {
+		return err
+	}
+	if err := vol.Import(contents); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// volumeArtifactBlob opens the blob of a volume export in the local artifact
+// store.
+func (ic *ContainerEngine) volumeArtifactBlob(ctx context.Context, name string) (*os.File, error) {
+	artStore, err := ic.Libpod.ArtifactStore()
+	if err != nil {
+		return nil, err
+	}
+	asr, err := libartifact.NewArtifactStorageReference(name)
+	if err != nil {
+		return nil, err
+	}
+	art, err := artStore.Inspect(ctx, asr)
+	if err != nil {
+		return nil, err
+	}
+	if art.Manifest.ArtifactType != domainUtils.VolumeArtifactMIMEType {
+		return nil, fmt.Errorf("artifact %s is not a volume export", name)
+	}
+	paths, err := artStore.BlobMountPaths(ctx, asr, &libartTypes.BlobMountPathOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) != 1 {
+		return nil, fmt.Errorf("volume artifact %s must contain exactly one blob, found %d", name, len(paths))
+	}
+	return os.Open(paths[0].SourcePath)
+}
+
+func (ic *ContainerEngine) VolumeSnapshotCreate(_ context.Context, nameOrID, snapshot string) (*entities.VolumeSnapshotReport, error) {
+	vol, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := vol.CreateSnapshot(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return volumeSnapshotReport(vol, snap), nil
+}
+
+func (ic *ContainerEngine) VolumeSnapshotList(_ context.Context, namesOrIDs []string) ([]*entities.VolumeSnapshotReport, error) {
+	var vols []*libpod.Volume
+	if len(namesOrIDs) == 0 {
+		localVols, err := ic.Libpod.Volumes(func(v *libpod.Volume) bool {
+			return v.Driver() == define.VolumeDriverLocal
+		})
+		if err != nil {
+			return nil, err
+		}
+		vols = localVols
+	} else {
+		for _, nameOrID := range namesOrIDs {
+			vol, err := ic.Libpod.LookupVolume(nameOrID)
+			if err != nil {
+				return nil, err
+			}
+			vols = append(vols, vol)
+		}
+	}
+
+	reports := make([]*entities.VolumeSnapshotReport, 0, len(vols))
+	for _, vol := range vols {
+		snaps, err := vol.Snapshots()
+		if err != nil {
+			// The volume may have been removed in the meantime.
+			if len(namesOrIDs) == 0 && errors.Is(err, define.ErrVolumeRemoved) {
+				continue
+			}
+			return nil, err
+		}
+		for i := range snaps {
+			reports = append(reports, volumeSnapshotReport(vol, &snaps[i]))
+		}
+	}
+	return reports, nil
+}
+
+func (ic *ContainerEngine) VolumeSnapshotRestore(_ context.Context, nameOrID, snapshot string) error {
+	vol, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return err
+	}
+	return vol.RestoreSnapshot(snapshot)
+}
+
+func (ic *ContainerEngine) VolumeSnapshotRm(_ context.Context, nameOrID, snapshot string) error {
+	vol, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return err
+	}
+	return vol.RemoveSnapshot(snapshot)
+}
+
+func volumeSnapshotReport(vol *libpod.Volume, snap *libpod.VolumeSnapshot) *entities.VolumeSnapshotReport {
+	return &entities.VolumeSnapshotReport{
+		Volume:  vol.Name(),
+		Name:    snap.Name,
+		Created: snap.CreatedTime,
+		Size:    snap.Size,
+	}
+}
@@ -456,8 +456,12 @@ func (ic *ContainerEngine) ContainerCheckpoint(_ context.Context, namesOrIds []s
 	return reports, nil
 }
 
+func (ic *ContainerEngine) ContainerMigrate(_ context.Context, _ string, _ entities.ContainerMigrateOptions) (*entities.ContainerMigrateReport, error) {
+	return nil, errors.New("migrating containers is not supported on remote clients")
+}
+
 func (ic *ContainerEngine) ContainerRestore(_ context.Context, namesOrIds []string, opts entities.RestoreOptions) ([]*entities.RestoreReport, error) {
-	if opts.ImportPrevious != "" {
+	if len(opts.ImportPrevious) > 0 {
 		return nil, fmt.Errorf("--import-previous is not supported on the remote client")
 	}
 
@@ -994,6 +998,14 @@ func (ic *ContainerEngine) ContainerCleanup(_ context.Context, _ []string, _ ent
 	return nil, errors.New("not implemented")
 }
 
+func (ic *ContainerEngine) ContainerRotateLog(_ context.Context, _ string, _ entities.ContainerRotateLogOptions) error {
+	return errors.New("rotating container logs is not supported on remote clients")
+}
+
+func (ic *ContainerEngine) ContainerShipLogs(_ context.Context, _ string) error {
+	return errors.New("shipping container logs is not supported on remote clients")
+}
+
 func (ic *ContainerEngine) ContainerInit(_ context.Context, namesOrIds []string, options entities.ContainerInitOptions) ([]*entities.ContainerInitReport, error) {
 	ctrs, rawInputs, err := getContainersAndInputByContext(ic.ClientCtx, options.All, false, namesOrIds, nil)
 	if err != nil {
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/pkg/bindings/containers"
 	"github.com/containers/podman/v6/pkg/bindings/system"
 	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/containers/podman/v6/pkg/domain/utils"
 )
 
 const (
@@ -34,15 +37,41 @@ func (ir *ImageEngine) fetchInfo(_ context.Context) (os, arch, variant string, n
 	return engineInfo.Host.OS, engineInfo.Host.Arch, engineInfo.Host.Variant, []string{nativePlatform}, nil
 }
 
+// fetchLoad returns the current load of the remote engine.
+func (ir *ImageEngine) fetchLoad(ctx context.Context) (cpus int, cpuUtilization float64, runningBuilds int, err error) {
+	cpus, cpuUtilization, err = utils.FarmNodeLoad(ctx, func() (*define.Info, error) {
+		return system.Info(ir.ClientCtx, &system.InfoOptions{})
+	})
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("retrieving host info from %q: %w", ir.NodeName, err)
+	}
+	ctrs, err := containers.List(ir.ClientCtx, new(containers.ListOptions).WithAll(true).WithExternal(true))
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("listing build containers on %q: %w", ir.NodeName, err)
+	}
+	return cpus, cpuUtilization, utils.CountBuildContainers(ctrs), nil
+}
+
 // FarmNodeInspect returns information about the remote engines in the farm
 func (ir *ImageEngine) FarmNodeInspect(ctx context.Context) (*entities.FarmInspectReport, error) {
 	ir.platforms.Do(func() {
 		ir.os, ir.arch, ir.variant, ir.nativePlatforms, ir.platformsErr = ir.fetchInfo(ctx)
 	})
+	if ir.platformsErr != nil {
+		return nil, ir.platformsErr
+	}
+	// The load changes all the time, do not cache it.
+	cpus, cpuUtilization, runningBuilds, err := ir.fetchLoad(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return &entities.FarmInspectReport{
 		NativePlatforms: ir.nativePlatforms,
 		OS:              ir.os,
 		Arch:            ir.arch,
 		Variant:         ir.variant,
-	}, ir.platformsErr
+		CPUs:            cpus,
+		CPUUtilization:  cpuUtilization,
+		RunningBuilds:   runningBuilds,
+	}, nil
 }
@@ -2,12 +2,16 @@ package tunnel
 
 import (
 	"context"
+	"errors"
 
 	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/pkg/bindings/containers"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 )
 
-func (ic *ContainerEngine) HealthCheckRun(_ context.Context, nameOrID string, _ entities.HealthCheckOptions) (*define.HealthCheckResults, error) {
+func (ic *ContainerEngine) HealthCheckRun(_ context.Context, nameOrID string, options entities.HealthCheckOptions) (*define.HealthCheckResults, error) {
+	if options.Readiness {
+		return nil, errors.New("running readiness checks is not supported on remote clients")
+	}
 	return containers.RunHealthCheck(ic.ClientCtx, nameOrID, nil)
 }
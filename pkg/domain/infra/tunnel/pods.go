@@ -203,6 +203,14 @@ func (ic *ContainerEngine) PodCreate(_ context.Context, specg entities.PodSpec)
 	return pods.CreatePodFromSpec(ic.ClientCtx, &specg)
 }
 
+func (ic *ContainerEngine) PodCheckpoint(_ context.Context, _ string, _ entities.PodCheckpointOptions) (*entities.PodCheckpointReport, error) {
+	return nil, errors.New("checkpointing pods is not supported on remote clients")
+}
+
+func (ic *ContainerEngine) PodRestore(_ context.Context, _ entities.PodRestoreOptions) (*entities.PodRestoreReport, error) {
+	return nil, errors.New("restoring pods is not supported on remote clients")
+}
+
 func (ic *ContainerEngine) PodClone(_ context.Context, _ entities.PodCloneOptions) (*entities.PodCloneReport, error) {
 	return nil, nil
 }
@@ -3,7 +3,9 @@ package tunnel
 import (
 	"context"
 	"errors"
+	"strconv"
 
+	"github.com/containers/podman/v6/pkg/bindings/quadlets"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 )
 
@@ -28,3 +30,44 @@ func (ic *ContainerEngine) QuadletPrint(_ context.Context, _ string) (string, er
 func (ic *ContainerEngine) QuadletRemove(_ context.Context, _ []string, _ entities.QuadletRemoveOptions) (*entities.QuadletRemoveReport, error) {
 	return nil, errNotImplemented
 }
+
+func (ic *ContainerEngine) QuadletStart(_ context.Context, names []string) ([]*entities.QuadletUnitReport, error) {
+	return quadletUnitActions(ic.ClientCtx, names, quadlets.Start), nil
+}
+
+func (ic *ContainerEngine) QuadletStop(_ context.Context, names []string) ([]*entities.QuadletUnitReport, error) {
+	return quadletUnitActions(ic.ClientCtx, names, quadlets.Stop), nil
+}
+
+func (ic *ContainerEngine) QuadletRestart(_ context.Context, names []string) ([]*entities.QuadletUnitReport, error) {
+	return quadletUnitActions(ic.ClientCtx, names, quadlets.Restart), nil
+}
+
+func quadletUnitActions(ctx context.Context, names []string, action func(ctx context.Context, name string) error) []*entities.QuadletUnitReport {
+	reports := make([]*entities.QuadletUnitReport, 0, len(names))
+	for _, name := range names {
+		reports = append(reports, &entities.QuadletUnitReport{Name: name, Err: action(ctx, name)})
+	}
+	return reports
+}
+
+func (ic *ContainerEngine) QuadletStatus(_ context.Context, names []string) ([]*entities.QuadletStatusReport, error) {
+	reports := make([]*entities.QuadletStatusReport, 0, len(names))
+	for _, name := range names {
+		report, err := quadlets.Status(ic.ClientCtx, name)
+		if err != nil {
+			report = &entities.QuadletStatusReport{Name: name, Err: err}
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func (ic *ContainerEngine) QuadletLogs(_ context.Context, name string, options entities.QuadletLogsOptions) error {
+	logsOptions := new(quadlets.LogsOptions).WithFollow(options.Follow).WithSince(options.Since).WithTail(strconv.Itoa(options.Tail))
+	return quadlets.Logs(ic.ClientCtx, name, logsOptions, options.Writer)
+}
+
+func (ic *ContainerEngine) QuadletValidate(_ context.Context, _ []string) (*entities.QuadletValidateReport, error) {
+	return nil, errNotImplemented
+}
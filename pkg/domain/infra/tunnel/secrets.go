@@ -25,6 +25,17 @@ func (ic *ContainerEngine) SecretCreate(_ context.Context, name string, reader i
 	return created, nil
 }
 
+func (ic *ContainerEngine) SecretRotate(_ context.Context, nameOrID string, reader io.Reader, options entities.SecretRotateOptions) (*entities.SecretRotateReport, error) {
+	opts := new(secrets.RotateOptions)
+	if options.Signal != "" {
+		opts.WithSignal(options.Signal)
+	}
+	if options.ExecHook != "" {
+		opts.WithExec(options.ExecHook)
+	}
+	return secrets.Rotate(ic.ClientCtx, nameOrID, reader, opts)
+}
+
 func (ic *ContainerEngine) SecretInspect(_ context.Context, nameOrIDs []string, options entities.SecretInspectOptions) ([]*entities.SecretInfoReport, []error, error) {
 	allInspect := make([]*entities.SecretInfoReport, 0, len(nameOrIDs))
 	errs := make([]error, 0, len(nameOrIDs))
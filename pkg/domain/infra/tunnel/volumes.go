@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/containers/podman/v6/pkg/bindings/volumes"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/containers/podman/v6/pkg/domain/entities/reports"
+	"github.com/containers/podman/v6/pkg/domain/utils"
 	"github.com/containers/podman/v6/pkg/errorhandling"
+	"go.podman.io/storage/pkg/archive"
 )
 
 func (ic *ContainerEngine) VolumeCreate(_ context.Context, opts entities.VolumeCreateOptions) (*entities.IDOrNameResponse, error) {
@@ -115,9 +118,46 @@ func (ic *ContainerEngine) VolumeReload(_ context.Context) (*entities.VolumeRelo
 }
 
 func (ic *ContainerEngine) VolumeExport(_ context.Context, nameOrID string, options entities.VolumeExportOptions) error {
-	return volumes.Export(ic.ClientCtx, nameOrID, options.Output)
+	if options.Artifact != "" {
+		return errors.New("exporting volumes to artifacts is not supported for remote clients")
+	}
+	if options.Compression == archive.Uncompressed && options.OciEncryptConfig == nil {
+		return volumes.Export(ic.ClientCtx, nameOrID, options.Output)
+	}
+
+	// The plain tar is streamed from the server, compression and
+	// encryption happen on the client.
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(volumes.Export(ic.ClientCtx, nameOrID, pw))
+	}()
+	defer pr.Close()
+	return utils.WriteVolumeArchive(options.Output, pr, options.Compression, options.OciEncryptConfig)
 }
 
 func (ic *ContainerEngine) VolumeImport(_ context.Context, nameOrID string, options entities.VolumeImportOptions) error {
-	return volumes.Import(ic.ClientCtx, nameOrID, options.Input)
+	if options.Artifact != "" {
+		return errors.New("importing volumes from artifacts is not supported for remote clients")
+	}
+	contents, err := utils.ReadVolumeArchive(options.Input, options.OciDecryptConfig)
+	if err != nil {
+		return err
+	}
+	return volumes.Import(ic.ClientCtx, nameOrID, contents)
+}
+
+func (ic *ContainerEngine) VolumeSnapshotCreate(_ context.Context, _, _ string) (*entities.VolumeSnapshotReport, error) {
+	return nil, errors.New("volume snapshots are not supported for remote clients")
+}
+
+func (ic *ContainerEngine) VolumeSnapshotList(_ context.Context, _ []string) ([]*entities.VolumeSnapshotReport, error) {
+	return nil, errors.New("volume snapshots are not supported for remote clients")
+}
+
+func (ic *ContainerEngine) VolumeSnapshotRestore(_ context.Context, _, _ string) error {
+	return errors.New("volume snapshots are not supported for remote clients")
+}
+
+func (ic *ContainerEngine) VolumeSnapshotRm(_ context.Context, _, _ string) error {
+	return errors.New("volume snapshots are not supported for remote clients")
 }
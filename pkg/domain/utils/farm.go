@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+)
+
+// farmLoadInterval is the interval between the two samples of the CPU usage
+// of a farm node.
+const farmLoadInterval = 500 * time.Millisecond
+
+// FarmNodeLoad returns the number of CPUs of a farm node and the percentage of
+// CPU time it spent busy recently. It calls info twice, farmLoadInterval
+// apart, to measure the usage over that interval. Engines which do not report
+// CPU ticks only give the usage since boot.
+func FarmNodeLoad(ctx context.Context, info func() (*define.Info, error)) (cpus int, cpuUtilization float64, err error) {
+	before, err := info()
+	if err != nil {
+		return 0, 0, err
+	}
+	if before.Host.CPUUtilization == nil {
+		return before.Host.CPUs, 0, nil
+	}
+	if before.Host.CPUUtilization.IdleTicks == 0 {
+		return before.Host.CPUs, 100 - before.Host.CPUUtilization.IdlePercent, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return 0, 0, context.Cause(ctx)
+	case <-time.After(farmLoadInterval):
+	}
+	after, err := info()
+	if err != nil {
+		return 0, 0, err
+	}
+	if after.Host.CPUUtilization == nil {
+		return after.Host.CPUs, 0, nil
+	}
+	return after.Host.CPUs, CPUBusyPercent(before.Host.CPUUtilization, after.Host.CPUUtilization), nil
+}
+
+// CPUBusyPercent returns the percentage of CPU time spent busy between the
+// samples before and after. The usage since boot of after is returned if no
+// ticks passed between them.
+func CPUBusyPercent(before, after *define.CPUUsage) float64 {
+	ticks := func(u *define.CPUUsage) uint64 {
+		return u.UserTicks + u.SystemTicks + u.IdleTicks
+	}
+	if ticks(after) <= ticks(before) || after.IdleTicks < before.IdleTicks {
+		return 100 - after.IdlePercent
+	}
+	total := float64(ticks(after) - ticks(before))
+	idle := float64(after.IdleTicks - before.IdleTicks)
+	return 100 - idle/total*100
+}
+
+// CountBuildContainers returns the number of build containers in ctrs. Builds
+// in progress show up as external containers created by buildah.
+func CountBuildContainers(ctrs []entities.ListContainer) int {
+	builds := 0
+	for _, ctr := range ctrs {
+		if ctr.State == "storage" && slices.Equal(ctr.Command, []string{"buildah"}) {
+			builds++
+		}
+	}
+	return builds
+}
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPUBusyPercent(t *testing.T) {
+	before := &define.CPUUsage{IdlePercent: 90, UserTicks: 100, SystemTicks: 100, IdleTicks: 1800}
+	// 150 of the 200 ticks in between were busy.
+	after := &define.CPUUsage{IdlePercent: 88, UserTicks: 200, SystemTicks: 150, IdleTicks: 1850}
+	assert.InDelta(t, 75, CPUBusyPercent(before, after), 0.001)
+
+	// Without ticks in between the usage since boot is used.
+	assert.InDelta(t, 10, CPUBusyPercent(before, before), 0.001)
+}
+
+func TestFarmNodeLoad(t *testing.T) {
+	samples := []*define.CPUUsage{
+		{IdlePercent: 90, UserTicks: 100, SystemTicks: 100, IdleTicks: 1800},
+		{IdlePercent: 88, UserTicks: 200, SystemTicks: 150, IdleTicks: 1850},
+	}
+	calls := 0
+	info := func() (*define.Info, error) {
+		calls++
+		return &define.Info{Host: &define.HostInfo{CPUs: 4, CPUUtilization: samples[calls-1]}}, nil
+	}
+	cpus, cpuUtilization, err := FarmNodeLoad(context.Background(), info)
+	require.NoError(t, err)
+	assert.Equal(t, 4, cpus)
+	assert.InDelta(t, 75, cpuUtilization, 0.001)
+	assert.Equal(t, 2, calls)
+
+	// Engines without ticks are sampled once.
+	calls = 0
+	samples = []*define.CPUUsage{{IdlePercent: 60}}
+	_, cpuUtilization, err = FarmNodeLoad(context.Background(), info)
+	require.NoError(t, err)
+	assert.InDelta(t, 40, cpuUtilization, 0.001)
+	assert.Equal(t, 1, calls)
+}
+
+func TestCountBuildContainers(t *testing.T) {
+	ctrs := []entities.ListContainer{
+		{State: "running", Command: []string{"buildah"}},
+		{State: "storage", Command: []string{"buildah"}},
+		{State: "storage", Command: []string{"storage"}},
+		{State: "storage", Command: []string{"buildah"}},
+	}
+	assert.Equal(t, 2, CountBuildContainers(ctrs))
+}
@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/common/pkg/config"
+	"go.podman.io/common/pkg/ssh"
+)
+
+// MigrationDestination is the host a container is migrated to.  Checkpoint
+// archives are copied into a temporary directory on the host and restored
+// from there.
+type MigrationDestination struct {
+	url      *url.URL
+	identity string
+	port     int
+	mode     ssh.EngineMode
+	dir      string
+}
+
+// NewMigrationDestination connects to the host of the given system connection
+// or [user@]host and creates the temporary directory for the checkpoint
+// archives on it.
+func NewMigrationDestination(connection string, mode ssh.EngineMode) (*MigrationDestination, error) {
+	cfg, err := config.Default()
+	if err != nil {
+		return nil, err
+	}
+	sshInfo := entities.ImageScpConnections{}
+	if err := GetServiceInformation(&sshInfo, []string{connection}, cfg); err != nil {
+		return nil, err
+	}
+
+	dest := &MigrationDestination{
+		url:      sshInfo.URI[0],
+		identity: sshInfo.Identities[0],
+		mode:     mode,
+	}
+	if urlPort := dest.url.Port(); urlPort != "" {
+		if dest.port, err = strconv.Atoi(urlPort); err != nil {
+			return nil, err
+		}
+	}
+
+	out, err := dest.exec(nil, "mktemp", "-d")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary directory on %s: %w", dest.url.Hostname(), err)
+	}
+	dest.dir = strings.TrimSpace(out)
+	return dest, nil
+}
+
+// exec runs the command on the destination with the optional input as stdin.
+func (d *MigrationDestination) exec(input io.Reader, args ...string) (string, error) {
+	return ssh.ExecWithInput(&ssh.ConnectionExecOptions{Host: d.url.String(), Identity: d.identity, Port: d.port, User: d.url.User, Args: args}, d.mode, input)
+}
+
+// Send copies the local file to the temporary directory on the destination.
+func (d *MigrationDestination) Send(name, localFile string) error {
+	input, err := os.Open(localFile)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	logrus.Debugf("Sending %s to %s", localFile, d.url.Hostname())
+	if _, err := d.exec(input, "cat", ">", path.Join(d.dir, name)); err != nil {
+		return fmt.Errorf("sending %s to %s: %w", name, d.url.Hostname(), err)
+	}
+	return nil
+}
+
+// Restore restores the container from the checkpoint archive and the
+// pre-checkpoint archives previously sent to the destination and returns the
+// ID of the restored container.
+func (d *MigrationDestination) Restore(checkpoint string, previous []string, extraArgs ...string) (string, error) {
+	args := []string{"podman", "container", "restore", "--import", path.Join(d.dir, checkpoint)}
+	for _, name := range previous {
+		args = append(args, "--import-previous", path.Join(d.dir, name))
+	}
+	args = append(args, extraArgs...)
+	out, err := d.exec(nil, args...)
+	if err != nil {
+		return "", fmt.Errorf("restoring container on %s: %w", d.url.Hostname(), err)
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// Remove removes the temporary directory on the destination.
+func (d *MigrationDestination) Remove() {
+	if _, err := d.exec(nil, "rm", "-rf", d.dir); err != nil {
+		logrus.Errorf("Removing %s on %s: %v", d.dir, d.url.Hostname(), err)
+	}
+}
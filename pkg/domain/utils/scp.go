@@ -2,18 +2,17 @@ package utils
 
 import (
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"os/exec"
 	"os/user"
-	"strconv"
 	"strings"
 
 	"github.com/containers/podman/v6/libpod/define"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/sirupsen/logrus"
 	"go.podman.io/common/pkg/config"
-	"go.podman.io/common/pkg/ssh"
 	"go.podman.io/image/v5/transports/alltransports"
 )
 
@@ -28,11 +27,6 @@ func ExecuteTransfer(src, dst string, opts entities.ScpExecuteTransferOptions) (
 		return nil, err
 	}
 
-	f, err := os.CreateTemp("", "podman") // open temp file for load/save output
-	if err != nil {
-		return nil, err
-	}
-
 	locations := []*entities.ScpTransferImageOptions{}
 	cliConnections := []string{}
 	args := []string{src}
@@ -64,9 +58,6 @@ func ExecuteTransfer(src, dst string, opts entities.ScpExecuteTransferOptions) (
 	}
 
 	source.Quiet = opts.Quiet
-	source.File = f.Name() // after parsing the arguments, set the file for the save/load
-	dest.File = source.File
-	defer os.Remove(source.File)
 
 	allLocal := true // if we are all localhost, do not validate connections but if we are using one localhost and one non we need to use sshd
 	for _, val := range cliConnections {
@@ -89,88 +80,45 @@ func ExecuteTransfer(src, dst string, opts entities.ScpExecuteTransferOptions) (
 	}
 
 	switch {
-	case source.Remote: // if we want to load FROM the remote, dest can either be local or remote in this case
-		saveToRemoteOpts := entities.ScpSaveToRemoteOptions{}
-		saveToRemoteOpts.Image = source.Image
-		saveToRemoteOpts.LocalFile = source.File
-		saveToRemoteOpts.Tag = ""
-		saveToRemoteOpts.URL = sshInfo.URI[0]
-		saveToRemoteOpts.Iden = sshInfo.Identities[0]
-		saveToRemoteOpts.SSHMode = opts.SSHMode
-		saveToRemoteOpts.Format = opts.SaveFormat
-		_, err = SaveToRemote(saveToRemoteOpts)
-		if err != nil {
-			return nil, err
-		}
-		if dest.Remote { // we want to load remote -> remote, both source and dest are remote
-			loadToRemoteOpts := entities.ScpLoadToRemoteOptions{}
-			loadToRemoteOpts.Dest = dest
-			loadToRemoteOpts.LocalFile = dest.File
-			loadToRemoteOpts.Tag = ""
-			loadToRemoteOpts.URL = sshInfo.URI[1]
-			loadToRemoteOpts.Iden = sshInfo.Identities[1]
-			loadToRemoteOpts.SSHMode = opts.SSHMode
-			loadToRemoteRep, err := LoadToRemote(loadToRemoteOpts)
-			if err != nil {
-				return nil, err
-			}
-			if len(loadToRemoteRep.Response) > 0 {
-				fmt.Println(loadToRemoteRep.Response)
+	case source.Remote || dest.Remote: // stream the image from the source to the destination, at least one of them is remote
+		hosts := make([]*scpHost, 0, 2)
+		remote := 0
+		for _, loc := range []*entities.ScpTransferImageOptions{&source, &dest} {
+			host := &scpHost{Podman: podman, ParentFlags: opts.ParentFlags, SSHMode: opts.SSHMode, SSHConfig: cfg.Engine.SSHConfig}
+			if loc.Remote {
+				host.URL = sshInfo.URI[remote]
+				host.Identity = sshInfo.Identities[remote]
+				remote++
 			}
-			if len(loadToRemoteRep.ID) > 0 {
-				loadReport.Names = append(loadReport.Names, loadToRemoteRep.ID)
-			}
-			break
+			hosts = append(hosts, host)
 		}
-		loadCmd := []string{podman}
-		loadCmd = append(loadCmd, opts.ParentFlags...)
-		loadCmd = append(loadCmd, "load")
-		if source.Quiet {
-			loadCmd = append(loadCmd, "-q")
-		}
-		loadCmd = append(loadCmd, "--input", dest.File)
-		id, err := ExecPodman(dest, podman, loadCmd)
-		if err != nil {
-			return nil, err
-		}
-		if len(id) > 0 {
-			loadReport.Names = append(loadReport.Names, id)
-		}
-	case dest.Remote: // remote host load, implies source is local
-		saveCmd := []string{podman}
-		saveCmd = append(saveCmd, opts.ParentFlags...)
-		saveCmd = append(saveCmd, "save")
-		if opts.SaveFormat != "" {
-			saveCmd = append(saveCmd, "--format", opts.SaveFormat)
+
+		// Only OCI archives allow leaving out the layers the destination
+		// has already.
+		format := opts.SaveFormat
+		if format == "" {
+			format = define.V2s2Archive
 		}
-		if source.Quiet {
-			saveCmd = append(saveCmd, "-q")
+		var progress io.Writer
+		if !opts.Quiet {
+			progress = os.Stderr
 		}
-		saveCmd = append(saveCmd, "--output", source.File, source.Image)
-		_, err = ExecPodman(dest, podman, saveCmd)
+		out, err := streamTransfer(hosts[0], hosts[1], source.Image, format, progress)
 		if err != nil {
 			return nil, err
 		}
-
-		loadToRemoteOpts := entities.ScpLoadToRemoteOptions{}
-		loadToRemoteOpts.Dest = dest
-		loadToRemoteOpts.LocalFile = source.File
-		loadToRemoteOpts.Tag = ""
-		loadToRemoteOpts.URL = sshInfo.URI[0]
-		loadToRemoteOpts.Iden = sshInfo.Identities[0]
-		loadToRemoteOpts.SSHMode = opts.SSHMode
-		loadToRemoteRep, err := LoadToRemote(loadToRemoteOpts)
-		if err != nil {
-			return nil, err
-		}
-		if len(loadToRemoteRep.Response) > 0 {
-			fmt.Println(loadToRemoteRep.Response)
+		if len(out) > 0 {
+			fmt.Println(out)
 		}
-		if len(loadToRemoteRep.ID) > 0 {
-			loadReport.Names = append(loadReport.Names, loadToRemoteRep.ID)
+		outArr := strings.Split(out, " ")
+		id := outArr[len(outArr)-1]
+		if len(dest.Tag) > 0 { // tag the image on the destination using the loaded name
+			if _, err := hosts[1].output("image", "tag", id, dest.Tag); err != nil {
+				return nil, err
+			}
 		}
-		if err = os.Remove(source.File); err != nil {
-			return nil, err
+		if len(id) > 0 {
+			loadReport.Names = append(loadReport.Names, id)
 		}
 	default: // else native load, both source and dest are local and transferring between users
 		if source.User == "" { // source user has to be set, destination does not
@@ -183,6 +131,14 @@ func ExecuteTransfer(src, dst string, opts entities.ScpExecuteTransferOptions) (
 				source.User = u.Username
 			}
 		}
+		f, err := os.CreateTemp("", "podman") // open temp file for load/save output
+		if err != nil {
+			return nil, err
+		}
+		f.Close()
+		source.File = f.Name() // after parsing the arguments, set the file for the save/load
+		dest.File = source.File
+		defer os.Remove(source.File)
 		rep := entities.ScpExecuteTransferReport{}
 		rep.Source = &source
 		rep.Dest = &dest
@@ -245,113 +201,6 @@ func LoginUser(user string) (*exec.Cmd, error) {
 	return cmd, err
 }
 
-// LoadToRemote takes image and remote connection information. it connects to the specified client
-// and copies the saved image dir over to the remote host and then loads it onto the machine
-// returns a report containing ssh response string and the id of the loaded image, or an error
-func LoadToRemote(opts entities.ScpLoadToRemoteOptions) (*entities.ScpLoadToRemoteReport, error) {
-	port := 0
-	urlPort := opts.URL.Port()
-	if urlPort != "" {
-		var err error
-		port, err = strconv.Atoi(opts.URL.Port())
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	input, err := os.Open(opts.LocalFile)
-	if err != nil {
-		return nil, err
-	}
-	defer input.Close()
-
-	out, err := ssh.ExecWithInput(&ssh.ConnectionExecOptions{Host: opts.URL.String(), Identity: opts.Iden, Port: port, User: opts.URL.User, Args: []string{"podman", "image", "load"}}, opts.SSHMode, input)
-	if err != nil {
-		return nil, err
-	}
-	if opts.Tag != "" {
-		return nil, fmt.Errorf("renaming of an image is currently not supported: %w", define.ErrInvalidArg)
-	}
-	rep := strings.TrimSuffix(out, "\n")
-	outArr := strings.Split(rep, " ")
-	id := outArr[len(outArr)-1]
-	if len(opts.Dest.Tag) > 0 { // tag the remote image using the output ID
-		_, err := ssh.Exec(&ssh.ConnectionExecOptions{Host: opts.URL.String(), Identity: opts.Iden, Port: port, User: opts.URL.User, Args: []string{"podman", "image", "tag", id, opts.Dest.Tag}}, opts.SSHMode)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return &entities.ScpLoadToRemoteReport{Response: rep, ID: id}, nil
-}
-
-// SaveToRemote takes image information and remote connection information. it connects to the specified client
-// and saves the specified image on the remote machine and then copies it to the specified local location
-// returns an error if one occurs.
-func SaveToRemote(opts entities.ScpSaveToRemoteOptions) (*entities.ScpSaveToRemoteReport, error) {
-	if opts.Tag != "" {
-		return nil, fmt.Errorf("renaming of an image is currently not supported: %w", define.ErrInvalidArg)
-	}
-
-	port := 0
-	urlPort := opts.URL.Port()
-	if urlPort != "" {
-		var err error
-		port, err = strconv.Atoi(opts.URL.Port())
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	remoteFile, err := ssh.Exec(&ssh.ConnectionExecOptions{Host: opts.URL.String(), Identity: opts.Iden, Port: port, User: opts.URL.User, Args: []string{"mktemp"}}, opts.SSHMode)
-	if err != nil {
-		return nil, err
-	}
-
-	saveArgs := []string{"podman", "image", "save", opts.Image}
-	if opts.Format != "" {
-		saveArgs = append(saveArgs, "--format", opts.Format)
-	}
-
-	saveArgs = append(saveArgs, "--output", remoteFile)
-
-	_, err = ssh.Exec(&ssh.ConnectionExecOptions{Host: opts.URL.String(), Identity: opts.Iden, Port: port, User: opts.URL.User, Args: saveArgs}, opts.SSHMode)
-	if err != nil {
-		return nil, err
-	}
-
-	scpConnOpts := ssh.ConnectionScpOptions{User: opts.URL.User, Identity: opts.Iden, Port: port, Source: "ssh://" + opts.URL.User.String() + "@" + opts.URL.Hostname() + ":" + remoteFile, Destination: opts.LocalFile}
-	scpRep, err := ssh.Scp(&scpConnOpts, opts.SSHMode)
-	if err != nil {
-		return nil, err
-	}
-	_, err = ssh.Exec(&ssh.ConnectionExecOptions{Host: opts.URL.String(), Identity: opts.Iden, Port: port, User: opts.URL.User, Args: []string{"rm", scpRep}}, opts.SSHMode)
-	if err != nil {
-		logrus.Errorf("Removing file on endpoint: %v", err)
-	}
-
-	return &entities.ScpSaveToRemoteReport{}, nil
-}
-
-// execPodman executes the podman save/load command given the podman binary
-func ExecPodman(dest entities.ScpTransferImageOptions, podman string, command []string) (string, error) {
-	cmd := exec.Command(podman)
-	CreateSCPCommand(cmd, command[1:])
-	logrus.Debugf("Executing podman command: %q", cmd)
-	if strings.Contains(strings.Join(command, " "), "load") { // need to tag
-		if len(dest.Tag) > 0 {
-			return "", ScpTag(cmd, podman, dest)
-		}
-		cmd.Stdout = nil
-		out, err := cmd.Output()
-		if err != nil {
-			return "", err
-		}
-		img := ExtractImage(out)
-		return img, nil
-	}
-	return "", cmd.Run()
-}
-
 // parseImageSCPArg returns the valid connection, and source/destination data based off of the information provided by the user
 // arg is a string containing one of the cli arguments returned is a filled out source/destination options structs as well as a connections array and an error if applicable
 func ParseImageSCPArg(arg string) (*entities.ScpTransferImageOptions, []string, error) {
@@ -0,0 +1,344 @@
+package utils
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os/exec"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/docker/go-units"
+	"github.com/opencontainers/go-digest"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/common/pkg/ssh"
+)
+
+// reuseLayersFlag is the hidden flag of podman load taking the layers left
+// out of a transfer from the local storage of the destination.
+const reuseLayersFlag = "--reuse-layers"
+
+// scpHost runs podman on one end of an image transfer, the local host or a
+// remote host reached over ssh.
+type scpHost struct {
+	// URL is the ssh URL of a remote host, nil for the local host.
+	URL *url.URL
+	// Identity is the ssh identity used to connect to a remote host.
+	Identity string
+	// SSHMode is the ssh.EngineMode used to connect to a remote host.
+	SSHMode ssh.EngineMode
+	// SSHConfig is the ssh configuration file used in native mode.
+	SSHConfig string
+	// Podman is the podman binary run on the local host.
+	Podman string
+	// ParentFlags are the global flags given to podman on the local host.
+	ParentFlags []string
+}
+
+// run runs podman with the given arguments on the host, streaming stdin to
+// it and its output to stdout.
+func (h *scpHost) run(args []string, stdin io.Reader, stdout io.Writer) error {
+	stderr := &bytes.Buffer{}
+	var err error
+	if h.URL == nil {
+		cmd := exec.Command(h.Podman, append(slices.Clone(h.ParentFlags), args...)...)
+		cmd.Stdin = stdin
+		cmd.Stdout = stdout
+		cmd.Stderr = stderr
+		logrus.Debugf("Executing podman command: %q", cmd)
+		err = cmd.Run()
+	} else {
+		err = h.runRemote(args, stdin, stdout, stderr)
+	}
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%s: %w", msg, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// runRemote runs podman on the remote host. Unlike ssh.Exec, the input and
+// output are streamed, never held in memory.
+func (h *scpHost) runRemote(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	port := 0
+	if urlPort := h.URL.Port(); urlPort != "" {
+		var err error
+		if port, err = strconv.Atoi(urlPort); err != nil {
+			return err
+		}
+	}
+	command := shellQuote(append([]string{"podman"}, args...))
+	logrus.Debugf("Executing podman command on %s: %s", h.URL.Hostname(), command)
+
+	if h.SSHMode == ssh.NativeMode {
+		sshPath, err := exec.LookPath("ssh")
+		if err != nil {
+			return err
+		}
+		sshArgs := []string{}
+		if h.Identity != "" {
+			sshArgs = append(sshArgs, "-i", h.Identity)
+		}
+		if h.SSHConfig != "" {
+			sshArgs = append(sshArgs, "-F", h.SSHConfig)
+		}
+		if port != 0 {
+			sshArgs = append(sshArgs, "-p", strconv.Itoa(port))
+		}
+		sshArgs = append(sshArgs, h.URL.User.Username()+"@"+h.URL.Hostname(), command)
+		cmd := exec.Command(sshPath, sshArgs...)
+		cmd.Stdin = stdin
+		cmd.Stdout = stdout
+		cmd.Stderr = stderr
+		return cmd.Run()
+	}
+
+	client, err := ssh.Dial(&ssh.ConnectionDialOptions{Host: h.URL.String(), Identity: h.Identity, User: h.URL.User, Port: port}, h.SSHMode)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	sess, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer sess.Close()
+	sess.Stdin = stdin
+	sess.Stdout = stdout
+	sess.Stderr = stderr
+	return sess.Run(command)
+}
+
+// output runs podman with the given arguments on the host and returns its
+// output.
+func (h *scpHost) output(args ...string) (string, error) {
+	out := &bytes.Buffer{}
+	err := h.run(args, nil, out)
+	return out.String(), err
+}
+
+// shellQuote joins args into a command line for the remote shell ssh runs
+// commands with, quoting the arguments which need it.
+func shellQuote(args []string) string {
+	quoted := make([]string, 0, len(args))
+	for _, arg := range args {
+		safe := arg != "" && strings.IndexFunc(arg, func(r rune) bool {
+			return (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && !strings.ContainsRune("-_./:=@,+%", r)
+		}) == -1
+		if safe {
+			quoted = append(quoted, arg)
+		} else {
+			quoted = append(quoted, "'"+strings.ReplaceAll(arg, "'", `'\''`)+"'")
+		}
+	}
+	return strings.Join(quoted, " ")
+}
+
+// scpTransferStats summarizes the blobs of a streamed image transfer.
+type scpTransferStats struct {
+	Sent, Skipped           int
+	SentBytes, SkippedBytes int64
+}
+
+// streamTransfer copies an image from src to dst, piping the archive saved
+// on src directly into podman load on dst. Layers dst has already are left
+// out of OCI archives. Progress is written to progress, if set. Returns the
+// output of podman load.
+func streamTransfer(src, dst *scpHost, image, format string, progress io.Writer) (string, error) {
+	var skip map[digest.Digest]bool
+	if format == define.OCIArchive {
+		var err error
+		if skip, err = sharedLayers(src, dst, image); err != nil {
+			return "", err
+		}
+	}
+
+	out, err := streamArchive(src, dst, image, format, skip, progress)
+	if err != nil && len(skip) > 0 && strings.Contains(err.Error(), "unknown flag: "+reuseLayersFlag) {
+		// Podman on the destination is too old to fill in layers.
+		logrus.Infof("Podman on the destination cannot reuse layers, sending all layers")
+		out, err = streamArchive(src, dst, image, format, nil, progress)
+	}
+	return out, err
+}
+
+// streamArchive runs one streamed transfer, leaving out the blobs in skip.
+func streamArchive(src, dst *scpHost, image, format string, skip map[digest.Digest]bool, progress io.Writer) (string, error) {
+	saveArgs := []string{"image", "save", "--format", format, image}
+	loadArgs := []string{"image", "load", "--quiet"}
+	if len(skip) > 0 {
+		loadArgs = append(loadArgs, reuseLayersFlag)
+	}
+
+	// A failing step makes the others fail too, the first error is the
+	// cause.
+	var (
+		firstErr error
+		once     sync.Once
+	)
+	fail := func(err error) {
+		once.Do(func() { firstErr = err })
+	}
+
+	saveReader, saveWriter := io.Pipe()
+	loadReader, loadWriter := io.Pipe()
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		err := src.run(saveArgs, nil, saveWriter)
+		if err != nil {
+			fail(fmt.Errorf("saving image %s: %w", image, err))
+		}
+		saveWriter.CloseWithError(err)
+	}()
+	go func() {
+		defer wg.Done()
+		var (
+			stats scpTransferStats
+			err   error
+		)
+		if format == define.OCIArchive {
+			stats, err = filterArchive(saveReader, loadWriter, skip, progress)
+		} else {
+			_, err = io.Copy(loadWriter, saveReader)
+		}
+		if err != nil {
+			fail(fmt.Errorf("streaming image %s: %w", image, err))
+		} else if progress != nil && format == define.OCIArchive {
+			fmt.Fprintf(progress, "Sent %d blobs (%s), skipped %d blobs present on the destination (%s)\n",
+				stats.Sent, units.HumanSize(float64(stats.SentBytes)), stats.Skipped, units.HumanSize(float64(stats.SkippedBytes)))
+		}
+		// Stop the save if the archive is not read to the end.
+		saveReader.CloseWithError(err)
+		loadWriter.CloseWithError(err)
+	}()
+
+	out := &bytes.Buffer{}
+	err := dst.run(loadArgs, loadReader, out)
+	if err != nil {
+		fail(fmt.Errorf("loading image %s: %w", image, err))
+	}
+	loadReader.CloseWithError(err)
+	wg.Wait()
+	if firstErr != nil {
+		return "", firstErr
+	}
+	return strings.TrimSuffix(out.String(), "\n"), nil
+}
+
+// sharedLayers returns the layers of image on src which dst has already, by
+// their uncompressed digest. Those are the digests of the layer blobs in OCI
+// archives saved by podman.
+func sharedLayers(src, dst *scpHost, image string) (map[digest.Digest]bool, error) {
+	const layersFormat = "{{range .RootFS.Layers}}{{println .}}{{end}}"
+
+	out, err := src.output("image", "inspect", "--format", layersFormat, image)
+	if err != nil {
+		return nil, err
+	}
+	layers := parseDigests(out)
+
+	out, err = dst.output("image", "ls", "--all", "--quiet", "--no-trunc")
+	if err != nil {
+		return nil, err
+	}
+	ids := strings.Fields(out)
+	if len(layers) == 0 || len(ids) == 0 {
+		return nil, nil
+	}
+	out, err = dst.output(append([]string{"image", "inspect", "--format", layersFormat}, ids...)...)
+	if err != nil {
+		return nil, err
+	}
+	shared := make(map[digest.Digest]bool)
+	for d := range parseDigests(out) {
+		if layers[d] {
+			shared[d] = true
+		}
+	}
+	return shared, nil
+}
+
+// parseDigests parses the valid digests, one per line, in out.
+func parseDigests(out string) map[digest.Digest]bool {
+	digests := make(map[digest.Digest]bool)
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		d := digest.Digest(strings.TrimSpace(scanner.Text()))
+		if d.Validate() == nil {
+			digests[d] = true
+		}
+	}
+	return digests
+}
+
+// filterArchive copies the OCI archive read from r to w, leaving out the
+// blobs in skip, and writes the progress to progress, if set.
+func filterArchive(r io.Reader, w io.Writer, skip map[digest.Digest]bool, progress io.Writer) (scpTransferStats, error) {
+	var stats scpTransferStats
+	tr := tar.NewReader(r)
+	tw := tar.NewWriter(w)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return stats, err
+		}
+
+		d, isBlob := archiveBlobDigest(hdr)
+		if isBlob {
+			if skip[d] {
+				stats.Skipped++
+				stats.SkippedBytes += hdr.Size
+				if progress != nil {
+					fmt.Fprintf(progress, "Skipping blob %s: already present on the destination\n", d.Encoded()[:12])
+				}
+				continue
+			}
+			stats.Sent++
+			stats.SentBytes += hdr.Size
+			if progress != nil {
+				fmt.Fprintf(progress, "Copying blob %s (%s)\n", d.Encoded()[:12], units.HumanSize(float64(hdr.Size)))
+			}
+		}
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return stats, err
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return stats, err
+		}
+	}
+	return stats, tw.Close()
+}
+
+// archiveBlobDigest returns the digest of the blob in the entry of an OCI
+// archive, if the entry is one.
+func archiveBlobDigest(hdr *tar.Header) (digest.Digest, bool) {
+	if hdr.Typeflag != tar.TypeReg {
+		return "", false
+	}
+	dir, encoded := path.Split(path.Clean(strings.TrimPrefix(hdr.Name, "./")))
+	alg, ok := strings.CutPrefix(path.Clean(dir), "blobs/")
+	if !ok {
+		return "", false
+	}
+	d := digest.NewDigestFromEncoded(digest.Algorithm(alg), encoded)
+	if d.Validate() != nil {
+		return "", false
+	}
+	return d, true
+}
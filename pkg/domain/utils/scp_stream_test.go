@@ -0,0 +1,158 @@
+package utils
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/containers/podman/v6/libpod/define"
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShellQuote(t *testing.T) {
+	assert.Equal(t, "podman image save --format oci-archive quay.io/libpod/alpine:latest",
+		shellQuote([]string{"podman", "image", "save", "--format", "oci-archive", "quay.io/libpod/alpine:latest"}))
+	assert.Equal(t, `podman image inspect --format '{{range .RootFS.Layers}}{{println .}}{{end}}' ''`,
+		shellQuote([]string{"podman", "image", "inspect", "--format", "{{range .RootFS.Layers}}{{println .}}{{end}}", ""}))
+	assert.Equal(t, `'it'\''s; rm -rf /'`, shellQuote([]string{"it's; rm -rf /"}))
+}
+
+// testOCIArchive returns an OCI archive with the given layers as blobs.
+func testOCIArchive(t *testing.T, layers ...string) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	files := []struct{ name, data string }{
+		{"oci-layout", `{"imageLayoutVersion":"1.0.0"}`},
+		{"index.json", `{"schemaVersion":2,"manifests":[]}`},
+	}
+	for _, layer := range layers {
+		files = append(files, struct{ name, data string }{"blobs/sha256/" + digest.FromString(layer).Encoded(), layer})
+	}
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "blobs/", Typeflag: tar.TypeDir, Mode: 0o755}))
+	for _, f := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: f.name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(f.data))}))
+		_, err := tw.Write([]byte(f.data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+// archiveEntries returns the contents of the regular files in a tar archive.
+func archiveEntries(t *testing.T, archive []byte) map[string]string {
+	t.Helper()
+	entries := make(map[string]string)
+	tr := tar.NewReader(bytes.NewReader(archive))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		if hdr.Typeflag == tar.TypeReg {
+			entries[hdr.Name] = string(data)
+		}
+	}
+	return entries
+}
+
+func TestFilterArchive(t *testing.T) {
+	archive := testOCIArchive(t, "base layer", "app layer")
+	base := digest.FromString("base layer")
+	app := digest.FromString("app layer")
+
+	out := &bytes.Buffer{}
+	progress := &bytes.Buffer{}
+	stats, err := filterArchive(bytes.NewReader(archive), out, map[digest.Digest]bool{base: true}, progress)
+	require.NoError(t, err)
+	assert.Equal(t, scpTransferStats{Sent: 1, Skipped: 1, SentBytes: int64(len("app layer")), SkippedBytes: int64(len("base layer"))}, stats)
+
+	entries := archiveEntries(t, out.Bytes())
+	assert.Contains(t, entries, "oci-layout")
+	assert.Contains(t, entries, "index.json")
+	assert.Equal(t, "app layer", entries["blobs/sha256/"+app.Encoded()])
+	assert.NotContains(t, entries, "blobs/sha256/"+base.Encoded())
+
+	assert.Contains(t, progress.String(), "Skipping blob "+base.Encoded()[:12])
+	assert.Contains(t, progress.String(), "Copying blob "+app.Encoded()[:12])
+}
+
+func TestArchiveBlobDigest(t *testing.T) {
+	d := digest.FromString("layer")
+	for _, tt := range []struct {
+		name   string
+		typ    byte
+		want   digest.Digest
+		isBlob bool
+	}{
+		{name: "blobs/sha256/" + d.Encoded(), typ: tar.TypeReg, want: d, isBlob: true},
+		{name: "./blobs/sha256/" + d.Encoded(), typ: tar.TypeReg, want: d, isBlob: true},
+		{name: "blobs/sha256/", typ: tar.TypeDir},
+		{name: "blobs/sha256/notadigest", typ: tar.TypeReg},
+		{name: "index.json", typ: tar.TypeReg},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, isBlob := archiveBlobDigest(&tar.Header{Name: tt.name, Typeflag: tt.typ})
+			assert.Equal(t, tt.isBlob, isBlob)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// fakePodman writes a script standing in for podman on both ends of a
+// transfer. It saves the archive at $SCP_ARCHIVE and records the archive it
+// loads and its arguments next to it.
+func fakePodman(t *testing.T) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	script := `#!/bin/sh
+case "$2" in
+save)
+	[ -n "$SCP_SAVE_ERROR" ] && { echo "$SCP_SAVE_ERROR" >&2; exit 125; }
+	cat "$SCP_ARCHIVE" ;;
+load)
+	echo "$@" > "$SCP_DIR/load-args"
+	cat > "$SCP_DIR/loaded"
+	echo "Loaded image: quay.io/libpod/testimage:latest" ;;
+esac
+`
+	podman := filepath.Join(dir, "podman")
+	require.NoError(t, os.WriteFile(podman, []byte(script), 0o755))
+	t.Setenv("SCP_DIR", dir)
+	return podman, dir
+}
+
+func TestStreamArchive(t *testing.T) {
+	podman, dir := fakePodman(t)
+	archive := filepath.Join(dir, "archive.tar")
+	require.NoError(t, os.WriteFile(archive, testOCIArchive(t, "base layer", "app layer"), 0o644))
+	t.Setenv("SCP_ARCHIVE", archive)
+
+	host := &scpHost{Podman: podman}
+	base := digest.FromString("base layer")
+	out, err := streamArchive(host, host, "testimage", define.OCIArchive, map[digest.Digest]bool{base: true}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Loaded image: quay.io/libpod/testimage:latest", out)
+
+	args, err := os.ReadFile(filepath.Join(dir, "load-args"))
+	require.NoError(t, err)
+	assert.Equal(t, "image load --quiet "+reuseLayersFlag, strings.TrimSpace(string(args)))
+	loaded, err := os.ReadFile(filepath.Join(dir, "loaded"))
+	require.NoError(t, err)
+	entries := archiveEntries(t, loaded)
+	assert.NotContains(t, entries, "blobs/sha256/"+base.Encoded())
+	assert.Contains(t, entries, "blobs/sha256/"+digest.FromString("app layer").Encoded())
+
+	t.Setenv("SCP_SAVE_ERROR", "Error: testimage: image not known")
+	_, err = streamArchive(host, host, "testimage", define.OCIArchive, nil, nil)
+	assert.ErrorContains(t, err, "saving image testimage: Error: testimage: image not known")
+}
@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/containers/ocicrypt"
+	encconfig "github.com/containers/ocicrypt/config"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"go.podman.io/storage/pkg/archive"
+)
+
+const (
+	// VolumeArtifactMIMEType is the artifact type of volume exports
+	// stored in the artifact store.
+	VolumeArtifactMIMEType = "application/vnd.podman.volume.v1"
+	// VolumeNameAnnotation records the name of the exported volume on the
+	// blob of a volume artifact.
+	VolumeNameAnnotation = "io.podman.volume.name"
+)
+
+// An encrypted volume archive is an uncompressed tar holding the annotations
+// needed to decrypt, i.e. the wrapped keys, followed by the encrypted and
+// optionally compressed volume tar.
+const (
+	volumeEncryptionFile = "podman-volume-encryption.json"
+	volumeEncryptedFile  = "volume.tar.enc"
+)
+
+type volumeEncryption struct {
+	Annotations map[string]string `json:"annotations"`
+}
+
+// VolumeArchiveMIMEType returns the media type of a volume archive written
+// with the given compression and encryption.
+func VolumeArchiveMIMEType(compression archive.Compression, encrypted bool) string {
+	mimeType := "application/vnd.podman.volume.layer.v1.tar"
+	switch compression {
+	case archive.Gzip:
+		mimeType += "+gzip"
+	case archive.Zstd:
+		mimeType += "+zstd"
+	}
+	if encrypted {
+		mimeType += "+encrypted"
+	}
+	return mimeType
+}
+
+// WriteVolumeArchive writes the volume tar read from contents to w,
+// compressed and, if encConfig is set, encrypted.  Encrypted archives are
+// spooled to a temporary file as the keys are only known once all contents
+// have been encrypted.
+func WriteVolumeArchive(w io.Writer, contents io.Reader, compression archive.Compression, encConfig *encconfig.EncryptConfig) error {
+	if encConfig == nil {
+		return compressVolumeArchive(w, contents, compression)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(compressVolumeArchive(pw, contents, compression))
+	}()
+	defer pr.Close()
+
+	encrypted, finalizer, err := ocicrypt.EncryptLayer(encConfig, pr, ocispec.Descriptor{})
+	if err != nil {
+		return fmt.Errorf("encrypting volume archive: %w", err)
+	}
+	spool, err := os.CreateTemp("", "podman-volume-export")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		spool.Close()
+		os.Remove(spool.Name())
+	}()
+	size, err := io.Copy(spool, encrypted)
+	if err != nil {
+		return fmt.Errorf("encrypting volume archive: %w", err)
+	}
+	annotations, err := finalizer()
+	if err != nil {
+		return fmt.Errorf("encrypting volume archive: %w", err)
+	}
+	header, err := json.Marshal(volumeEncryption{Annotations: annotations})
+	if err != nil {
+		return err
+	}
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	tw := tar.NewWriter(w)
+	if err := tw.WriteHeader(&tar.Header{Name: volumeEncryptionFile, Mode: 0o600, Size: int64(len(header))}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(header); err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: volumeEncryptedFile, Mode: 0o600, Size: size}); err != nil {
+		return err
+	}
+	if _, err := io.Copy(tw, spool); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+func compressVolumeArchive(w io.Writer, contents io.Reader, compression archive.Compression) error {
+	cw, err := archive.CompressStream(w, compression)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(cw, contents); err != nil {
+		cw.Close()
+		return err
+	}
+	return cw.Close()
+}
+
+// ReadVolumeArchive returns the volume tar of an archive written by
+// WriteVolumeArchive, decrypted with decConfig if the archive is encrypted.
+// The tar is still compressed, volume imports detect the compression.
+func ReadVolumeArchive(r io.Reader, decConfig *encconfig.DecryptConfig) (io.Reader, error) {
+	br := bufio.NewReaderSize(r, 1024)
+	// An uncompressed tar starts with the header of its first entry.
+	peek, _ := br.Peek(1024)
+	if hdr, err := tar.NewReader(bytes.NewReader(peek)).Next(); err != nil || hdr.Name != volumeEncryptionFile {
+		return br, nil
+	}
+	if decConfig == nil {
+		return nil, errors.New("volume archive is encrypted, a decryption key is required")
+	}
+
+	tr := tar.NewReader(br)
+	if _, err := tr.Next(); err != nil {
+		return nil, err
+	}
+	var encryption volumeEncryption
+	if err := json.NewDecoder(tr).Decode(&encryption); err != nil {
+		return nil, fmt.Errorf("reading encryption of volume archive: %w", err)
+	}
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("reading encrypted volume archive: %w", err)
+	}
+	if hdr.Name != volumeEncryptedFile {
+		return nil, fmt.Errorf("invalid encrypted volume archive: unexpected entry %q", hdr.Name)
+	}
+	decrypted, _, err := ocicrypt.DecryptLayer(decConfig, tr, ocispec.Descriptor{Annotations: encryption.Annotations}, false)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting volume archive: %w", err)
+	}
+	return decrypted, nil
+}
@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"testing"
+
+	encconfig "github.com/containers/ocicrypt/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/storage/pkg/archive"
+)
+
+func volumeTar(t *testing.T, content string) []byte {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "test", Mode: 0o644, Size: int64(len(content))}))
+	_, err := tw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+func volumeTarContent(t *testing.T, r io.Reader) string {
+	decompressed, err := archive.DecompressStream(r)
+	require.NoError(t, err)
+	defer decompressed.Close()
+	tr := tar.NewReader(decompressed)
+	hdr, err := tr.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "test", hdr.Name)
+	content, err := io.ReadAll(tr)
+	require.NoError(t, err)
+	return string(content)
+}
+
+func volumeArchiveKeys(t *testing.T) (encconfig.CryptoConfig, encconfig.CryptoConfig) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pub, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	pubPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pub})
+	privPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	encCC, err := encconfig.EncryptWithJwe([][]byte{pubPEM})
+	require.NoError(t, err)
+	decCC, err := encconfig.DecryptWithPrivKeys([][]byte{privPEM}, [][]byte{nil})
+	require.NoError(t, err)
+	return encCC, decCC
+}
+
+func TestVolumeArchive(t *testing.T) {
+	encCC, decCC := volumeArchiveKeys(t)
+	const content = "hello volume"
+
+	for _, compression := range []archive.Compression{archive.Uncompressed, archive.Gzip, archive.Zstd} {
+		t.Run(compression.Extension(), func(t *testing.T) {
+			var plain bytes.Buffer
+			err := WriteVolumeArchive(&plain, bytes.NewReader(volumeTar(t, content)), compression, nil)
+			require.NoError(t, err)
+			contents, err := ReadVolumeArchive(&plain, nil)
+			require.NoError(t, err)
+			assert.Equal(t, content, volumeTarContent(t, contents))
+
+			var encrypted bytes.Buffer
+			err = WriteVolumeArchive(&encrypted, bytes.NewReader(volumeTar(t, content)), compression, encCC.EncryptConfig)
+			require.NoError(t, err)
+			assert.NotContains(t, encrypted.String(), content)
+
+			_, err = ReadVolumeArchive(bytes.NewReader(encrypted.Bytes()), nil)
+			assert.ErrorContains(t, err, "a decryption key is required")
+
+			contents, err = ReadVolumeArchive(bytes.NewReader(encrypted.Bytes()), decCC.DecryptConfig)
+			require.NoError(t, err)
+			assert.Equal(t, content, volumeTarContent(t, contents))
+		})
+	}
+}
+
+func TestVolumeArchiveWrongKey(t *testing.T) {
+	encCC, _ := volumeArchiveKeys(t)
+	_, otherDecCC := volumeArchiveKeys(t)
+
+	var encrypted bytes.Buffer
+	err := WriteVolumeArchive(&encrypted, bytes.NewReader(volumeTar(t, "secret")), archive.Zstd, encCC.EncryptConfig)
+	require.NoError(t, err)
+	_, err = ReadVolumeArchive(&encrypted, otherDecCC.DecryptConfig)
+	assert.ErrorContains(t, err, "decrypting volume archive")
+}
+
+func TestVolumeArchiveMIMEType(t *testing.T) {
+	assert.Equal(t, "application/vnd.podman.volume.layer.v1.tar", VolumeArchiveMIMEType(archive.Uncompressed, false))
+	assert.Equal(t, "application/vnd.podman.volume.layer.v1.tar+zstd+encrypted", VolumeArchiveMIMEType(archive.Zstd, true))
+}
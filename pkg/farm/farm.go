@@ -6,14 +6,20 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
+	"net/http"
+	"net/url"
 	"os"
 	"sort"
 	"strings"
 	"sync"
+	"syscall"
 
 	"github.com/containers/buildah/define"
+	"github.com/containers/buildah/pkg/parse"
 	"github.com/containers/podman/v6/pkg/domain/entities"
 	"github.com/containers/podman/v6/pkg/domain/infra"
+	"github.com/containers/podman/v6/pkg/errorhandling"
 	"github.com/hashicorp/go-multierror"
 	"github.com/sirupsen/logrus"
 	lplatform "go.podman.io/common/libimage/platform"
@@ -25,18 +31,22 @@ type Farm struct {
 	name        string
 	localEngine entities.ImageEngine            // not nil -> use local engine, too
 	builders    map[string]entities.ImageEngine // name -> builder
+	nodes       map[string]Node                 // name -> scheduling settings
+	cache       string                          // repository for the build cache
 }
 
 // Schedule is a description of where and how we'll do builds.
 type Schedule struct {
-	platformBuilders map[string]string // target->connection
+	platformBuilders map[string][]string // target->connections, in the order to try them
 }
 
-func newFarmWithBuilders(_ context.Context, name string, cons []config.Connection, localEngine entities.ImageEngine, buildLocal bool) (*Farm, error) {
+func newFarmWithBuilders(_ context.Context, name string, cons []config.Connection, settings Settings, localEngine entities.ImageEngine, buildLocal bool) (*Farm, error) {
 	farm := &Farm{
 		builders:    make(map[string]entities.ImageEngine),
 		localEngine: localEngine,
 		name:        name,
+		nodes:       settings.Nodes,
+		cache:       settings.Cache,
 	}
 	var (
 		builderMutex sync.Mutex
@@ -93,8 +103,14 @@ func NewFarm(ctx context.Context, name string, localEngine entities.ImageEngine,
 	if err != nil {
 		return nil, err
 	}
+	var settings Settings
+	if name != "" {
+		if settings, err = GetSettings(name); err != nil {
+			return nil, err
+		}
+	}
 
-	return newFarmWithBuilders(ctx, name, destinations, localEngine, buildLocal)
+	return newFarmWithBuilders(ctx, name, destinations, settings, localEngine, buildLocal)
 }
 
 // NativePlatforms returns a list of the set of platforms for which the farm
@@ -141,11 +157,13 @@ func (f *Farm) NativePlatforms(ctx context.Context) ([]string, error) {
 // over emulated builders, but will assign a builder which can use emulation
 // for a platform if no suitable native builder is available.
 //
+// Builders are picked by the priority and weight set for their connections in
+// the farm, a la RFC 2782, and by their current load: the builds they are
+// running and how busy their CPUs are.  The other capable builders are kept
+// to retry a build on if a builder cannot be reached.
+//
 // If platforms is an empty list, all available native platforms will be
 // scheduled.
-//
-// TODO: add (Priority,Weight *int) a la RFC 2782 to destinations that we know
-// of, and factor those in when assigning builds to nodes in here.
 func (f *Farm) Schedule(ctx context.Context, platforms []string) (Schedule, error) {
 	var (
 		err       error
@@ -160,33 +178,19 @@ func (f *Farm) Schedule(ctx context.Context, platforms []string) (Schedule, erro
 		}
 	}
 
-	platformBuilders := make(map[string]string)
-	native := make(map[string]string)
-	emulated := make(map[string]string)
-	var localPlatform string
-	// Make notes of which platforms we can build for natively, and which
-	// ones we can build for using emulation.
+	// Make notes of which platforms each node can build for, natively or
+	// using emulation, and of how loaded it is.
+	nodes := make([]*nodeState, 0, len(f.builders))
 	for name, engine := range f.builders {
 		infoGroup.Go(func() error {
 			inspect, err := engine.FarmNodeInspect(ctx)
 			if err != nil {
 				return err
 			}
+			logrus.Debugf("Node %q: %d CPUs, %.1f%% busy, %d builds running", name, inspect.CPUs, inspect.CPUUtilization, inspect.RunningBuilds)
 			infoMutex.Lock()
 			defer infoMutex.Unlock()
-			for _, n := range inspect.NativePlatforms {
-				if _, assigned := native[n]; !assigned {
-					native[n] = name
-				}
-				if name == entities.LocalFarmImageBuilderName {
-					localPlatform = n
-				}
-			}
-			for _, e := range inspect.EmulatedPlatforms {
-				if _, assigned := emulated[e]; !assigned {
-					emulated[e] = name
-				}
-			}
+			nodes = append(nodes, &nodeState{name: name, report: inspect, Node: f.nodes[name]})
 			return nil
 		})
 	}
@@ -196,21 +200,9 @@ func (f *Farm) Schedule(ctx context.Context, platforms []string) (Schedule, erro
 			return Schedule{}, err
 		}
 	}
-	// Assign a build to the first node that could build it natively, and
-	// if there isn't one, the first one that can build it with the help of
-	// emulation, and if there aren't any, error out.
-	for _, platform := range platforms {
-		if builder, ok := native[platform]; ok {
-			platformBuilders[platform] = builder
-		} else if builder, ok := emulated[platform]; ok {
-			platformBuilders[platform] = builder
-		} else {
-			return Schedule{}, fmt.Errorf("no builder capable of building for platform %q available", platform)
-		}
-	}
-	// If local is set, prioritize building on local
-	if localPlatform != "" {
-		platformBuilders[localPlatform] = entities.LocalFarmImageBuilderName
+	platformBuilders, err := schedulePlatforms(platforms, nodes)
+	if err != nil {
+		return Schedule{}, err
 	}
 	schedule := Schedule{
 		platformBuilders: platformBuilders,
@@ -219,8 +211,12 @@ func (f *Farm) Schedule(ctx context.Context, platforms []string) (Schedule, erro
 }
 
 // Build runs a build using the specified targetplatform:service map.  If all
-// builds succeed, it copies the resulting images from the remote hosts to the
-// local service and builds a manifest list with the specified reference name.
+// builds succeed, it pushes the resulting images from the builders to the
+// registry and builds a manifest list with the specified reference name, in
+// the local service unless options.PushOnly is set.
+//
+// If the farm has a cache repository and no cache was asked for, the builders
+// push their build cache to it and pull it from there.
 func (f *Farm) Build(ctx context.Context, schedule Schedule, options entities.BuildOptions, reference string, _ entities.ImageEngine) error {
 	switch options.OutputFormat {
 	default:
@@ -230,19 +226,34 @@ func (f *Farm) Build(ctx context.Context, schedule Schedule, options entities.Bu
 	case define.Dockerv2ImageManifest:
 	}
 
+	if f.cache != "" && len(options.CacheFrom) == 0 && len(options.CacheTo) == 0 {
+		cache, err := parse.RepoNamesToNamedReferences([]string{f.cache})
+		if err != nil {
+			return fmt.Errorf("cache repository of farm %q: %w", f.name, err)
+		}
+		logrus.Debugf("Using build cache in %s", f.cache)
+		options.CacheTo = cache
+		if !options.NoCache {
+			options.CacheFrom = cache
+		}
+		// The cache is made of layers.
+		options.Layers = true
+	}
+
 	// Build the list of jobs.
-	var jobs sync.Map
 	type job struct {
 		platform string
 		os       string
 		arch     string
 		variant  string
-		builder  entities.ImageEngine
+		builders []string
 	}
-	for platform, builderName := range schedule.platformBuilders { // prepare to build
-		builder, ok := f.builders[builderName]
-		if !ok {
-			return fmt.Errorf("unknown builder %q", builderName)
+	jobs := make([]job, 0, len(schedule.platformBuilders))
+	for platform, builderNames := range schedule.platformBuilders { // prepare to build
+		for _, builderName := range builderNames {
+			if _, ok := f.builders[builderName]; !ok {
+				return fmt.Errorf("unknown builder %q", builderName)
+			}
 		}
 		var rawOS, rawArch, rawVariant string
 		p := strings.Split(platform, "/")
@@ -256,12 +267,12 @@ func (f *Farm) Build(ctx context.Context, schedule Schedule, options entities.Bu
 			rawVariant = p[2]
 		}
 		os, arch, variant := lplatform.Normalize(rawOS, rawArch, rawVariant)
-		jobs.Store(builderName, job{
+		jobs = append(jobs, job{
 			platform: platform,
 			os:       os,
 			arch:     arch,
 			variant:  variant,
-			builder:  builder,
+			builders: builderNames,
 		})
 	}
 
@@ -271,8 +282,14 @@ func (f *Farm) Build(ctx context.Context, schedule Schedule, options entities.Bu
 		iidFileRaw:    options.IIDFileRaw,
 		authfile:      options.Authfile,
 		skipTLSVerify: options.SkipTLSVerify,
+		outputFormat:  options.OutputFormat,
+	}
+	var manifestListBuilder listBuilder
+	if options.PushOnly {
+		manifestListBuilder = newRegistryListBuilder(reference, listBuilderOptions)
+	} else {
+		manifestListBuilder = newManifestListBuilder(reference, f.localEngine, listBuilderOptions)
 	}
-	manifestListBuilder := newManifestListBuilder(reference, f.localEngine, listBuilderOptions)
 
 	// Start builds in parallel and wait for them all to finish.
 	var (
@@ -283,63 +300,38 @@ func (f *Farm) Build(ctx context.Context, schedule Schedule, options entities.Bu
 		report  entities.BuildReport
 		builder entities.ImageEngine
 	}
-	for platform, builder := range schedule.platformBuilders {
-		outReader, outWriter := io.Pipe()
-		errReader, errWriter := io.Pipe()
-		go func() {
-			defer outReader.Close()
-			reader := bufio.NewReader(outReader)
-			writer := options.Out
-			if writer == nil {
-				writer = os.Stdout
-			}
-			line, err := reader.ReadString('\n')
-			for err == nil {
-				line = strings.TrimSuffix(line, "\n")
-				fmt.Fprintf(writer, "[%s@%s] %s\n", platform, builder, line)
-				line, err = reader.ReadString('\n')
-			}
-		}()
-		go func() {
-			defer errReader.Close()
-			reader := bufio.NewReader(errReader)
-			writer := options.Err
-			if writer == nil {
-				writer = os.Stderr
-			}
-			line, err := reader.ReadString('\n')
-			for err == nil {
-				line = strings.TrimSuffix(line, "\n")
-				fmt.Fprintf(writer, "[%s@%s] %s\n", platform, builder, line)
-				line, err = reader.ReadString('\n')
-			}
-		}()
+	for _, j := range jobs {
 		buildGroup.Go(func() error {
-			var j job
-			defer outWriter.Close()
-			defer errWriter.Close()
-			c, ok := jobs.Load(builder)
-			if !ok {
-				return fmt.Errorf("unknown connection for %q (shouldn't happen)", builder)
-			}
-			if j, ok = c.(job); !ok {
-				return fmt.Errorf("unexpected connection type for %q (shouldn't happen)", builder)
-			}
-			buildOptions := options
-			buildOptions.Platforms = []struct{ OS, Arch, Variant string }{{j.os, j.arch, j.variant}}
-			buildOptions.Out = outWriter
-			buildOptions.Err = errWriter
-			fmt.Printf("Starting build for %v at %q\n", buildOptions.Platforms, builder)
-			buildReport, err := j.builder.Build(ctx, options.ContainerFiles, buildOptions)
-			if err != nil {
-				return fmt.Errorf("building for %q on %q: %w", j.platform, builder, err)
+			// If a builder cannot be reached or fails, retry the
+			// build on the next capable builder.
+			var buildErr error
+			for i, builderName := range j.builders {
+				if i > 0 {
+					if ctx.Err() != nil {
+						break
+					}
+					fmt.Fprintf(os.Stderr, "Build for %q on %q failed, retrying on %q\n", j.platform, j.builders[i-1], builderName)
+				}
+				builder := f.builders[builderName]
+				buildOptions := options
+				buildOptions.Platforms = []struct{ OS, Arch, Variant string }{{j.os, j.arch, j.variant}}
+				buildReport, err := f.buildOn(ctx, builder, builderName, j.platform, buildOptions)
+				if err != nil {
+					buildErr = errors.Join(buildErr, fmt.Errorf("building for %q on %q: %w", j.platform, builderName, err))
+					// A failing build would fail on the other
+					// builders as well.
+					if !isNodeError(err) {
+						break
+					}
+					continue
+				}
+				buildResults.Store(j.platform, buildResult{
+					report:  *buildReport,
+					builder: builder,
+				})
+				return nil
 			}
-			fmt.Printf("finished build for %v at %q: built %s\n", buildOptions.Platforms, builder, buildReport.ID)
-			buildResults.Store(platform, buildResult{
-				report:  *buildReport,
-				builder: j.builder,
-			})
-			return nil
+			return buildErr
 		})
 	}
 	buildErrors := buildGroup.Wait()
@@ -366,6 +358,66 @@ func (f *Farm) Build(ctx context.Context, schedule Schedule, options entities.Bu
 	return nil
 }
 
+// isNodeError returns whether err is caused by the connection to a builder or
+// by the builder's engine rather than by the build itself.
+func isNodeError(err error) bool {
+	var urlErr *url.Error
+	var netErr net.Error
+	if errors.As(err, &urlErr) || errors.As(err, &netErr) {
+		return true
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var apiErr *errorhandling.ErrorModel
+	if errors.As(err, &apiErr) {
+		switch apiErr.ResponseCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+	}
+	return false
+}
+
+// buildOn runs a build on one builder, prefixing its output with the
+// platform and the builder's name.
+func (f *Farm) buildOn(ctx context.Context, builder entities.ImageEngine, builderName, platform string, options entities.BuildOptions) (*entities.BuildReport, error) {
+	outWriter := prefixOutput(options.Out, os.Stdout, fmt.Sprintf("[%s@%s] ", platform, builderName))
+	defer outWriter.Close()
+	errWriter := prefixOutput(options.Err, os.Stderr, fmt.Sprintf("[%s@%s] ", platform, builderName))
+	defer errWriter.Close()
+	options.Out = outWriter
+	options.Err = errWriter
+	fmt.Printf("Starting build for %v at %q\n", options.Platforms, builderName)
+	buildReport, err := builder.Build(ctx, options.ContainerFiles, options)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("finished build for %v at %q: built %s\n", options.Platforms, builderName, buildReport.ID)
+	return buildReport, nil
+}
+
+// prefixOutput returns a writer which copies each line written to it to
+// writer, or to def if writer is nil, after prefix.
+func prefixOutput(writer, def io.Writer, prefix string) io.WriteCloser {
+	if writer == nil {
+		writer = def
+	}
+	pipeReader, pipeWriter := io.Pipe()
+	go func() {
+		defer pipeReader.Close()
+		reader := bufio.NewReader(pipeReader)
+		line, err := reader.ReadString('\n')
+		for err == nil {
+			line = strings.TrimSuffix(line, "\n")
+			fmt.Fprintf(writer, "%s%s\n", prefix, line)
+			line, err = reader.ReadString('\n')
+		}
+	}()
+	return pipeWriter
+}
+
 func getFarmDestinations(name string) (string, []config.Connection, error) {
 	cfg, err := config.Default()
 	if err != nil {
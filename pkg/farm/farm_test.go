@@ -0,0 +1,53 @@
+package farm
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+
+	"github.com/containers/podman/v6/pkg/errorhandling"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNodeError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "connection refused",
+			err:  &url.Error{Op: "Post", URL: "http://d/build", Err: syscall.ECONNREFUSED},
+			want: true,
+		},
+		{
+			name: "server quit",
+			err:  fmt.Errorf("server probably quit: %w", io.ErrUnexpectedEOF),
+			want: true,
+		},
+		{
+			name: "service unavailable",
+			err:  &errorhandling.ErrorModel{Message: "unavailable", ResponseCode: http.StatusServiceUnavailable},
+			want: true,
+		},
+		{
+			name: "bad request",
+			err:  &errorhandling.ErrorModel{Message: "bad request", ResponseCode: http.StatusBadRequest},
+			want: false,
+		},
+		{
+			name: "build failure",
+			err:  errors.New(`building at STEP "RUN false": while running runtime: exit status 1`),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isNodeError(tt.err))
+		})
+	}
+}
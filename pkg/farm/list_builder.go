@@ -19,6 +19,13 @@ type listBuilderOptions struct {
 	iidFileRaw    string
 	authfile      string
 	skipTLSVerify *bool
+	outputFormat  string
+}
+
+// listBuilder assembles the images built on the farm nodes into a manifest
+// list.
+type listBuilder interface {
+	build(ctx context.Context, images map[entities.BuildReport]entities.ImageEngine) (string, error)
 }
 
 type listLocal struct {
@@ -58,6 +65,41 @@ func (l *listLocal) build(ctx context.Context, images map[entities.BuildReport]e
 		}
 	}
 
+	refs, err := pushImages(ctx, l.listName, images, l.options, skipTLSVerify)
+	if err != nil {
+		return "", err
+	}
+
+	// Clear the list in the event it already existed
+	if exists.Value {
+		_, err = l.localEngine.ManifestListClear(ctx, l.listName)
+		if err != nil {
+			return "", fmt.Errorf("error clearing list %q", l.listName)
+		}
+	}
+
+	// Add the images to the list
+	listID, err := l.localEngine.ManifestAdd(ctx, l.listName, refs, entities.ManifestAddOptions{Authfile: l.options.authfile, SkipTLSVerify: skipTLSVerify})
+	if err != nil {
+		return "", fmt.Errorf("adding images %q to list: %w", refs, err)
+	}
+	_, err = l.localEngine.ManifestPush(ctx, l.listName, l.listName, entities.ImagePushOptions{Authfile: l.options.authfile, SkipTLSVerify: skipTLSVerify})
+	if err != nil {
+		return "", err
+	}
+
+	// Write the manifest list's ID file if we're expected to
+	if err := writeIIDFiles(l.options, listID); err != nil {
+		return "", err
+	}
+
+	return l.listName, nil
+}
+
+// pushImages pushes the images built on the farm nodes to the repository of
+// listName, removes them from the nodes if options.cleanup is set, and
+// returns references to the pushed images.
+func pushImages(ctx context.Context, listName string, images map[entities.BuildReport]entities.ImageEngine, options listBuilderOptions, skipTLSVerify types.OptionalBool) ([]string, error) {
 	// Push the images to the registry given by the user
 	var (
 		pushGroup multierror.Group
@@ -69,23 +111,23 @@ func (l *listLocal) build(ctx context.Context, images map[entities.BuildReport]e
 			logrus.Infof("pushing image %s", image.ID)
 			defer logrus.Infof("pushed image %s", image.ID)
 			// Push the image to the registry
-			report, err := engine.Push(ctx, image.ID, l.listName+docker.UnknownDigestSuffix, entities.ImagePushOptions{Authfile: l.options.authfile, Quiet: false, SkipTLSVerify: skipTLSVerify})
+			report, err := engine.Push(ctx, image.ID, listName+docker.UnknownDigestSuffix, entities.ImagePushOptions{Authfile: options.authfile, Quiet: false, SkipTLSVerify: skipTLSVerify})
 			if err != nil {
 				return fmt.Errorf("pushing image %q to registry: %w", image, err)
 			}
 			refsMutex.Lock()
 			defer refsMutex.Unlock()
-			refs = append(refs, "docker://"+l.listName+"@"+report.ManifestDigest)
+			refs = append(refs, "docker://"+listName+"@"+report.ManifestDigest)
 			return nil
 		})
 	}
 	pushErrors := pushGroup.Wait()
-	err = pushErrors.ErrorOrNil()
+	err := pushErrors.ErrorOrNil()
 	if err != nil {
-		return "", fmt.Errorf("building: %w", err)
+		return nil, fmt.Errorf("building: %w", err)
 	}
 
-	if l.options.cleanup {
+	if options.cleanup {
 		var rmGroup multierror.Group
 		for image, engine := range images {
 			if engine.FarmNodeName(ctx) == entities.LocalFarmImageBuilderName {
@@ -102,40 +144,25 @@ func (l *listLocal) build(ctx context.Context, images map[entities.BuildReport]e
 		rmErrors := rmGroup.Wait()
 		if rmErrors != nil {
 			if err = rmErrors.ErrorOrNil(); err != nil {
-				return "", fmt.Errorf("removing intermediate images: %w", err)
+				return nil, fmt.Errorf("removing intermediate images: %w", err)
 			}
 		}
 	}
+	return refs, nil
+}
 
-	// Clear the list in the event it already existed
-	if exists.Value {
-		_, err = l.localEngine.ManifestListClear(ctx, l.listName)
-		if err != nil {
-			return "", fmt.Errorf("error clearing list %q", l.listName)
-		}
-	}
-
-	// Add the images to the list
-	listID, err := l.localEngine.ManifestAdd(ctx, l.listName, refs, entities.ManifestAddOptions{Authfile: l.options.authfile, SkipTLSVerify: skipTLSVerify})
-	if err != nil {
-		return "", fmt.Errorf("adding images %q to list: %w", refs, err)
-	}
-	_, err = l.localEngine.ManifestPush(ctx, l.listName, l.listName, entities.ImagePushOptions{Authfile: l.options.authfile, SkipTLSVerify: skipTLSVerify})
-	if err != nil {
-		return "", err
-	}
-
-	// Write the manifest list's ID file if we're expected to
-	if l.options.iidFile != "" {
-		if err := os.WriteFile(l.options.iidFile, []byte("sha256:"+listID), 0o644); err != nil {
-			return "", err
+// writeIIDFiles writes the ID of the manifest list to the ID files the
+// caller asked for.
+func writeIIDFiles(options listBuilderOptions, listID string) error {
+	if options.iidFile != "" {
+		if err := os.WriteFile(options.iidFile, []byte("sha256:"+listID), 0o644); err != nil {
+			return err
 		}
 	}
-	if l.options.iidFileRaw != "" {
-		if err := os.WriteFile(l.options.iidFileRaw, []byte(listID), 0o644); err != nil {
-			return "", err
+	if options.iidFileRaw != "" {
+		if err := os.WriteFile(options.iidFileRaw, []byte(listID), 0o644); err != nil {
+			return err
 		}
 	}
-
-	return l.listName, nil
+	return nil
 }
@@ -0,0 +1,170 @@
+package farm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/containers/buildah/define"
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sirupsen/logrus"
+	"go.podman.io/image/v5/image"
+	"go.podman.io/image/v5/manifest"
+	"go.podman.io/image/v5/transports/alltransports"
+	"go.podman.io/image/v5/types"
+)
+
+type listRegistry struct {
+	listName string
+	options  listBuilderOptions
+}
+
+// newRegistryListBuilder returns a manifest list builder which pushes a
+// manifest list straight to the registry, without storing it or the images
+// in local storage.
+func newRegistryListBuilder(listName string, options listBuilderOptions) *listRegistry {
+	return &listRegistry{
+		listName: listName,
+		options:  options,
+	}
+}
+
+// Build pushes the images from the build reports to the registry and
+// assembles them into a manifest list there.
+func (l *listRegistry) build(ctx context.Context, images map[entities.BuildReport]entities.ImageEngine) (string, error) {
+	// Set skipTLSVerify based on whether it was changed by the caller
+	skipTLSVerify := types.OptionalBoolUndefined
+	if l.options.skipTLSVerify != nil {
+		skipTLSVerify = types.NewOptionalBool(*l.options.skipTLSVerify)
+	}
+
+	refs, err := pushImages(ctx, l.listName, images, l.options, skipTLSVerify)
+	if err != nil {
+		return "", err
+	}
+
+	sys := &types.SystemContext{
+		AuthFilePath:                l.options.authfile,
+		DockerInsecureSkipTLSVerify: skipTLSVerify,
+	}
+	instances := make([]imgspecv1.Descriptor, 0, len(refs))
+	for _, ref := range refs {
+		instance, err := describeInstance(ctx, sys, ref)
+		if err != nil {
+			return "", err
+		}
+		instances = append(instances, instance)
+	}
+	sort.Slice(instances, func(i, j int) bool {
+		return platformString(instances[i].Platform) < platformString(instances[j].Platform)
+	})
+
+	list, err := serializeList(instances, l.options.outputFormat)
+	if err != nil {
+		return "", err
+	}
+	listDigest, err := manifest.Digest(list)
+	if err != nil {
+		return "", err
+	}
+
+	dest, err := alltransports.ParseImageName("docker://" + l.listName)
+	if err != nil {
+		return "", err
+	}
+	logrus.Infof("pushing manifest list %s", l.listName)
+	destination, err := dest.NewImageDestination(ctx, sys)
+	if err != nil {
+		return "", err
+	}
+	defer destination.Close()
+	if err := destination.PutManifest(ctx, list, nil); err != nil {
+		return "", fmt.Errorf("pushing manifest list %q: %w", l.listName, err)
+	}
+	if err := destination.Commit(ctx, nil); err != nil {
+		return "", fmt.Errorf("pushing manifest list %q: %w", l.listName, err)
+	}
+
+	// Write the manifest list's ID file if we're expected to
+	if err := writeIIDFiles(l.options, listDigest.Encoded()); err != nil {
+		return "", err
+	}
+
+	return l.listName + "@" + listDigest.String(), nil
+}
+
+// describeInstance returns the descriptor of the image at ref to add to a
+// manifest list.
+func describeInstance(ctx context.Context, sys *types.SystemContext, ref string) (imgspecv1.Descriptor, error) {
+	imageRef, err := alltransports.ParseImageName(ref)
+	if err != nil {
+		return imgspecv1.Descriptor{}, err
+	}
+	src, err := imageRef.NewImageSource(ctx, sys)
+	if err != nil {
+		return imgspecv1.Descriptor{}, fmt.Errorf("reading image %q: %w", ref, err)
+	}
+	img, err := image.FromSource(ctx, sys, src)
+	if err != nil {
+		src.Close()
+		return imgspecv1.Descriptor{}, fmt.Errorf("reading image %q: %w", ref, err)
+	}
+	defer img.Close()
+
+	manifestBytes, manifestType, err := img.Manifest(ctx)
+	if err != nil {
+		return imgspecv1.Descriptor{}, fmt.Errorf("reading manifest of %q: %w", ref, err)
+	}
+	manifestDigest, err := manifest.Digest(manifestBytes)
+	if err != nil {
+		return imgspecv1.Descriptor{}, err
+	}
+	config, err := img.OCIConfig(ctx)
+	if err != nil {
+		return imgspecv1.Descriptor{}, fmt.Errorf("reading configuration of %q: %w", ref, err)
+	}
+	return imgspecv1.Descriptor{
+		MediaType: manifestType,
+		Digest:    manifestDigest,
+		Size:      int64(len(manifestBytes)),
+		Platform: &imgspecv1.Platform{
+			OS:           config.OS,
+			Architecture: config.Architecture,
+			Variant:      config.Variant,
+		},
+	}, nil
+}
+
+// serializeList returns a manifest list of the given instances, in the format
+// of the images of the build.
+func serializeList(instances []imgspecv1.Descriptor, outputFormat string) ([]byte, error) {
+	if outputFormat != define.Dockerv2ImageManifest {
+		return manifest.OCI1IndexFromComponents(instances, nil).Serialize()
+	}
+	components := make([]manifest.Schema2ManifestDescriptor, 0, len(instances))
+	for _, instance := range instances {
+		components = append(components, manifest.Schema2ManifestDescriptor{
+			Schema2Descriptor: manifest.Schema2Descriptor{
+				MediaType: instance.MediaType,
+				Size:      instance.Size,
+				Digest:    instance.Digest,
+			},
+			Platform: manifest.Schema2PlatformSpec{
+				OS:           instance.Platform.OS,
+				Architecture: instance.Platform.Architecture,
+				Variant:      instance.Platform.Variant,
+			},
+		})
+	}
+	return manifest.Schema2ListFromComponents(components).Serialize()
+}
+
+// platformString returns the os/arch[/variant] form of a platform.
+func platformString(p *imgspecv1.Platform) string {
+	s := p.OS + "/" + p.Architecture
+	if p.Variant != "" {
+		s += "/" + p.Variant
+	}
+	return s
+}
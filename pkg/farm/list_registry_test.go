@@ -0,0 +1,51 @@
+package farm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/containers/buildah/define"
+	"github.com/opencontainers/go-digest"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/image/v5/manifest"
+)
+
+func TestSerializeList(t *testing.T) {
+	instances := []imgspecv1.Descriptor{
+		{
+			MediaType: imgspecv1.MediaTypeImageManifest,
+			Digest:    digest.FromString("amd64"),
+			Size:      100,
+			Platform:  &imgspecv1.Platform{OS: "linux", Architecture: "amd64"},
+		},
+		{
+			MediaType: imgspecv1.MediaTypeImageManifest,
+			Digest:    digest.FromString("arm64"),
+			Size:      200,
+			Platform:  &imgspecv1.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"},
+		},
+	}
+
+	list, err := serializeList(instances, define.OCIv1ImageManifest)
+	require.NoError(t, err)
+	var index imgspecv1.Index
+	require.NoError(t, json.Unmarshal(list, &index))
+	assert.Equal(t, imgspecv1.MediaTypeImageIndex, index.MediaType)
+	assert.Equal(t, instances, index.Manifests)
+
+	list, err = serializeList(instances, define.Dockerv2ImageManifest)
+	require.NoError(t, err)
+	assert.Equal(t, manifest.DockerV2ListMediaType, manifest.GuessMIMEType(list))
+	schema2, err := manifest.Schema2ListFromManifest(list)
+	require.NoError(t, err)
+	require.Len(t, schema2.Manifests, 2)
+	assert.Equal(t, digest.FromString("arm64"), schema2.Manifests[1].Digest)
+	assert.Equal(t, "v8", schema2.Manifests[1].Platform.Variant)
+}
+
+func TestPlatformString(t *testing.T) {
+	assert.Equal(t, "linux/amd64", platformString(&imgspecv1.Platform{OS: "linux", Architecture: "amd64"}))
+	assert.Equal(t, "linux/arm/v7", platformString(&imgspecv1.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}))
+}
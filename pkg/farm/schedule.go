@@ -0,0 +1,92 @@
+package farm
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/containers/podman/v6/pkg/domain/entities"
+)
+
+// minIdleShare is the smallest share of idle CPU time assumed for a node, so
+// that a busy node still gets builds when no other node can take them.
+const minIdleShare = 0.05
+
+// nodeState is what the scheduler knows about a builder.
+type nodeState struct {
+	name   string
+	report *entities.FarmInspectReport
+	Node
+}
+
+// capacity returns how much work the node can take, from its weight, its
+// CPUs and the share of them sitting idle.
+func (n *nodeState) capacity() float64 {
+	weight := n.Weight
+	if weight <= 0 {
+		weight = 1
+	}
+	cpus := max(n.report.CPUs, 1)
+	idle := max((100-n.report.CPUUtilization)/100, minIdleShare)
+	return float64(weight) * float64(cpus) * idle
+}
+
+// cost returns the cost of adding one more build to the node, which is
+// already running its own builds plus the assigned ones.
+func (n *nodeState) cost(assigned int) float64 {
+	return float64(n.report.RunningBuilds+assigned+1) / n.capacity()
+}
+
+// schedulePlatforms returns, for each platform, the builders able to build
+// for it in the order they should be tried. Builders building natively come
+// first, then the ones which need emulation. Within each group, builders go
+// by priority, lowest first, and then by the cost of one more build, taking
+// the builds scheduled before into account. The local builder, if any, is
+// always tried first for the platforms it builds natively.
+func schedulePlatforms(platforms []string, nodes []*nodeState) (map[string][]string, error) {
+	assigned := make(map[string]int)
+	platformBuilders := make(map[string][]string)
+	for _, platform := range platforms {
+		var native, emulated []*nodeState
+		for _, node := range nodes {
+			switch {
+			case slices.Contains(node.report.NativePlatforms, platform):
+				native = append(native, node)
+			case slices.Contains(node.report.EmulatedPlatforms, platform):
+				emulated = append(emulated, node)
+			}
+		}
+		if len(native) == 0 && len(emulated) == 0 {
+			return nil, fmt.Errorf("no builder capable of building for platform %q available", platform)
+		}
+		sortNodes(native, assigned)
+		sortNodes(emulated, assigned)
+
+		builders := make([]string, 0, len(native)+len(emulated))
+		for _, node := range append(native, emulated...) {
+			builders = append(builders, node.name)
+		}
+		// If local is set, prioritize building on local
+		if i := slices.Index(builders, entities.LocalFarmImageBuilderName); i > 0 && i < len(native) {
+			builders = slices.Insert(slices.Delete(builders, i, i+1), 0, entities.LocalFarmImageBuilderName)
+		}
+		platformBuilders[platform] = builders
+		assigned[builders[0]]++
+	}
+	return platformBuilders, nil
+}
+
+// sortNodes sorts nodes by priority, then by the cost of one more build, then
+// by name.
+func sortNodes(nodes []*nodeState, assigned map[string]int) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		a, b := nodes[i], nodes[j]
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		if costA, costB := a.cost(assigned[a.name]), b.cost(assigned[b.name]); costA != costB {
+			return costA < costB
+		}
+		return a.name < b.name
+	})
+}
@@ -0,0 +1,119 @@
+package farm
+
+import (
+	"testing"
+
+	"github.com/containers/podman/v6/pkg/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testNode(name string, native, emulated []string, cpus int, busy float64, running int, settings Node) *nodeState {
+	return &nodeState{
+		name: name,
+		report: &entities.FarmInspectReport{
+			NativePlatforms:   native,
+			EmulatedPlatforms: emulated,
+			CPUs:              cpus,
+			CPUUtilization:    busy,
+			RunningBuilds:     running,
+		},
+		Node: settings,
+	}
+}
+
+func TestSchedulePlatforms(t *testing.T) {
+	amd64 := []string{"linux/amd64"}
+	arm64 := []string{"linux/arm64"}
+
+	for _, tt := range []struct {
+		name      string
+		platforms []string
+		nodes     []*nodeState
+		want      map[string][]string
+	}{
+		{
+			name:      "least loaded node first",
+			platforms: []string{"linux/amd64"},
+			nodes: []*nodeState{
+				testNode("busy", amd64, nil, 8, 10, 3, Node{}),
+				testNode("idle", amd64, nil, 8, 10, 0, Node{}),
+			},
+			want: map[string][]string{"linux/amd64": {"idle", "busy"}},
+		},
+		{
+			name:      "priority before load",
+			platforms: []string{"linux/amd64"},
+			nodes: []*nodeState{
+				testNode("primary", amd64, nil, 8, 90, 4, Node{Priority: 0}),
+				testNode("backup", amd64, nil, 8, 0, 0, Node{Priority: 1}),
+			},
+			want: map[string][]string{"linux/amd64": {"primary", "backup"}},
+		},
+		{
+			name:      "weight and CPUs",
+			platforms: []string{"linux/amd64"},
+			nodes: []*nodeState{
+				testNode("small", amd64, nil, 4, 0, 1, Node{Weight: 4}),
+				testNode("big", amd64, nil, 32, 0, 1, Node{}),
+				testNode("heavy", amd64, nil, 4, 0, 1, Node{Weight: 16}),
+			},
+			want: map[string][]string{"linux/amd64": {"heavy", "big", "small"}},
+		},
+		{
+			name:      "busy CPUs",
+			platforms: []string{"linux/amd64"},
+			nodes: []*nodeState{
+				testNode("a", amd64, nil, 8, 100, 0, Node{}),
+				testNode("b", amd64, nil, 8, 50, 0, Node{}),
+			},
+			want: map[string][]string{"linux/amd64": {"b", "a"}},
+		},
+		{
+			name:      "native before emulated",
+			platforms: []string{"linux/arm64"},
+			nodes: []*nodeState{
+				testNode("x86", amd64, arm64, 64, 0, 0, Node{Priority: -1}),
+				testNode("arm", arm64, nil, 2, 90, 2, Node{}),
+			},
+			want: map[string][]string{"linux/arm64": {"arm", "x86"}},
+		},
+		{
+			name:      "spread builds over nodes",
+			platforms: []string{"linux/amd64", "linux/amd64/v2", "linux/amd64/v3"},
+			nodes: []*nodeState{
+				testNode("a", []string{"linux/amd64", "linux/amd64/v2", "linux/amd64/v3"}, nil, 8, 0, 0, Node{}),
+				testNode("b", []string{"linux/amd64", "linux/amd64/v2", "linux/amd64/v3"}, nil, 8, 0, 0, Node{}),
+			},
+			want: map[string][]string{
+				"linux/amd64":    {"a", "b"},
+				"linux/amd64/v2": {"b", "a"},
+				"linux/amd64/v3": {"a", "b"},
+			},
+		},
+		{
+			name:      "local first for its native platform",
+			platforms: []string{"linux/amd64", "linux/arm64"},
+			nodes: []*nodeState{
+				testNode("remote", []string{"linux/amd64", "linux/arm64"}, nil, 64, 0, 0, Node{}),
+				testNode(entities.LocalFarmImageBuilderName, amd64, arm64, 2, 90, 5, Node{}),
+			},
+			want: map[string][]string{
+				"linux/amd64": {entities.LocalFarmImageBuilderName, "remote"},
+				"linux/arm64": {"remote", entities.LocalFarmImageBuilderName},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := schedulePlatforms(tt.platforms, tt.nodes)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSchedulePlatformsNoBuilder(t *testing.T) {
+	nodes := []*nodeState{testNode("x86", []string{"linux/amd64"}, nil, 8, 0, 0, Node{})}
+	_, err := schedulePlatforms([]string{"linux/s390x"}, nodes)
+	assert.ErrorContains(t, err, `no builder capable of building for platform "linux/s390x" available`)
+}
@@ -0,0 +1,136 @@
+package farm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"go.podman.io/storage/pkg/configfile"
+	"go.podman.io/storage/pkg/ioutils"
+	"go.podman.io/storage/pkg/lockfile"
+)
+
+// settingsFile is the file holding the podman specific settings of farms. The
+// farms themselves are kept in the connections file.
+const settingsFile = "podman-farms.json"
+
+// Node are the scheduling settings of a connection in a farm.
+type Node struct {
+	// Priority of the connection, builds go to the connections with the
+	// lowest priority first
+	Priority int `json:",omitempty"`
+	// Weight of the connection among connections of the same priority,
+	// connections with a higher weight get more builds, 0 means 1
+	Weight int `json:",omitempty"`
+}
+
+// Settings are the podman specific settings of a farm.
+type Settings struct {
+	// Nodes are the scheduling settings of the connections of the farm
+	// where key=connection name
+	Nodes map[string]Node `json:",omitempty"`
+	// Cache is the repository builds on the farm push their build cache
+	// to and pull it from
+	Cache string `json:",omitempty"`
+}
+
+// SettingsFile holds the settings of all farms.
+type SettingsFile struct {
+	// Farms is a map of the settings of the farms where key=farm name
+	Farms map[string]Settings `json:",omitempty"`
+}
+
+// settingsFilePath returns the path of the settings file, which is stored
+// next to the connections file.
+func settingsFilePath() (string, error) {
+	if path, found := os.LookupEnv("PODMAN_CONNECTIONS_CONF"); found {
+		return filepath.Join(filepath.Dir(path), settingsFile), nil
+	}
+	path, err := configfile.UserConfigPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(path, settingsFile), nil
+}
+
+func readSettingsFile(path string) (*SettingsFile, error) {
+	conf := new(SettingsFile)
+	f, err := os.Open(path)
+	if err != nil {
+		// return empty settings if file does not exists
+		if errors.Is(err, fs.ErrNotExist) {
+			return conf, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	if err := json.NewDecoder(f).Decode(conf); err != nil {
+		return nil, fmt.Errorf("parse %q: %w", path, err)
+	}
+	return conf, nil
+}
+
+func writeSettingsFile(path string, conf *SettingsFile) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	opts := &ioutils.AtomicFileWriterOptions{ExplicitCommit: true}
+	f, err := ioutils.NewAtomicFileWriterWithOpts(path, 0o644, opts)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := json.NewEncoder(f).Encode(conf); err != nil {
+		return err
+	}
+	// If no errors commit the changes to the settings file
+	return f.Commit()
+}
+
+// EditSettings must be used to edit the settings of farms. The file is read
+// and written automatically, the callback function just needs to modify conf
+// as needed.
+func EditSettings(callback func(conf *SettingsFile) error) error {
+	path, err := settingsFilePath()
+	if err != nil {
+		return err
+	}
+
+	lock, err := lockfile.GetLockFile(path + ".lock")
+	if err != nil {
+		return fmt.Errorf("obtain lock file: %w", err)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	conf, err := readSettingsFile(path)
+	if err != nil {
+		return fmt.Errorf("read farm settings file: %w", err)
+	}
+	if conf.Farms == nil {
+		conf.Farms = make(map[string]Settings)
+	}
+	if err := callback(conf); err != nil {
+		return err
+	}
+	return writeSettingsFile(path, conf)
+}
+
+// GetSettings returns the settings of the farm with the given name.
+func GetSettings(name string) (Settings, error) {
+	path, err := settingsFilePath()
+	if err != nil {
+		return Settings{}, err
+	}
+	conf, err := readSettingsFile(path)
+	if err != nil {
+		return Settings{}, fmt.Errorf("read farm settings file: %w", err)
+	}
+	return conf.Farms[name], nil
+}
@@ -0,0 +1,42 @@
+package farm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditSettings(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("PODMAN_CONNECTIONS_CONF", filepath.Join(dir, "podman-connections.json"))
+
+	// A missing file holds no settings.
+	settings, err := GetSettings("farm1")
+	require.NoError(t, err)
+	assert.Equal(t, Settings{}, settings)
+
+	err = EditSettings(func(conf *SettingsFile) error {
+		conf.Farms["farm1"] = Settings{Nodes: map[string]Node{"con1": {Priority: 1, Weight: 2}}, Cache: "quay.io/user/cache"}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, settingsFile))
+
+	settings, err = GetSettings("farm1")
+	require.NoError(t, err)
+	assert.Equal(t, Node{Priority: 1, Weight: 2}, settings.Nodes["con1"])
+	assert.Equal(t, "quay.io/user/cache", settings.Cache)
+
+	// A failing callback leaves the file alone.
+	err = EditSettings(func(conf *SettingsFile) error {
+		delete(conf.Farms, "farm1")
+		return os.ErrInvalid
+	})
+	assert.ErrorIs(t, err, os.ErrInvalid)
+	settings, err = GetSettings("farm1")
+	require.NoError(t, err)
+	assert.Len(t, settings.Nodes, 1)
+}
@@ -182,6 +182,8 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 		portMappings                            []libnetworkTypes.PortMapping
 		networks                                []string
 		healthStatus                            string
+		ready                                   *bool
+		restartAt                               time.Time
 		restartCount                            uint
 		podName                                 string
 	)
@@ -232,6 +234,19 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 			return err
 		}
 
+		restartAt, err = c.RestartBackoff()
+		if err != nil {
+			return err
+		}
+
+		if c.HasReadinessCheck() {
+			isReady, err := c.Ready()
+			if err != nil {
+				return err
+			}
+			ready = &isReady
+		}
+
 		restartCount, err = c.RestartCount()
 		if err != nil {
 			return err
@@ -298,12 +313,17 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 		Pod:          conConfig.Pod,
 		PodName:      podName,
 		Ports:        portMappings,
+		Ready:        ready,
 		Restarts:     restartCount,
 		Size:         size,
 		StartedAt:    startedTime.Unix(),
 		State:        conState.String(),
 		Status:       healthStatus,
 	}
+	if !restartAt.IsZero() {
+		ps.State = define.ContainerStateBackoff
+		ps.RestartAt = restartAt.Unix()
+	}
 
 	if opts.Namespace {
 		ps.Namespaces = entities.ListContainerNamespaces{
@@ -0,0 +1,97 @@
+package vaultdriver
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// DriverName is the name of the vault secret driver.
+const DriverName = "vault"
+
+// shellDriverName is the name of the secret driver of the secrets manager
+// that runs the vault driver.
+const shellDriverName = "shell"
+
+// HelperCommand is the podman command running the vault driver for the
+// shell driver of the secrets manager.
+var HelperCommand = []string{"secret", "vault-driver"}
+
+// Actions of the helper command, one per command of the shell driver.
+const (
+	ActionDelete = "delete"
+	ActionList   = "list"
+	ActionLookup = "lookup"
+	ActionStore  = "store"
+)
+
+// optionsFlag is the flag of the helper command holding the encoded driver
+// options.
+const optionsFlag = "--options"
+
+// ShellOptions returns the options of the shell driver of the secrets manager
+// that runs the vault driver with the given options through the helper
+// command of the podman binary at podmanPath.  The options are validated.
+func ShellOptions(podmanPath string, opts map[string]string) (map[string]string, error) {
+	if _, err := NewDriver(opts); err != nil {
+		return nil, err
+	}
+	encoded, err := EncodeOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	prefix := shellQuote(podmanPath) + " " + strings.Join(HelperCommand, " ") + " " + optionsFlag + " " + encoded + " "
+	return map[string]string{
+		ActionDelete: prefix + ActionDelete,
+		ActionList:   prefix + ActionList,
+		ActionLookup: prefix + ActionLookup,
+		ActionStore:  prefix + ActionStore,
+	}, nil
+}
+
+// FromShellOptions returns the vault driver options of a secret stored with
+// the given driver and driver options, and whether it is a vault secret.
+func FromShellOptions(driver string, shellOpts map[string]string) (map[string]string, bool) {
+	if driver != shellDriverName {
+		return nil, false
+	}
+	marker := " " + strings.Join(HelperCommand, " ") + " " + optionsFlag + " "
+	_, rest, found := strings.Cut(shellOpts[ActionLookup], marker)
+	if !found {
+		return nil, false
+	}
+	encoded, _, _ := strings.Cut(rest, " ")
+	opts, err := DecodeOptions(encoded)
+	if err != nil {
+		return nil, false
+	}
+	return opts, true
+}
+
+// EncodeOptions encodes the driver options for the helper command.
+func EncodeOptions(opts map[string]string) (string, error) {
+	b, err := json.Marshal(opts)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// DecodeOptions decodes driver options encoded by EncodeOptions.
+func DecodeOptions(encoded string) (map[string]string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decoding vault driver options: %w", err)
+	}
+	opts := make(map[string]string)
+	if err := json.Unmarshal(b, &opts); err != nil {
+		return nil, fmt.Errorf("decoding vault driver options: %w", err)
+	}
+	return opts, nil
+}
+
+// shellQuote quotes s for /bin/sh.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
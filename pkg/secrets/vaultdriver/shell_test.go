@@ -0,0 +1,36 @@
+package vaultdriver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShellOptions(t *testing.T) {
+	opts := map[string]string{"address": "http://vault:8200", "path": "myapp/db", "field": "password"}
+	shellOpts, err := ShellOptions("/opt/it's/podman", opts)
+	require.NoError(t, err)
+	assert.Len(t, shellOpts, 4)
+	assert.Regexp(t, `^'/opt/it'\\''s/podman' secret vault-driver --options [A-Za-z0-9_-]+ lookup$`, shellOpts[ActionLookup])
+
+	got, ok := FromShellOptions("shell", shellOpts)
+	assert.True(t, ok)
+	assert.Equal(t, opts, got)
+
+	// Invalid options are rejected.
+	_, err = ShellOptions("/usr/bin/podman", map[string]string{"address": "http://vault:8200", "foo": "bar"})
+	assert.Error(t, err)
+}
+
+func TestFromShellOptionsOtherSecrets(t *testing.T) {
+	shellOpts, err := ShellOptions("/usr/bin/podman", map[string]string{"address": "http://vault:8200"})
+	require.NoError(t, err)
+
+	_, ok := FromShellOptions("file", shellOpts)
+	assert.False(t, ok)
+	_, ok = FromShellOptions("shell", map[string]string{ActionLookup: "cat /run/secrets/$SECRET_ID"})
+	assert.False(t, ok)
+	_, ok = FromShellOptions("shell", map[string]string{ActionLookup: "podman secret vault-driver --options !!! lookup"})
+	assert.False(t, ok)
+}
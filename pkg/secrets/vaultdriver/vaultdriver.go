@@ -0,0 +1,457 @@
+package vaultdriver
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.podman.io/common/pkg/secrets/define"
+)
+
+// errMissingConfig indicates that a required option of the driver is not set.
+var errMissingConfig = errors.New("missing config value")
+
+// managedPrefix is the path, relative to the mount, below which the secrets
+// stored by the driver are kept.
+const managedPrefix = "podman"
+
+// requestTimeout is the timeout of a single request to the server.
+const requestTimeout = 30 * time.Second
+
+type driverConfig struct {
+	// Address is the URL of the server, e.g. https://vault.example.com:8200
+	Address string
+	// Namespace is the namespace of the secrets (Vault Enterprise)
+	Namespace string
+	// Mount is the mount path of the KV version 2 secrets engine
+	Mount string
+	// Path is the path of an existing secret below Mount. When empty, the
+	// driver stores secrets below Mount/podman/<secret id> itself.
+	Path string
+	// Field is the field of the secret holding the secret data
+	Field string
+	// TokenFile is a file containing the token used to authenticate. When
+	// empty, VAULT_TOKEN or ~/.vault-token is used.
+	TokenFile string
+	// RoleID is the AppRole role ID used to authenticate
+	RoleID string
+	// SecretIDFile is a file containing the AppRole secret ID
+	SecretIDFile string
+	// AppRoleMount is the mount path of the AppRole auth method
+	AppRoleMount string
+	// CACert is a PEM file with the CA certificates used to verify the server
+	CACert string
+	// TTL is how long looked up secret data is cached, 0 disables caching
+	TTL time.Duration
+}
+
+func defaultDriverConfig() *driverConfig {
+	cfg := &driverConfig{
+		Address:      os.Getenv("VAULT_ADDR"),
+		Namespace:    os.Getenv("VAULT_NAMESPACE"),
+		Mount:        "secret",
+		Field:        "value",
+		AppRoleMount: "approle",
+		CACert:       os.Getenv("VAULT_CACERT"),
+	}
+	return cfg
+}
+
+func (cfg *driverConfig) ParseOpts(opts map[string]string) error {
+	for key, value := range opts {
+		switch key {
+		case "address":
+			cfg.Address = value
+		case "namespace":
+			cfg.Namespace = value
+		case "mount":
+			cfg.Mount = strings.Trim(value, "/")
+		case "path":
+			cfg.Path = strings.Trim(value, "/")
+		case "field":
+			cfg.Field = value
+		case "token_file":
+			cfg.TokenFile = value
+		case "role_id":
+			cfg.RoleID = value
+		case "secret_id_file":
+			cfg.SecretIDFile = value
+		case "approle_mount":
+			cfg.AppRoleMount = strings.Trim(value, "/")
+		case "ca_cert":
+			cfg.CACert = value
+		case "ttl":
+			ttl, err := time.ParseDuration(value)
+			if err != nil || ttl < 0 {
+				return fmt.Errorf("invalid vault driver ttl %q", value)
+			}
+			cfg.TTL = ttl
+		default:
+			return fmt.Errorf("invalid vault driver option: %q", key)
+		}
+	}
+	if cfg.Address == "" {
+		return fmt.Errorf("vault driver needs the address option or VAULT_ADDR: %w", errMissingConfig)
+	}
+	if cfg.Mount == "" || cfg.Field == "" {
+		return fmt.Errorf("vault driver mount and field must not be empty: %w", errMissingConfig)
+	}
+	if (cfg.RoleID == "") != (cfg.SecretIDFile == "") {
+		return fmt.Errorf("vault driver AppRole authentication needs both role_id and secret_id_file: %w", errMissingConfig)
+	}
+	if strings.Contains(cfg.Path, "..") {
+		return define.ErrInvalidKey
+	}
+	return nil
+}
+
+// Driver is the vaultdriver object. It reads secrets from the KV version 2
+// secrets engine of a HashiCorp Vault compatible server on every lookup, so
+// the secret data is never written to the local disk.
+type Driver struct {
+	driverConfig
+	client *http.Client
+}
+
+// NewDriver creates a new secret driver.
+func NewDriver(opts map[string]string) (*Driver, error) {
+	cfg := defaultDriverConfig()
+	if err := cfg.ParseOpts(opts); err != nil {
+		return nil, err
+	}
+
+	client, err := newHTTPClient(cfg.CACert)
+	if err != nil {
+		return nil, err
+	}
+
+	driver := &Driver{
+		driverConfig: *cfg,
+		client:       client,
+	}
+
+	return driver, nil
+}
+
+func newHTTPClient(caCert string) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caCert != "" {
+		pem, err := os.ReadFile(caCert)
+		if err != nil {
+			return nil, fmt.Errorf("reading vault CA certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", caCert)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	return &http.Client{Transport: transport, Timeout: requestTimeout}, nil
+}
+
+// managed reports whether the driver stores the secrets itself, as opposed
+// to referencing an existing secret by its path.
+func (d *Driver) managed() bool {
+	return d.Path == ""
+}
+
+// secretPath returns the path of the secret below the mount.
+func (d *Driver) secretPath(id string) string {
+	if d.managed() {
+		return path.Join(managedPrefix, id)
+	}
+	return d.Path
+}
+
+// List returns all secret IDs stored by the driver.
+func (d *Driver) List() ([]string, error) {
+	var resp struct {
+		Data struct {
+			Keys []string `json:"keys"`
+		} `json:"data"`
+	}
+	if err := d.request("LIST", path.Join(d.Mount, "metadata", managedPrefix), nil, &resp); err != nil {
+		if errors.Is(err, define.ErrNoSuchSecret) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	secrets := make([]string, 0, len(resp.Data.Keys))
+	for _, key := range resp.Data.Keys {
+		// Keys ending in / are folders, not secrets.
+		if !strings.HasSuffix(key, "/") {
+			secrets = append(secrets, key)
+		}
+	}
+	sort.Strings(secrets)
+	return secrets, nil
+}
+
+// Lookup returns the bytes associated with a secret ID.
+func (d *Driver) Lookup(id string) ([]byte, error) {
+	if strings.Contains(id, "..") {
+		return nil, define.ErrInvalidKey
+	}
+
+	key := d.cacheKey(id)
+	if data, ok := lookupCache.get(key); ok {
+		return data, nil
+	}
+
+	var resp struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := d.request(http.MethodGet, path.Join(d.Mount, "data", d.secretPath(id)), nil, &resp); err != nil {
+		return nil, err
+	}
+	value, ok := resp.Data.Data[d.Field]
+	if !ok {
+		return nil, fmt.Errorf("%s: field %q not found in vault secret %s: %w", id, d.Field, d.secretPath(id), define.ErrNoSuchSecret)
+	}
+	var data []byte
+	switch v := value.(type) {
+	case string:
+		data = []byte(v)
+	default:
+		// Non-string fields are handed to the container as JSON.
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		data = b
+	}
+
+	if d.TTL > 0 {
+		lookupCache.set(key, data, d.TTL)
+	}
+	return data, nil
+}
+
+// Store saves the bytes associated with an ID. Secrets referenced by path are
+// owned by the server, so for them Store only checks that the secret can be
+// read and ignores the data.
+func (d *Driver) Store(id string, data []byte) error {
+	if strings.Contains(id, "..") {
+		return define.ErrInvalidKey
+	}
+	if !d.managed() {
+		_, err := d.Lookup(id)
+		return err
+	}
+
+	body := map[string]any{
+		"options": map[string]any{"cas": 0},
+		"data":    map[string]string{d.Field: string(data)},
+	}
+	if err := d.request(http.MethodPost, path.Join(d.Mount, "data", d.secretPath(id)), body, nil); err != nil {
+		return fmt.Errorf("%s: %w", id, err)
+	}
+	return nil
+}
+
+// Delete removes the secret associated with the specified ID. Secrets
+// referenced by path are owned by the server and are left alone.
+func (d *Driver) Delete(id string) error {
+	if strings.Contains(id, "..") {
+		return define.ErrInvalidKey
+	}
+	// The lookup below caches the data again, so drop it when done.
+	defer lookupCache.remove(d.cacheKey(id))
+	if !d.managed() {
+		return nil
+	}
+
+	// Reading first turns a missing secret into ErrNoSuchSecret, deleting
+	// the metadata of a missing secret succeeds.
+	if _, err := d.Lookup(id); err != nil {
+		return err
+	}
+	return d.request(http.MethodDelete, path.Join(d.Mount, "metadata", d.secretPath(id)), nil, nil)
+}
+
+func (d *Driver) cacheKey(id string) string {
+	return strings.Join([]string{d.Address, d.Namespace, d.Mount, d.secretPath(id), d.Field}, "\x00")
+}
+
+// request sends a request to the API of the server and decodes the JSON
+// response into out, if it is not nil. A 404 response is returned as
+// ErrNoSuchSecret.
+func (d *Driver) request(method, apiPath string, body, out any) error {
+	token, err := d.token()
+	if err != nil {
+		return err
+	}
+	return d.do(method, apiPath, token, body, out)
+}
+
+func (d *Driver) do(method, apiPath, token string, body, out any) error {
+	u, err := url.JoinPath(d.Address, "v1", apiPath)
+	if err != nil {
+		return fmt.Errorf("invalid vault address %q: %w", d.Address, err)
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(context.TODO(), method, u, reqBody)
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if d.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", d.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("contacting vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%s: %w", apiPath, define.ErrNoSuchSecret)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var apiErr struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&apiErr)
+		if len(apiErr.Errors) > 0 {
+			return fmt.Errorf("vault %s %s: %s: %s", method, apiPath, resp.Status, strings.Join(apiErr.Errors, ", "))
+		}
+		return fmt.Errorf("vault %s %s: %s", method, apiPath, resp.Status)
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding vault response: %w", err)
+	}
+	return nil
+}
+
+// token returns the token to authenticate with. AppRole logins are cached
+// until shortly before the token expires.
+func (d *Driver) token() (string, error) {
+	if d.RoleID == "" {
+		tokenFile := d.TokenFile
+		if tokenFile == "" {
+			if token := os.Getenv("VAULT_TOKEN"); token != "" {
+				return token, nil
+			}
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return "", fmt.Errorf("vault driver needs the token_file option, VAULT_TOKEN or ~/.vault-token: %w", errMissingConfig)
+			}
+			tokenFile = filepath.Join(home, ".vault-token")
+		}
+		b, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return "", fmt.Errorf("reading vault token: %w", err)
+		}
+		return strings.TrimSpace(string(b)), nil
+	}
+
+	key := strings.Join([]string{d.Address, d.Namespace, d.AppRoleMount, d.RoleID}, "\x00")
+	if token, ok := tokenCache.get(key); ok {
+		return string(token), nil
+	}
+
+	secretID, err := os.ReadFile(d.SecretIDFile)
+	if err != nil {
+		return "", fmt.Errorf("reading vault AppRole secret ID: %w", err)
+	}
+	var resp struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int64  `json:"lease_duration"`
+		} `json:"auth"`
+	}
+	body := map[string]string{
+		"role_id":   d.RoleID,
+		"secret_id": strings.TrimSpace(string(secretID)),
+	}
+	if err := d.do(http.MethodPost, path.Join("auth", d.AppRoleMount, "login"), "", body, &resp); err != nil {
+		return "", fmt.Errorf("logging in to vault with AppRole: %w", err)
+	}
+	if resp.Auth.ClientToken == "" {
+		return "", errors.New("logging in to vault with AppRole: no token returned")
+	}
+	// Renew a bit before the lease runs out, so a token never expires
+	// in the middle of a request.
+	if ttl := time.Duration(resp.Auth.LeaseDuration)*time.Second - requestTimeout; ttl > 0 {
+		tokenCache.set(key, []byte(resp.Auth.ClientToken), ttl)
+	}
+	return resp.Auth.ClientToken, nil
+}
+
+// cache is an in-memory cache with per entry expiry. It is shared by all
+// drivers of the process, so long running processes like the API service
+// benefit from it.
+type cache struct {
+	lock    sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	data    []byte
+	expires time.Time
+}
+
+var (
+	lookupCache = &cache{entries: make(map[string]cacheEntry)}
+	tokenCache  = &cache{entries: make(map[string]cacheEntry)}
+)
+
+func (c *cache) get(key string) ([]byte, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.data, true
+}
+
+func (c *cache) set(key string, data []byte, ttl time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries[key] = cacheEntry{data: data, expires: time.Now().Add(ttl)}
+}
+
+func (c *cache) remove(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.entries, key)
+}
@@ -0,0 +1,215 @@
+package vaultdriver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/common/pkg/secrets/define"
+)
+
+// fakeVault serves the parts of the KV version 2 and AppRole APIs used by the
+// driver.
+type fakeVault struct {
+	lock    sync.Mutex
+	secrets map[string]map[string]any
+	reads   int
+	logins  int
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if r.Method == http.MethodPost && r.URL.Path == "/v1/auth/approle/login" {
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["role_id"] != "role" || body["secret_id"] != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.logins++
+		_ = json.NewEncoder(w).Encode(map[string]any{"auth": map[string]any{"client_token": "test-token", "lease_duration": 3600}})
+		return
+	}
+	if r.Header.Get("X-Vault-Token") != "test-token" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	switch {
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v1/secret/data/"):
+		f.reads++
+		data, ok := f.secrets[strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"data": data}})
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/v1/secret/data/"):
+		var body struct {
+			Data map[string]any `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.secrets[strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")] = body.Data
+	case r.Method == "LIST" && r.URL.Path == "/v1/secret/metadata/podman":
+		keys := []string{"folder/"}
+		for p := range f.secrets {
+			if id, ok := strings.CutPrefix(p, "podman/"); ok {
+				keys = append(keys, id)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"keys": keys}})
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/v1/secret/metadata/"):
+		delete(f.secrets, strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/"))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newFakeVault(t *testing.T) (*fakeVault, string, string) {
+	vault := &fakeVault{secrets: map[string]map[string]any{
+		"myapp/db": {"password": "first", "ports": []any{5432.0, 5433.0}},
+	}}
+	server := httptest.NewServer(vault)
+	t.Cleanup(server.Close)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("test-token\n"), 0o600))
+	return vault, server.URL, tokenFile
+}
+
+func TestParseOpts(t *testing.T) {
+	tests := []struct {
+		name string
+		opts map[string]string
+		err  string
+	}{
+		{name: "no address", opts: map[string]string{}, err: "address"},
+		{name: "unknown option", opts: map[string]string{"address": "http://vault", "foo": "bar"}, err: `invalid vault driver option: "foo"`},
+		{name: "invalid ttl", opts: map[string]string{"address": "http://vault", "ttl": "-1s"}, err: "invalid vault driver ttl"},
+		{name: "empty field", opts: map[string]string{"address": "http://vault", "field": ""}, err: "must not be empty"},
+		{name: "role without secret", opts: map[string]string{"address": "http://vault", "role_id": "role"}, err: "AppRole"},
+		{name: "path traversal", opts: map[string]string{"address": "http://vault", "path": "a/../b"}, err: define.ErrInvalidKey.Error()},
+		{name: "valid", opts: map[string]string{"address": "http://vault", "mount": "/kv/", "ttl": "1m"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("VAULT_ADDR", "")
+			cfg := defaultDriverConfig()
+			err := cfg.ParseOpts(tt.opts)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "kv", cfg.Mount)
+		})
+	}
+}
+
+func TestManagedSecrets(t *testing.T) {
+	vault, address, tokenFile := newFakeVault(t)
+	driver, err := NewDriver(map[string]string{"address": address, "token_file": tokenFile})
+	require.NoError(t, err)
+
+	require.NoError(t, driver.Store("abc", []byte("stored-data")))
+	assert.Equal(t, map[string]any{"value": "stored-data"}, vault.secrets["podman/abc"])
+
+	data, err := driver.Lookup("abc")
+	require.NoError(t, err)
+	assert.Equal(t, "stored-data", string(data))
+
+	ids, err := driver.List()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"abc"}, ids)
+
+	require.NoError(t, driver.Delete("abc"))
+	assert.NotContains(t, vault.secrets, "podman/abc")
+
+	assert.ErrorIs(t, driver.Delete("abc"), define.ErrNoSuchSecret)
+	_, err = driver.Lookup("abc")
+	assert.ErrorIs(t, err, define.ErrNoSuchSecret)
+	assert.ErrorIs(t, driver.Store("../abc", nil), define.ErrInvalidKey)
+}
+
+func TestReferencedSecrets(t *testing.T) {
+	vault, address, tokenFile := newFakeVault(t)
+	driver, err := NewDriver(map[string]string{"address": address, "token_file": tokenFile, "path": "myapp/db", "field": "password"})
+	require.NoError(t, err)
+
+	// Storing only checks that the secret can be read.
+	require.NoError(t, driver.Store("abc", []byte("ignored")))
+	assert.Equal(t, "first", vault.secrets["myapp/db"]["password"])
+
+	// Without a ttl every lookup reads the server, so rotated secrets
+	// are picked up.
+	vault.secrets["myapp/db"]["password"] = "rotated"
+	data, err := driver.Lookup("abc")
+	require.NoError(t, err)
+	assert.Equal(t, "rotated", string(data))
+
+	// Deleting leaves the secret on the server.
+	require.NoError(t, driver.Delete("abc"))
+	assert.Contains(t, vault.secrets, "myapp/db")
+
+	// Non-string fields are returned as JSON.
+	driver, err = NewDriver(map[string]string{"address": address, "token_file": tokenFile, "path": "myapp/db", "field": "ports"})
+	require.NoError(t, err)
+	data, err = driver.Lookup("abc")
+	require.NoError(t, err)
+	assert.Equal(t, "[5432,5433]", string(data))
+
+	driver, err = NewDriver(map[string]string{"address": address, "token_file": tokenFile, "path": "myapp/db", "field": "missing"})
+	require.NoError(t, err)
+	assert.ErrorIs(t, driver.Store("abc", nil), define.ErrNoSuchSecret)
+
+	driver, err = NewDriver(map[string]string{"address": address, "token_file": tokenFile, "path": "myapp/missing"})
+	require.NoError(t, err)
+	assert.ErrorIs(t, driver.Store("abc", nil), define.ErrNoSuchSecret)
+}
+
+func TestLookupCache(t *testing.T) {
+	vault, address, tokenFile := newFakeVault(t)
+	driver, err := NewDriver(map[string]string{"address": address, "token_file": tokenFile, "ttl": "1h"})
+	require.NoError(t, err)
+	require.NoError(t, driver.Store("cached", []byte("stored-data")))
+
+	reads := vault.reads
+	for range 2 {
+		data, err := driver.Lookup("cached")
+		require.NoError(t, err)
+		assert.Equal(t, "stored-data", string(data))
+	}
+	assert.Equal(t, reads+1, vault.reads)
+
+	// Deleting drops the cached data.
+	require.NoError(t, driver.Delete("cached"))
+	_, err = driver.Lookup("cached")
+	assert.ErrorIs(t, err, define.ErrNoSuchSecret)
+}
+
+func TestAppRoleLogin(t *testing.T) {
+	vault, address, _ := newFakeVault(t)
+	secretIDFile := filepath.Join(t.TempDir(), "secret-id")
+	require.NoError(t, os.WriteFile(secretIDFile, []byte("secret\n"), 0o600))
+
+	driver, err := NewDriver(map[string]string{"address": address, "role_id": "role", "secret_id_file": secretIDFile, "path": "myapp/db", "field": "password"})
+	require.NoError(t, err)
+	for range 2 {
+		data, err := driver.Lookup("abc")
+		require.NoError(t, err)
+		assert.Equal(t, "first", string(data))
+	}
+	// The token is reused until it expires.
+	assert.Equal(t, 1, vault.logins)
+}
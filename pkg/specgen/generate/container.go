@@ -509,6 +509,7 @@ func ConfigToSpec(rt *libpod.Runtime, specg *specgen.SpecGenerator, containerID
 
 	specg.HealthConfig = conf.HealthCheckConfig
 	specg.StartupHealthConfig = conf.StartupHealthCheckConfig
+	specg.ReadinessConfig = conf.ReadinessCheckConfig
 	specg.HealthCheckOnFailureAction = conf.HealthCheckOnFailureAction
 
 	if len(tmpEnvSecrets) > 0 {
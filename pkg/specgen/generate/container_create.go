@@ -10,9 +10,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/containers/podman/v6/libpod"
 	"github.com/containers/podman/v6/libpod/define"
+	"github.com/containers/podman/v6/libpod/logs/shipper"
 	"github.com/containers/podman/v6/pkg/namespaces"
 	"github.com/containers/podman/v6/pkg/rootless"
 	"github.com/containers/podman/v6/pkg/specgen"
@@ -563,12 +565,29 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 		if s.LogConfiguration.Size > 0 {
 			options = append(options, libpod.WithMaxLogSize(s.LogConfiguration.Size))
 		}
+		if s.LogConfiguration.MaxFiles > 0 || s.LogConfiguration.Compress || s.LogConfiguration.MaxAge > 0 {
+			maxFiles := s.LogConfiguration.MaxFiles
+			if maxFiles == 0 {
+				// compress and max-age only apply to rotated files
+				maxFiles = 1
+			}
+			options = append(options, libpod.WithLogRotation(maxFiles, s.LogConfiguration.Compress, s.LogConfiguration.MaxAge))
+		}
 		if len(s.LogConfiguration.Options) > 0 && s.LogConfiguration.Options["tag"] != "" {
 			options = append(options, libpod.WithLogTag(s.LogConfiguration.Options["tag"]))
 		}
 		if len(s.LogConfiguration.Labels) > 0 {
 			options = append(options, libpod.WithLogLabels(s.LogConfiguration.Labels))
 		}
+		driverOptions := make(map[string]string)
+		for k, v := range s.LogConfiguration.Options {
+			if shipper.IsDriverOption(k) {
+				driverOptions[k] = v
+			}
+		}
+		if len(driverOptions) > 0 {
+			options = append(options, libpod.WithLogDriverOptions(driverOptions))
+		}
 		if len(s.LogConfiguration.Driver) > 0 {
 			options = append(options, libpod.WithLogDriver(s.LogConfiguration.Driver))
 		}
@@ -647,6 +666,16 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 	if retries != 0 {
 		options = append(options, libpod.WithRestartRetries(retries))
 	}
+	if s.RestartDelay != nil {
+		var maxDelay, resetWindow time.Duration
+		if s.RestartMaxDelay != nil {
+			maxDelay = *s.RestartMaxDelay
+		}
+		if s.RestartResetWindow != nil {
+			resetWindow = *s.RestartResetWindow
+		}
+		options = append(options, libpod.WithRestartBackoff(*s.RestartDelay, maxDelay, resetWindow))
+	}
 
 	healthCheckSet := false
 	if s.ContainerHealthCheckConfig.HealthConfig != nil {
@@ -658,6 +687,9 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 		options = append(options, libpod.WithStartupHealthcheck(s.ContainerHealthCheckConfig.StartupHealthConfig))
 		healthCheckSet = true
 	}
+	if s.ContainerHealthCheckConfig.ReadinessConfig != nil {
+		options = append(options, libpod.WithReadinessCheck(s.ContainerHealthCheckConfig.ReadinessConfig))
+	}
 
 	if s.ContainerHealthCheckConfig.HealthCheckOnFailureAction != define.HealthCheckOnFailureActionNone {
 		options = append(options, libpod.WithHealthCheckOnFailureAction(s.ContainerHealthCheckConfig.HealthCheckOnFailureAction))
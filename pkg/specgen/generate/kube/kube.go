@@ -264,6 +264,24 @@ func ToSpecGen(ctx context.Context, opts *CtrSpecGenOptions) (*specgen.SpecGener
 				return nil, err
 			}
 			s.LogConfiguration.Size = logSize
+		case "max-file":
+			maxFiles, err := strconv.ParseUint(val, 10, 32)
+			if err != nil || maxFiles == 0 {
+				return nil, fmt.Errorf("invalid log option max-file %q: must be a positive integer", val)
+			}
+			s.LogConfiguration.MaxFiles = uint(maxFiles)
+		case "compress":
+			compress, err := strconv.ParseBool(val)
+			if err != nil {
+				return nil, fmt.Errorf("invalid log option compress %q: %w", val, err)
+			}
+			s.LogConfiguration.Compress = compress
+		case "max-age":
+			maxAge, err := time.ParseDuration(val)
+			if err != nil || maxAge <= 0 {
+				return nil, fmt.Errorf("invalid log option max-age %q: must be a positive duration", val)
+			}
+			s.LogConfiguration.MaxAge = maxAge
 		case "label":
 			labelKey, labelVal, hasVal := strings.Cut(val, "=")
 			if !hasVal {
@@ -289,6 +307,10 @@ func ToSpecGen(ctx context.Context, opts *CtrSpecGenOptions) (*specgen.SpecGener
 	if err != nil {
 		return nil, fmt.Errorf("failed to configure startupProbe: %w", err)
 	}
+	err = setupReadinessProbe(s, opts.Container)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure readinessProbe: %w", err)
+	}
 
 	// Since we prefix the container name with pod name to work-around the uniqueness requirement,
 	// the seccomp profile should reference the actual container name from the YAML
@@ -828,6 +850,29 @@ func setupStartupProbe(s *specgen.SpecGenerator, containerYAML v1.Container, res
 	return nil
 }
 
+// setupReadinessProbe converts the readinessProbe of a container into a
+// readiness check.  Readiness checks run on their own timer with the
+// probe's period and do not require a healthcheck.
+func setupReadinessProbe(s *specgen.SpecGenerator, containerYAML v1.Container) error {
+	if containerYAML.ReadinessProbe == nil {
+		return nil
+	}
+	emptyHandler := v1.Handler{}
+	if containerYAML.ReadinessProbe.Handler == emptyHandler {
+		return nil
+	}
+	probe := containerYAML.ReadinessProbe
+	healthConfig, err := probeToHealthConfig(probe, containerYAML.Ports)
+	if err != nil {
+		return err
+	}
+	s.ReadinessConfig = &define.ReadinessCheck{
+		Schema2HealthConfig: *healthConfig,
+		Successes:           int(probe.SuccessThreshold),
+	}
+	return nil
+}
+
 func makeHealthCheck(inCmd string, interval int32, retries int32, timeout int32, startPeriod int32) (*manifest.Schema2HealthConfig, error) {
 	// Every healthcheck requires a command
 	if len(inCmd) == 0 {
@@ -7,6 +7,7 @@ import (
 	"runtime"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/containers/podman/v6/libpod/define"
 	v1 "github.com/containers/podman/v6/pkg/k8s.io/api/core/v1"
@@ -17,6 +18,7 @@ import (
 	spec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/assert"
 	"go.podman.io/common/pkg/secrets"
+	"go.podman.io/image/v5/manifest"
 	"go.podman.io/storage/pkg/system"
 	"sigs.k8s.io/yaml"
 )
@@ -1402,6 +1404,80 @@ func TestTCPLivenessProbe(t *testing.T) {
 	}
 }
 
+func TestReadinessProbe(t *testing.T) {
+	tests := []struct {
+		name              string
+		specGenerator     specgen.SpecGenerator
+		container         v1.Container
+		expectedTest      string
+		expectedHCTest    []string
+		expectedInterval  time.Duration
+		expectedSuccesses int
+	}{
+		{
+			"ReadinessProbeWithoutLivenessProbe",
+			specgen.SpecGenerator{},
+			v1.Container{
+				ReadinessProbe: &v1.Probe{
+					Handler: v1.Handler{
+						TCPSocket: &v1.TCPSocketAction{
+							Port: intstr.FromInt(8080),
+						},
+					},
+					PeriodSeconds:    3,
+					SuccessThreshold: 2,
+				},
+			},
+			"localhost",
+			nil,
+			3 * time.Second,
+			2,
+		},
+		{
+			"ReadinessProbeKeepsLivenessProbe",
+			specgen.SpecGenerator{
+				ContainerHealthCheckConfig: specgen.ContainerHealthCheckConfig{
+					HealthConfig: &manifest.Schema2HealthConfig{
+						Test: []string{define.HealthConfigTestCmd, "true"},
+					},
+				},
+			},
+			v1.Container{
+				ReadinessProbe: &v1.Probe{
+					Handler: v1.Handler{
+						HTTPGet: &v1.HTTPGetAction{
+							Port: intstr.FromInt(80),
+							Path: "/ready",
+						},
+					},
+					PeriodSeconds: 5,
+				},
+			},
+			"http://localhost:80/ready",
+			[]string{define.HealthConfigTestCmd, "true"},
+			5 * time.Second,
+			0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := setupReadinessProbe(&test.specGenerator, test.container)
+			assert.NoError(t, err)
+			readiness := test.specGenerator.ContainerHealthCheckConfig.ReadinessConfig
+			assert.NotNil(t, readiness)
+			assert.Contains(t, readiness.Test, test.expectedTest)
+			assert.Equal(t, test.expectedSuccesses, readiness.Successes)
+			assert.Equal(t, test.expectedInterval, readiness.Interval)
+			if test.expectedHCTest == nil {
+				assert.Nil(t, test.specGenerator.ContainerHealthCheckConfig.HealthConfig)
+			} else {
+				assert.Equal(t, test.expectedHCTest, test.specGenerator.ContainerHealthCheckConfig.HealthConfig.Test)
+			}
+		})
+	}
+}
+
 func TestDeviceResource(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -5,6 +5,7 @@ import (
 	"net"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/containers/podman/v6/libpod/define"
 	spec "github.com/opencontainers/runtime-spec/specs-go"
@@ -26,6 +27,19 @@ type LogConfig struct {
 	// Size is the maximum size of the log file
 	// Optional.
 	Size int64 `json:"size,omitempty"`
+	// MaxFiles is the maximum number of log files to keep when the log
+	// file is rotated, including the active one.
+	// Only available if LogDriver is set to "json-file" or "k8s-file".
+	// Optional.
+	MaxFiles uint `json:"max_files,omitempty"`
+	// Compress compresses rotated log files.
+	// Only available if LogDriver is set to "json-file" or "k8s-file".
+	// Optional.
+	Compress bool `json:"compress,omitempty"`
+	// MaxAge is the maximum age of rotated log files.
+	// Only available if LogDriver is set to "json-file" or "k8s-file".
+	// Optional.
+	MaxAge time.Duration `json:"max_age,omitempty"`
 	// A set of log labels to apply
 	// Only available if LogDriver is set to "journald".
 	// Optional
@@ -117,6 +131,21 @@ type ContainerBasicConfig struct {
 	// Only available when RestartPolicy is set to "on-failure".
 	// Optional.
 	RestartRetries *uint `json:"restart_tries,omitempty"`
+	// RestartDelay is the delay before the restart policy restarts the
+	// container. It is doubled on every consecutive restart.
+	// If not given, the container is restarted immediately.
+	// Optional.
+	RestartDelay *time.Duration `json:"restart_delay,omitempty"`
+	// RestartMaxDelay is the maximum delay before the restart policy
+	// restarts the container.
+	// Only available when RestartDelay is set.
+	// Optional.
+	RestartMaxDelay *time.Duration `json:"restart_max_delay,omitempty"`
+	// RestartResetWindow is the time the container must run before its
+	// restart delay is reset.
+	// Only available when RestartDelay is set.
+	// Optional.
+	RestartResetWindow *time.Duration `json:"restart_reset_window,omitempty"`
 	// OCIRuntime is the name of the OCI runtime that will be used to create
 	// the container.
 	// If not specified, the default will be used.
@@ -604,6 +633,9 @@ type ContainerHealthCheckConfig struct {
 	// Requires that HealthConfig be set.
 	// Optional.
 	StartupHealthConfig *define.StartupHealthCheck `json:"startupHealthConfig,omitempty"`
+	// Readiness check for a container.
+	// Optional.
+	ReadinessConfig *define.ReadinessCheck `json:"readinessConfig,omitempty"`
 	// HealthLogDestination defines the destination where the log is stored.
 	// TODO (6.0): In next major release convert it to pointer and use omitempty
 	HealthLogDestination string `json:"healthLogDestination"`
@@ -866,6 +866,24 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 				return err
 			}
 			s.LogConfiguration.Size = logSize
+		case "max-file":
+			maxFiles, err := strconv.ParseUint(val, 10, 32)
+			if err != nil || maxFiles == 0 {
+				return fmt.Errorf("invalid log option max-file %q: must be a positive integer", val)
+			}
+			s.LogConfiguration.MaxFiles = uint(maxFiles)
+		case "compress":
+			compress, err := strconv.ParseBool(val)
+			if err != nil {
+				return fmt.Errorf("invalid log option compress %q: %w", val, err)
+			}
+			s.LogConfiguration.Compress = compress
+		case "max-age":
+			maxAge, err := time.ParseDuration(val)
+			if err != nil || maxAge <= 0 {
+				return fmt.Errorf("invalid log option max-age %q: must be a positive duration", val)
+			}
+			s.LogConfiguration.MaxAge = maxAge
 		case "label":
 			labelKey, labelVal, hasVal := strings.Cut(val, "=")
 			if !hasVal {
@@ -909,6 +927,27 @@ func FillOutSpecGen(s *specgen.SpecGenerator, c *entities.ContainerCreateOptions
 		s.RestartPolicy = policy
 		s.RestartRetries = &retries
 	}
+	for _, d := range []struct {
+		flag string
+		val  string
+		dest **time.Duration
+	}{
+		{"restart-delay", c.RestartDelay, &s.RestartDelay},
+		{"restart-max-delay", c.RestartMaxDelay, &s.RestartMaxDelay},
+		{"restart-reset-window", c.RestartResetWindow, &s.RestartResetWindow},
+	} {
+		if d.val == "" {
+			continue
+		}
+		dur, err := time.ParseDuration(d.val)
+		if err != nil {
+			return fmt.Errorf("invalid --%s %q: %w", d.flag, d.val, err)
+		}
+		*d.dest = &dur
+	}
+	if s.RestartDelay == nil && (s.RestartMaxDelay != nil || s.RestartResetWindow != nil) {
+		return errors.New("--restart-max-delay and --restart-reset-window require --restart-delay")
+	}
 
 	if (len(s.Secrets) == 0 && len(s.EnvSecrets) == 0) || len(c.Secrets) != 0 {
 		s.Secrets, s.EnvSecrets, err = parseSecrets(c.Secrets)
@@ -0,0 +1,206 @@
+package systemd
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronMacros maps the predefined cron schedules to their five field form.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var cronMonths = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+var cronWeekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// cronField describes the valid values of a single cron field.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var (
+	cronMinute  = cronField{name: "minute", min: 0, max: 59}
+	cronHour    = cronField{name: "hour", min: 0, max: 23}
+	cronDay     = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth   = cronField{name: "month", min: 1, max: 12, names: cronMonths}
+	cronWeekday = cronField{name: "day of week", min: 0, max: 7, names: cronWeekdays}
+)
+
+// CronToOnCalendar converts a cron schedule as used by Kubernetes CronJobs
+// into a systemd OnCalendar expression.  If timeZone is not empty, it is
+// appended to the expression.
+func CronToOnCalendar(schedule, timeZone string) (string, error) {
+	schedule = strings.TrimSpace(schedule)
+	if strings.HasPrefix(schedule, "CRON_TZ=") || strings.HasPrefix(schedule, "TZ=") {
+		return "", fmt.Errorf("invalid schedule %q: time zones must be set via the timeZone field", schedule)
+	}
+	if expanded, ok := cronMacros[strings.ToLower(schedule)]; ok {
+		schedule = expanded
+	}
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return "", fmt.Errorf("invalid schedule %q: expected 5 fields, found %d", schedule, len(fields))
+	}
+
+	minutes, err := cronMinute.parse(fields[0])
+	if err != nil {
+		return "", err
+	}
+	hours, err := cronHour.parse(fields[1])
+	if err != nil {
+		return "", err
+	}
+	days, err := cronDay.parse(fields[2])
+	if err != nil {
+		return "", err
+	}
+	months, err := cronMonth.parse(fields[3])
+	if err != nil {
+		return "", err
+	}
+	weekdays, err := cronWeekday.parse(fields[4])
+	if err != nil {
+		return "", err
+	}
+	// Sunday can be written as both 0 and 7.
+	if slices.Contains(weekdays, 7) {
+		weekdays = slices.DeleteFunc(weekdays, func(d int) bool { return d == 7 })
+		if !slices.Contains(weekdays, 0) {
+			weekdays = append([]int{0}, weekdays...)
+		}
+	}
+
+	// If both the day of month and the day of week are restricted, cron
+	// runs the job when either matches while systemd requires both to match.
+	dayRestricted := fields[2] != "*" && fields[2] != "?"
+	weekdayRestricted := fields[4] != "*" && fields[4] != "?"
+	if dayRestricted && weekdayRestricted {
+		return "", fmt.Errorf("invalid schedule %q: restricting both day of month and day of week is not supported", schedule)
+	}
+
+	var sb strings.Builder
+	if weekdayRestricted && len(weekdays) < len(cronWeekdays) {
+		names := make([]string, 0, len(weekdays))
+		for _, d := range weekdays {
+			name := cronWeekdays[d]
+			names = append(names, strings.ToUpper(name[:1])+name[1:])
+		}
+		sb.WriteString(strings.Join(names, ","))
+		sb.WriteString(" ")
+	}
+	fmt.Fprintf(&sb, "*-%s-%s %s:%s:00",
+		cronMonth.format(months), cronDay.format(days), cronHour.format(hours), cronMinute.format(minutes))
+
+	if timeZone != "" {
+		if _, err := time.LoadLocation(timeZone); err != nil {
+			return "", fmt.Errorf("invalid time zone %q: %w", timeZone, err)
+		}
+		sb.WriteString(" ")
+		sb.WriteString(timeZone)
+	}
+	return sb.String(), nil
+}
+
+// parse expands a single cron field into the sorted list of values it matches.
+func (f cronField) parse(field string) ([]int, error) {
+	values := []int{}
+	for part := range strings.SplitSeq(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			s, err := strconv.Atoi(stepStr)
+			if err != nil || s < 1 {
+				return nil, fmt.Errorf("invalid step %q in %s field", stepStr, f.name)
+			}
+			step = s
+		}
+
+		start, end := f.min, f.max
+		switch {
+		case rng == "*" || rng == "?":
+		case strings.Contains(rng, "-"):
+			first, last, _ := strings.Cut(rng, "-")
+			var err error
+			if start, err = f.value(first); err != nil {
+				return nil, err
+			}
+			if end, err = f.value(last); err != nil {
+				return nil, err
+			}
+			if start > end {
+				return nil, fmt.Errorf("invalid range %q in %s field", rng, f.name)
+			}
+		default:
+			var err error
+			if start, err = f.value(rng); err != nil {
+				return nil, err
+			}
+			// "a/n" means every n starting at a.
+			if !hasStep {
+				end = start
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			if !slices.Contains(values, v) {
+				values = append(values, v)
+			}
+		}
+	}
+	slices.Sort(values)
+	return values, nil
+}
+
+// value parses a single numeric or named value of the field.
+func (f cronField) value(s string) (int, error) {
+	if i := slices.Index(f.names, strings.ToLower(s)); i >= 0 {
+		if f.min == 1 {
+			return i + 1, nil
+		}
+		return i, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value %q in %s field", s, f.name)
+	}
+	return v, nil
+}
+
+// format returns the systemd calendar representation of the values.
+func (f cronField) format(values []int) string {
+	if len(values) == f.max-f.min+1 {
+		return "*"
+	}
+	// Use the shorter repetition syntax for "*/n".
+	if len(values) > 2 && values[0] == f.min {
+		step := values[1] - values[0]
+		repeated := true
+		for i := 1; i < len(values); i++ {
+			if values[i]-values[i-1] != step {
+				repeated = false
+				break
+			}
+		}
+		if repeated && values[len(values)-1]+step > f.max {
+			return fmt.Sprintf("%02d/%d", f.min, step)
+		}
+	}
+	strs := make([]string, 0, len(values))
+	for _, v := range values {
+		strs = append(strs, fmt.Sprintf("%02d", v))
+	}
+	return strings.Join(strs, ",")
+}
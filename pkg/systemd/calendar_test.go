@@ -0,0 +1,61 @@
+package systemd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronToOnCalendar(t *testing.T) {
+	tests := []struct {
+		schedule string
+		timeZone string
+		expected string
+	}{
+		{"* * * * *", "", "*-*-* *:*:00"},
+		{"*/5 * * * *", "", "*-*-* *:00/5:00"},
+		{"30 2 * * *", "", "*-*-* 02:30:00"},
+		{"0 9-17 * * 1-5", "", "Mon,Tue,Wed,Thu,Fri *-*-* 09,10,11,12,13,14,15,16,17:00:00"},
+		{"0 0 1 JAN,jul *", "", "*-01,07-01 00:00:00"},
+		{"15 4 * * sun,7", "", "Sun *-*-* 04:15:00"},
+		{"0 0 * * 0-7", "", "*-*-* 00:00:00"},
+		{"5/20 * * * *", "", "*-*-* *:05,25,45:00"},
+		{"@daily", "", "*-*-* 00:00:00"},
+		{"@weekly", "", "Sun *-*-* 00:00:00"},
+		{"@hourly", "UTC", "*-*-* *:00:00 UTC"},
+		{"0 6 * * *", "Europe/Berlin", "*-*-* 06:00:00 Europe/Berlin"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			got, err := CronToOnCalendar(tt.schedule, tt.timeZone)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestCronToOnCalendarErrors(t *testing.T) {
+	tests := []struct {
+		schedule string
+		timeZone string
+	}{
+		{"* * * *", ""},
+		{"60 * * * *", ""},
+		{"* 24 * * *", ""},
+		{"* * 0 * *", ""},
+		{"* * * 13 *", ""},
+		{"* * * * 8", ""},
+		{"*/0 * * * *", ""},
+		{"10-5 * * * *", ""},
+		{"0 0 1 * 1", ""},
+		{"CRON_TZ=UTC 0 0 * * *", ""},
+		{"0 0 * * *", "Not/AZone"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			_, err := CronToOnCalendar(tt.schedule, tt.timeZone)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -147,3 +147,26 @@ func ConnectToDBUS() (*dbus.Conn, error) {
 	}
 	return dbus.NewSystemdConnectionContext(context.Background())
 }
+
+// UnitJob is a method of a systemd D-Bus connection queueing a job for a unit,
+// such as StartUnitContext.
+type UnitJob func(ctx context.Context, name, mode string, ch chan<- string) (int, error)
+
+// RunUnitJob queues a job for the unit with the given name, replacing any job
+// already queued for it, and waits for the job to complete.
+func RunUnitJob(ctx context.Context, job UnitJob, name string) error {
+	ch := make(chan string, 1)
+	if _, err := job(ctx, name, "replace", ch); err != nil {
+		return err
+	}
+	logrus.Debugf("Waiting for job of systemd unit %s", name)
+	select {
+	case result := <-ch:
+		if result != "done" && result != "skipped" {
+			return fmt.Errorf("job for systemd unit %s finished with result %q", name, result)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
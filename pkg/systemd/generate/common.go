@@ -87,12 +87,12 @@ func filterCommonContainerFlags(command []string, argCount int) []string {
 	return processed
 }
 
-// escapeSystemdArguments makes sure that all arguments with at least one whitespace
+// EscapeSystemdArguments makes sure that all arguments with at least one whitespace
 // are quoted to make sure those are interpreted as one argument instead of
 // multiple ones. Also make sure to escape all characters which have a special
 // meaning to systemd -> $,% and \
 // see: https://www.freedesktop.org/software/systemd/man/systemd.service.html#Command%20lines
-func escapeSystemdArguments(command []string) []string {
+func EscapeSystemdArguments(command []string) []string {
 	for i := range command {
 		command[i] = escapeSystemdArg(command[i])
 	}
@@ -213,7 +213,7 @@ func TestEscapeSystemdArguments(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		quoted := escapeSystemdArguments(test.input)
+		quoted := EscapeSystemdArguments(test.input)
 		assert.Equal(t, test.output, quoted)
 	}
 }
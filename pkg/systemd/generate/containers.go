@@ -346,7 +346,7 @@ func executeContainerTemplate(info *containerInfo, options entities.GenerateSyst
 		startCommand := []string{info.Executable}
 		if index > 2 {
 			// include root flags
-			info.RootFlags = strings.Join(escapeSystemdArguments(info.CreateCommand[1:index-1]), " ")
+			info.RootFlags = strings.Join(EscapeSystemdArguments(info.CreateCommand[1:index-1]), " ")
 			startCommand = append(startCommand, info.CreateCommand[1:index-1]...)
 		}
 		startCommand = append(startCommand,
@@ -480,7 +480,7 @@ func executeContainerTemplate(info *containerInfo, options entities.GenerateSyst
 		}
 
 		startCommand = append(startCommand, remainingCmd...)
-		startCommand = escapeSystemdArguments(startCommand)
+		startCommand = EscapeSystemdArguments(startCommand)
 		if options.TemplateUnitFile {
 			info.IdentifySpecifier = true
 			startCommand, err = setContainerNameForTemplate(startCommand, info)
@@ -325,7 +325,7 @@ func executePodTemplate(info *podInfo, options entities.GenerateSystemdOptions)
 				return "", fmt.Errorf("pod does not appear to be created via `podman pod create`: %v", info.CreateCommand)
 			}
 			podRootArgs = info.CreateCommand[1 : podCreateIndex-1]
-			info.RootFlags = strings.Join(escapeSystemdArguments(podRootArgs), " ")
+			info.RootFlags = strings.Join(EscapeSystemdArguments(podRootArgs), " ")
 			podCreateArgs = filterPodFlags(info.CreateCommand[podCreateIndex+1:], 0)
 		}
 		// We're hard-coding the first five arguments and append the
@@ -367,7 +367,7 @@ func executePodTemplate(info *podInfo, options entities.GenerateSystemdOptions)
 			startCommand = append(startCommand, "--exit-policy=stop")
 		}
 		startCommand = append(startCommand, podCreateArgs...)
-		startCommand = escapeSystemdArguments(startCommand)
+		startCommand = EscapeSystemdArguments(startCommand)
 
 		info.ExecStartPre = formatOptions(startCommand)
 		info.ExecStart = formatOptionsString("{{{{.Executable}}}} {{{{if .RootFlags}}}}{{{{ .RootFlags}}}} {{{{end}}}}pod start --pod-id-file {{{{.PodIDFile}}}}")
@@ -28,6 +28,10 @@ type unitLine struct {
 	key       string
 	value     string
 	isComment bool
+
+	// Where the line was parsed from, empty for lines added in memory
+	path   string
+	lineNr int
 }
 
 type unitGroup struct {
@@ -44,6 +48,20 @@ type UnitFile struct {
 	Path     string
 }
 
+// LineError is an error parsing a line of a unit file.
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
 type UnitFileParser struct {
 	file *UnitFile
 
@@ -65,7 +83,10 @@ func (l *unitLine) set(value string) {
 }
 
 func (l *unitLine) dup() *unitLine {
-	return newUnitLine(l.key, l.value, l.isComment)
+	d := newUnitLine(l.key, l.value, l.isComment)
+	d.path = l.path
+	d.lineNr = l.lineNr
+	return d
 }
 
 func (l *unitLine) isKey(key string) bool {
@@ -317,7 +338,7 @@ func (p *UnitFileParser) parseGroup(line string) error {
 	return nil
 }
 
-func (p *UnitFileParser) parseKeyValuePair(line string) error {
+func (p *UnitFileParser) parseKeyValuePair(line string, lineNr int) error {
 	if p.currentGroup == nil {
 		return fmt.Errorf("key file does not start with a group")
 	}
@@ -344,7 +365,10 @@ func (p *UnitFileParser) parseKeyValuePair(line string) error {
 
 	p.flushPendingComments(false)
 
-	p.currentGroup.add(key, value)
+	l := newUnitLine(key, value, false)
+	l.path = p.file.Path
+	l.lineNr = lineNr
+	p.currentGroup.addLine(l)
 
 	return nil
 }
@@ -356,7 +380,7 @@ func (p *UnitFileParser) parseLine(line string, lineNr int) error {
 	case lineIsGroup(line):
 		return p.parseGroup(line)
 	case lineIsKeyValuePair(line):
-		return p.parseKeyValuePair(line)
+		return p.parseKeyValuePair(line, lineNr)
 	default:
 		return fmt.Errorf("file contains line %d: “%s” which is not a key-value pair, group, or comment", lineNr, line)
 	}
@@ -386,6 +410,7 @@ func (f *UnitFile) Parse(data string) error {
 
 	lines := strings.Split(strings.TrimSuffix(data, "\n"), "\n")
 	remaining := ""
+	startNr := 0
 
 	for lineNr, line := range lines {
 		line = strings.TrimSpace(line)
@@ -394,7 +419,11 @@ func (f *UnitFile) Parse(data string) error {
 			if remaining != "" {
 				continue
 			}
+			startNr = lineNr
 		} else {
+			if remaining == "" {
+				startNr = lineNr
+			}
 			if strings.HasSuffix(line, "\\") {
 				line = line[:len(line)-1]
 				if lineNr != len(lines)-1 {
@@ -408,8 +437,8 @@ func (f *UnitFile) Parse(data string) error {
 				remaining = ""
 			}
 		}
-		if err := p.parseLine(line, lineNr+1); err != nil {
-			return err
+		if err := p.parseLine(line, startNr+1); err != nil {
+			return &LineError{Line: startNr + 1, Err: err}
 		}
 	}
 
@@ -594,6 +623,23 @@ func (f *UnitFile) LookupLastRaw(groupName string, key string) (string, bool) {
 	return line.value, true
 }
 
+// Get the file and line number where the last instance of the key was
+// defined. The file is the path of the drop-in for keys merged from one, and
+// the location is unknown for keys which were not parsed from a file.
+func (f *UnitFile) LookupLocation(groupName string, key string) (string, int, bool) {
+	g, ok := f.groupByName[groupName]
+	if !ok {
+		return "", 0, false
+	}
+
+	line := g.findLast(key)
+	if line == nil || line.lineNr == 0 {
+		return "", 0, false
+	}
+
+	return line.path, line.lineNr, true
+}
+
 func (f *UnitFile) HasKey(groupName string, key string) bool {
 	_, ok := f.LookupLastRaw(groupName, key)
 	return ok
@@ -341,6 +341,55 @@ Name=my-container
 	assert.Equal(t, "; another comment", comments[1])
 }
 
+func TestLookupLocation(t *testing.T) {
+	unit := `[Container]
+# comment
+Image=alpine
+Exec=sh -c \
+  "sleep inf"
+Image=fedora
+
+[Service]
+Restart=always
+`
+	f := NewUnitFile()
+	f.Path = "/etc/containers/systemd/test.container"
+	err := f.Parse(unit)
+	assert.NoError(t, err)
+
+	path, line, ok := f.LookupLocation("Container", "Image")
+	assert.True(t, ok)
+	assert.Equal(t, f.Path, path)
+	assert.Equal(t, 6, line)
+
+	_, line, ok = f.LookupLocation("Container", "Exec")
+	assert.True(t, ok)
+	assert.Equal(t, 4, line)
+
+	dropin := NewUnitFile()
+	dropin.Path = "/etc/containers/systemd/test.container.d/10-restart.conf"
+	err = dropin.Parse("[Service]\n\nRestart=no\n")
+	assert.NoError(t, err)
+	f.Merge(dropin)
+
+	path, line, ok = f.LookupLocation("Service", "Restart")
+	assert.True(t, ok)
+	assert.Equal(t, dropin.Path, path)
+	assert.Equal(t, 3, line)
+
+	f.Add("Service", "Type", "notify")
+	_, _, ok = f.LookupLocation("Service", "Type")
+	assert.False(t, ok)
+
+	_, _, ok = f.LookupLocation("Unit", "Description")
+	assert.False(t, ok)
+
+	err = f.Parse("[Container]\nImage=alpine\nnot a key\n")
+	var lineErr *LineError
+	assert.ErrorAs(t, err, &lineErr)
+	assert.Equal(t, 3, lineErr.Line)
+}
+
 func FuzzParser(f *testing.F) {
 	for _, sample := range samples {
 		f.Add([]byte(sample))
@@ -0,0 +1,315 @@
+package quadlet
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/containers/podman/v6/pkg/logiface"
+	"github.com/containers/podman/v6/pkg/systemd/parser"
+)
+
+// This file contains the steps of the conversion shared by the generator and
+// `podman quadlet validate`, which converts Quadlets without installing them.
+
+// LoadUnitDropins merges the drop-in files of the unit found in any of the
+// source paths into it.
+func LoadUnitDropins(unit *parser.UnitFile, sourcePaths []string) error {
+	var prevError error
+	reportError := func(err error) {
+		if prevError != nil {
+			err = fmt.Errorf("%s\n%s", prevError, err)
+		}
+		prevError = err
+	}
+
+	unitDropinPaths := unit.GetUnitDropinPaths()
+	dropinDirs := make([]string, 0, len(unitDropinPaths))
+	for _, dropinPath := range unitDropinPaths {
+		for _, sourcePath := range sourcePaths {
+			dropinDirs = append(dropinDirs, path.Join(sourcePath, dropinPath))
+		}
+	}
+
+	dropinPaths := make(map[string]string)
+	for _, dropinDir := range dropinDirs {
+		dropinFiles, err := os.ReadDir(dropinDir)
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				reportError(fmt.Errorf("error reading directory %q, %w", dropinDir, err))
+			}
+
+			continue
+		}
+
+		for _, dropinFile := range dropinFiles {
+			dropinName := dropinFile.Name()
+			if filepath.Ext(dropinName) != ".conf" {
+				continue // Only *.conf supported
+			}
+
+			if _, ok := dropinPaths[dropinName]; ok {
+				continue // We already saw this name
+			}
+
+			dropinPaths[dropinName] = path.Join(dropinDir, dropinName)
+		}
+	}
+
+	dropinFiles := make([]string, len(dropinPaths))
+	i := 0
+	for k := range dropinPaths {
+		dropinFiles[i] = k
+		i++
+	}
+
+	// Merge in alpha-numerical order
+	sort.Strings(dropinFiles)
+
+	for _, dropinFile := range dropinFiles {
+		dropinPath := dropinPaths[dropinFile]
+
+		logiface.Debugf("Loading source drop-in file %s", dropinPath)
+
+		if f, err := parser.ParseUnitFile(dropinPath); err != nil {
+			reportError(fmt.Errorf("error loading %q, %w", dropinPath, err))
+		} else {
+			unit.Merge(f)
+		}
+	}
+
+	return prevError
+}
+
+// SortUnits sorts unit files according to potential inter-dependencies, with
+// Volume and Network units taking precedence over all others.
+func SortUnits(units []*parser.UnitFile) {
+	sort.Slice(units, func(i, j int) bool {
+		getOrder := func(i int) int {
+			ext := filepath.Ext(units[i].Filename)
+			order, ok := SupportedExtensions[ext]
+			if !ok {
+				return 0
+			}
+			return order
+		}
+		return getOrder(i) < getOrder(j)
+	})
+}
+
+// GenerateUnitsInfoMap returns the information about the units needed to
+// convert units referring to each other.
+func GenerateUnitsInfoMap(units []*parser.UnitFile) map[string]*UnitInfo {
+	unitsInfoMap := make(map[string]*UnitInfo)
+	for _, unit := range units {
+		var serviceName string
+		var containers []string
+		var resourceName string
+		var err error
+
+		serviceName, err = GetUnitServiceName(unit)
+		if err != nil {
+			logiface.Errorf("Error obtaining service name: %v", err)
+		}
+
+		switch {
+		case strings.HasSuffix(unit.Filename, ".container"):
+			// Prefill resourceNames for .container files. This solves network reusing.
+			resourceName = GetContainerResourceName(unit)
+		case strings.HasSuffix(unit.Filename, ".build"):
+			// Prefill resourceNames for .build files. This is significantly less complex than
+			// pre-computing all resourceNames for all Quadlet types (which is rather complex for a few
+			// types), but still breaks the dependency cycle between .volume and .build ([Volume] can
+			// have Image=some.build, and [Build] can have Volume=some.volume:/some-volume)
+			resourceName = GetBuiltImageName(unit)
+		case strings.HasSuffix(unit.Filename, ".artifact"):
+			serviceName = GetArtifactServiceName(unit)
+		case strings.HasSuffix(unit.Filename, ".pod"):
+			containers = make([]string, 0)
+			// Prefill resourceNames for .pod files.
+			// This is requires for referencing the pod from .container files
+			resourceName = GetPodResourceName(unit)
+		case strings.HasSuffix(unit.Filename, ".volume"), strings.HasSuffix(unit.Filename, ".kube"), strings.HasSuffix(unit.Filename, ".network"), strings.HasSuffix(unit.Filename, ".image"):
+			// Do nothing for these case.
+		default:
+			// ConvertUnit warns about files of unsupported types.
+			continue
+		}
+
+		unitsInfoMap[unit.Filename] = &UnitInfo{
+			ServiceName:       serviceName,
+			ContainersToStart: containers,
+			ResourceName:      resourceName,
+		}
+	}
+
+	return unitsInfoMap
+}
+
+// ConvertUnit converts a Quadlet unit of any supported type to the systemd
+// units generated from it: its service and, for containers with a Timer
+// group, its timer. Problems which do not prevent the conversion, like
+// unsupported keys, are returned in warnings and the ones which do in err.
+// Nothing is generated, with a warning, for files of unsupported types and for
+// templates that can only be converted for their instances.
+func ConvertUnit(unit *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, isUser bool) (units []*parser.UnitFile, warnings, err error) {
+	var service, timer *parser.UnitFile
+	var warn error
+
+	warnings = checkUnsupportedServiceKeys(unit)
+
+	switch {
+	case strings.HasSuffix(unit.Filename, ".container"):
+		warnings = errors.Join(warnings, checkAmbiguousName(unit, ContainerGroup))
+		service, warn, err = ConvertContainer(unit, unitsInfoMap, isUser)
+		if err == nil {
+			timer, err = ConvertTimer(unit, unitsInfoMap)
+		}
+	case strings.HasSuffix(unit.Filename, ".volume"):
+		warnings = errors.Join(warnings, checkAmbiguousName(unit, VolumeGroup))
+		service, warn, err = ConvertVolume(unit, unitsInfoMap, isUser)
+	case strings.HasSuffix(unit.Filename, ".kube"):
+		service, err = ConvertKube(unit, unitsInfoMap, isUser)
+	case strings.HasSuffix(unit.Filename, ".network"):
+		service, warn, err = ConvertNetwork(unit, unitsInfoMap, isUser)
+	case strings.HasSuffix(unit.Filename, ".image"):
+		warnings = errors.Join(warnings, checkAmbiguousName(unit, ImageGroup))
+		service, err = ConvertImage(unit, unitsInfoMap, isUser)
+	case strings.HasSuffix(unit.Filename, ".build"):
+		service, warn, err = ConvertBuild(unit, unitsInfoMap, isUser)
+	case strings.HasSuffix(unit.Filename, ".artifact"):
+		warnings = errors.Join(warnings, checkAmbiguousName(unit, ArtifactGroup))
+		service, err = ConvertArtifact(unit, unitsInfoMap, isUser)
+	case strings.HasSuffix(unit.Filename, ".pod"):
+		service, warn, err = ConvertPod(unit, unitsInfoMap, isUser)
+	default:
+		// Stray files in the unit directories are skipped.
+		return nil, errors.Join(warnings, fmt.Errorf("unsupported file type %q", unit.Filename)), nil
+	}
+
+	warnings = errors.Join(warnings, warn)
+	if errors.Is(err, ErrInstanceRequired) {
+		// Not an error, the instances of the template are converted.
+		return nil, errors.Join(warnings, err), nil
+	}
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	units = []*parser.UnitFile{service}
+	if timer != nil {
+		units = append(units, timer)
+	}
+	return units, warnings, nil
+}
+
+// Splits the errors or warnings returned by a conversion into a list of
+// KeyErrors, with an empty group and key for errors not about a single key.
+func SplitKeyErrors(err error) []*KeyError {
+	if err == nil {
+		return nil
+	}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var keyErrs []*KeyError
+		for _, e := range joined.Unwrap() {
+			keyErrs = append(keyErrs, SplitKeyErrors(e)...)
+		}
+		return keyErrs
+	}
+
+	var keyErr *KeyError
+	if errors.As(err, &keyErr) {
+		return []*KeyError{{Group: keyErr.Group, Key: keyErr.Key, Err: err}}
+	}
+
+	return []*KeyError{{Err: err}}
+}
+
+func isImageID(imageName string) bool {
+	// All sha25:... names are assumed by podman to be fully specified
+	if strings.HasPrefix(imageName, "sha256:") {
+		return true
+	}
+
+	// However, podman also accepts image ids as pure hex strings,
+	// but only those of length 64 are unambiguous image ids
+	if len(imageName) != 64 {
+		return false
+	}
+
+	for _, c := range imageName {
+		if !unicode.Is(unicode.Hex_Digit, c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isUnambiguousName(imageName string) bool {
+	// Fully specified image ids are unambiguous
+	if isImageID(imageName) {
+		return true
+	}
+
+	// Otherwise we require a fully qualified name
+	before, _, ok := strings.Cut(imageName, "/")
+	if !ok {
+		// No domain or path, not fully qualified
+		return false
+	}
+
+	// What is before the first slash can be a domain or a path
+	domain := before
+
+	// If its a domain (has dot or port or is "localhost") it is considered fq
+	if strings.ContainsAny(domain, ".:") || domain == "localhost" {
+		return true
+	}
+
+	return false
+}
+
+// warns if input is an ambiguous name, i.e. a partial image id or a short
+// name (i.e. is missing a registry)
+//
+// Examples:
+//   - short names: "image:tag", "library/fedora"
+//   - fully qualified names: "quay.io/image", "localhost/image:tag",
+//     "server.org:5000/lib/image", "sha256:..."
+//
+// We implement a simple version of this from scratch here to avoid
+// a huge dependency in the generator just for a warning.
+func checkAmbiguousName(unit *parser.UnitFile, group string) error {
+	imageName, ok := unit.Lookup(group, KeyImage)
+	if !ok {
+		return nil
+	}
+	if strings.HasSuffix(imageName, ".build") || strings.HasSuffix(imageName, ".image") {
+		return nil
+	}
+	if !isUnambiguousName(imageName) {
+		return keyError(group, KeyImage, fmt.Errorf("%s specifies the image \"%s\" which not a fully qualified image name. This is not ideal for performance and security reasons. See the podman-pull manpage discussion of short-name-aliases.conf for details.", unit.Filename, imageName))
+	}
+	return nil
+}
+
+// Warns if the unit has any properties defined in the Service group that are known to cause issues.
+// We want to warn instead of erroring to avoid breaking any existing users' units,
+// or to allow users to use these properties if they know what they are doing.
+func checkUnsupportedServiceKeys(unit *parser.UnitFile) error {
+	var warnings error
+	for _, key := range UnsupportedServiceKeys {
+		_, hasKey := unit.Lookup(ServiceGroup, key)
+		if hasKey {
+			warnings = errors.Join(warnings, keyError(ServiceGroup, key, fmt.Errorf("using key %s in the Service group is not supported - use at your own risk", key)))
+		}
+	}
+	return warnings
+}
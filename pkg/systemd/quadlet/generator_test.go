@@ -0,0 +1,107 @@
+//go:build linux
+
+package quadlet
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/containers/podman/v6/pkg/systemd/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsUnambiguousName(t *testing.T) {
+	tests := []struct {
+		input string
+		res   bool
+	}{
+		// Ambiguous names
+		{"fedora", false},
+		{"fedora:latest", false},
+		{"library/fedora", false},
+		{"library/fedora:latest", false},
+		{"busybox@sha256:d366a4665ab44f0648d7a00ae3fae139d55e32f9712c67accd604bb55df9d05a", false},
+		{"busybox:latest@sha256:d366a4665ab44f0648d7a00ae3fae139d55e32f9712c67accd604bb55df9d05a", false},
+		{"d366a4665ab44f0648d7a00ae3fae139d55e32f9712c67accd604bb55df9d05", false},
+		{"d366a4665ab44f0648d7a00ae3fae139d55e32f9712c67accd604bb55df9d05aa", false},
+
+		// Unambiguous names
+		{"quay.io/fedora", true},
+		{"docker.io/fedora", true},
+		{"docker.io/library/fedora:latest", true},
+		{"localhost/fedora", true},
+		{"localhost:5000/fedora:latest", true},
+		{"example.foo.this.may.be.garbage.but.maybe.not:1234/fedora:latest", true},
+		{"docker.io/library/busybox@sha256:d366a4665ab44f0648d7a00ae3fae139d55e32f9712c67accd604bb55df9d05a", true},
+		{"docker.io/library/busybox:latest@sha256:d366a4665ab44f0648d7a00ae3fae139d55e32f9712c67accd604bb55df9d05a", true},
+		{"docker.io/fedora@sha256:d366a4665ab44f0648d7a00ae3fae139d55e32f9712c67accd604bb55df9d05a", true},
+		{"sha256:d366a4665ab44f0648d7a00ae3fae139d55e32f9712c67accd604bb55df9d05a", true},
+		{"d366a4665ab44f0648d7a00ae3fae139d55e32f9712c67accd604bb55df9d05a", true},
+	}
+
+	for _, test := range tests {
+		res := isUnambiguousName(test.input)
+		assert.Equal(t, res, test.res, "%q", test.input)
+	}
+}
+
+func TestConvertUnitKeyErrors(t *testing.T) {
+	unit := parser.NewUnitFile()
+	unit.Filename = "test.container"
+	unit.Path = "/etc/containers/systemd/test.container"
+	err := unit.Parse(`[Container]
+Image=fedora
+Bogus=1
+Environment=FOO=bar\q
+Other=2
+
+[Service]
+User=root
+`)
+	require.NoError(t, err)
+
+	unitsInfoMap := GenerateUnitsInfoMap([]*parser.UnitFile{unit})
+	_, warnings, err := ConvertUnit(unit, unitsInfoMap, false)
+
+	errs := SplitKeyErrors(err)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "Bogus", errs[0].Key)
+	assert.Equal(t, ContainerGroup, errs[0].Group)
+	assert.Equal(t, "Other", errs[1].Key)
+	_, line, ok := unit.LookupLocation(errs[1].Group, errs[1].Key)
+	assert.True(t, ok)
+	assert.Equal(t, 5, line)
+
+	warns := SplitKeyErrors(warnings)
+	require.Len(t, warns, 2)
+	assert.Equal(t, ServiceGroup, warns[0].Group)
+	assert.Equal(t, "User", warns[0].Key)
+	assert.Equal(t, KeyImage, warns[1].Key)
+	assert.Contains(t, warns[1].Error(), "not a fully qualified image name")
+
+	unit.Unset(ContainerGroup, "Bogus")
+	unit.Unset(ContainerGroup, "Other")
+	generated, warnings, err := ConvertUnit(unit, unitsInfoMap, false)
+	require.NoError(t, err)
+	require.Len(t, generated, 1)
+	assert.Equal(t, "test.service", generated[0].Filename)
+
+	warns = SplitKeyErrors(warnings)
+	require.Len(t, warns, 3)
+	assert.Equal(t, KeyEnvironment, warns[2].Key)
+	assert.Contains(t, warns[2].Error(), "unsupported escape char")
+
+	assert.Equal(t, []*KeyError{{Err: errors.New("plain")}}, SplitKeyErrors(errors.New("plain")))
+}
+
+func TestConvertUnitUnsupportedType(t *testing.T) {
+	unit := parser.NewUnitFile()
+	unit.Filename = "notes.txt"
+	unitsInfoMap := GenerateUnitsInfoMap([]*parser.UnitFile{unit})
+
+	generated, warnings, err := ConvertUnit(unit, unitsInfoMap, false)
+	require.NoError(t, err)
+	assert.Empty(t, generated)
+	assert.ErrorContains(t, warnings, `unsupported file type "notes.txt"`)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/containers/podman/v6/pkg/specgenutilexternal"
@@ -34,6 +35,7 @@ const (
 	NetworkGroup    = "Network"
 	PodGroup        = "Pod"
 	ServiceGroup    = "Service"
+	TimerGroup      = "Timer"
 	UnitGroup       = "Unit"
 	VolumeGroup     = "Volume"
 	ImageGroup      = "Image"
@@ -147,6 +149,7 @@ const (
 	KeyPodName               = "PodName"
 	KeyPolicy                = "Policy"
 	KeyPublishPort           = "PublishPort"
+	KeyPublishPortOffset     = "PublishPortOffset"
 	KeyPull                  = "Pull"
 	KeyQuiet                 = "Quiet"
 	KeyReadOnly              = "ReadOnly"
@@ -224,6 +227,10 @@ var (
 	URL            = regexp.Delayed(`^((https?)|(git)://)|(github\.com/).+$`)
 	validPortRange = regexp.Delayed(`\d+(-\d+)?(/udp|/tcp)?$`)
 
+	// ErrInstanceRequired is returned when converting a template that can
+	// only be converted for its instances, like foo@bar.container.
+	ErrInstanceRequired = errors.New("only the instances of the template are generated")
+
 	unitDependencyKeys = []string{
 		"After",
 		"Before",
@@ -303,6 +310,7 @@ var (
 				KeyPod:                   true,
 				KeyPodmanArgs:            true,
 				KeyPublishPort:           true,
+				KeyPublishPortOffset:     true,
 				KeyPull:                  true,
 				KeyReadOnly:              true,
 				KeyReadOnlyTmpfs:         true,
@@ -404,6 +412,7 @@ var (
 				KeyNetwork:              true,
 				KeyPodmanArgs:           true,
 				KeyPublishPort:          true,
+				KeyPublishPortOffset:    true,
 				KeyRemapGid:             true,
 				KeyRemapUid:             true,
 				KeyRemapUidSize:         true,
@@ -512,6 +521,7 @@ var (
 				KeyPodName:              true,
 				KeyPodmanArgs:           true,
 				KeyPublishPort:          true,
+				KeyPublishPortOffset:    true,
 				KeyRemapGid:             true,
 				KeyRemapUid:             true,
 				KeyRemapUidSize:         true,
@@ -538,6 +548,10 @@ func (u *UnitInfo) ServiceFileName() string {
 	return fmt.Sprintf("%s.service", u.ServiceName)
 }
 
+func (u *UnitInfo) TimerFileName() string {
+	return fmt.Sprintf("%s.timer", u.ServiceName)
+}
+
 func removeExtension(name string, extraPrefix string, extraSuffix string) string {
 	baseName := name
 
@@ -557,15 +571,41 @@ func isPortRange(port string) bool {
 	return validPortRange.MatchString(port)
 }
 
+// KeyError is an error or a warning about a key of a Quadlet unit. The key
+// may be missing from the unit if the error is about it being required.
+type KeyError struct {
+	Group string
+	Key   string
+	Err   error
+}
+
+func (e *KeyError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *KeyError) Unwrap() error {
+	return e.Err
+}
+
+// keyError returns err as a KeyError about the key in the group, or nil if
+// err is nil.
+func keyError(groupName string, key string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &KeyError{Group: groupName, Key: key, Err: err}
+}
+
 func checkForUnknownKeysInSpecificGroup(unit *parser.UnitFile, groupName string, supportedKeys map[string]bool) error {
+	var errs error
 	keys := unit.ListKeys(groupName)
 	for _, key := range keys {
 		if !supportedKeys[key] {
-			return fmt.Errorf("unsupported key '%s' in group '%s' in %s", key, groupName, unit.Path)
+			errs = errors.Join(errs, keyError(groupName, key, fmt.Errorf("unsupported key '%s' in group '%s' in %s", key, groupName, unit.Path)))
 		}
 	}
 
-	return nil
+	return errs
 }
 
 func checkForUnknownKeys(unit *parser.UnitFile, groupName string, supportedKeys map[string]bool) error {
@@ -608,10 +648,10 @@ func ConvertContainer(container *parser.UnitFile, unitsInfoMap map[string]*UnitI
 	image, _ := container.Lookup(ContainerGroup, KeyImage)
 	rootfs, _ := container.Lookup(ContainerGroup, KeyRootfs)
 	if len(image) == 0 && len(rootfs) == 0 {
-		return nil, warnings, fmt.Errorf("no Image or Rootfs key specified")
+		return nil, warnings, keyError(ContainerGroup, KeyImage, fmt.Errorf("no Image or Rootfs key specified"))
 	}
 	if len(image) > 0 && len(rootfs) > 0 {
-		return nil, warnings, fmt.Errorf("the Image And Rootfs keys conflict can not be specified together")
+		return nil, warnings, keyError(ContainerGroup, KeyRootfs, fmt.Errorf("the Image And Rootfs keys conflict can not be specified together"))
 	}
 
 	if len(image) > 0 {
@@ -623,6 +663,20 @@ func ConvertContainer(container *parser.UnitFile, unitsInfoMap map[string]*UnitI
 
 	containerName := getContainerName(container)
 
+	// A Timer group turns the container into a scheduled job: the timer
+	// unit generated by ConvertTimer starts the service and is installed
+	// instead of it.
+	if container.HasGroup(TimerGroup) {
+		service.RemoveGroup(TimerGroup)
+		service.RemoveGroup(InstallGroup)
+		if !service.HasKey(ServiceGroup, "Type") {
+			service.Set(ServiceGroup, "Type", "oneshot")
+		}
+		if service.LookupBooleanWithDefault(ServiceGroup, "RemainAfterExit", false) {
+			warnings = errors.Join(warnings, keyError(ServiceGroup, "RemainAfterExit", fmt.Errorf("RemainAfterExit=yes prevents the timer of %s from starting the service more than once", container.Filename)))
+		}
+	}
+
 	// Set PODMAN_SYSTEMD_UNIT so that podman auto-update can restart the service.
 	service.Add(ServiceGroup, "Environment", "PODMAN_SYSTEMD_UNIT=%n")
 
@@ -630,7 +684,7 @@ func ConvertContainer(container *parser.UnitFile, unitsInfoMap map[string]*UnitI
 	killMode, ok := service.Lookup(ServiceGroup, "KillMode")
 	if !ok || (killMode != "mixed" && killMode != "control-group") {
 		if ok {
-			return nil, warnings, fmt.Errorf("invalid KillMode '%s'", killMode)
+			return nil, warnings, keyError(ServiceGroup, "KillMode", fmt.Errorf("invalid KillMode '%s'", killMode))
 		}
 
 		// We default to mixed instead of control-group, because it lets conmon do its thing
@@ -729,7 +783,7 @@ func ConvertContainer(container *parser.UnitFile, unitsInfoMap map[string]*UnitI
 
 	serviceType, ok := service.Lookup(ServiceGroup, "Type")
 	if ok && serviceType != "notify" && serviceType != "oneshot" {
-		return nil, warnings, fmt.Errorf("invalid service Type '%s'", serviceType)
+		return nil, warnings, keyError(ServiceGroup, "Type", fmt.Errorf("invalid service Type '%s'", serviceType))
 	}
 
 	if serviceType != "oneshot" {
@@ -865,13 +919,15 @@ func ConvertContainer(container *parser.UnitFile, unitsInfoMap map[string]*UnitI
 		exposedPort = strings.TrimSpace(exposedPort) // Allow whitespace after
 
 		if !isPortRange(exposedPort) {
-			return nil, warnings, fmt.Errorf("invalid port format '%s'", exposedPort)
+			return nil, warnings, keyError(ContainerGroup, KeyExposeHostPort, fmt.Errorf("invalid port format '%s'", exposedPort))
 		}
 
 		podman.add("--expose", exposedPort)
 	}
 
-	handlePublishPorts(container, ContainerGroup, podman)
+	if err := handlePublishPorts(container, ContainerGroup, podman); err != nil {
+		return nil, warnings, err
+	}
 
 	keyValKeys := map[string]string{
 		KeyEnvironment: "--env",
@@ -932,13 +988,47 @@ func ConvertContainer(container *parser.UnitFile, unitsInfoMap map[string]*UnitI
 	if ok {
 		podman.add(execArgs...)
 	}
-	warnings = errors.Join(warnings, warn)
+	warnings = errors.Join(warnings, keyError(ContainerGroup, KeyExec, warn))
 
 	service.AddCmdline(ServiceGroup, "ExecStart", podman.Args)
 
 	return service, warnings, nil
 }
 
+// Convert the Timer group of a quadlet container file to a systemd timer unit
+// that starts the service generated by ConvertContainer.  The Install group
+// is moved to the timer so that it is the timer which gets enabled.
+// Returns nil if the file has no Timer group.
+func ConvertTimer(quadletUnitFile *parser.UnitFile, unitsInfoMap map[string]*UnitInfo) (*parser.UnitFile, error) {
+	if !quadletUnitFile.HasGroup(TimerGroup) {
+		return nil, nil
+	}
+	unitInfo, ok := unitsInfoMap[quadletUnitFile.Filename]
+	if !ok {
+		return nil, fmt.Errorf("internal error while processing timer %s", quadletUnitFile.Filename)
+	}
+
+	timer := quadletUnitFile.Dup()
+	// The timer activates the service with the same name, this also works
+	// for instances of template units.
+	timer.Filename = unitInfo.TimerFileName()
+	for _, group := range timer.ListGroups() {
+		if group != UnitGroup && group != TimerGroup && group != InstallGroup {
+			timer.RemoveGroup(group)
+		}
+	}
+	// The dependencies are those of the service, only keep the description.
+	for _, key := range timer.ListKeys(UnitGroup) {
+		if key != "Description" && key != "Documentation" {
+			timer.Unset(UnitGroup, key)
+		}
+	}
+	if quadletUnitFile.Path != "" {
+		timer.Add(UnitGroup, "SourcePath", quadletUnitFile.Path)
+	}
+	return timer, nil
+}
+
 func isTemplateUnit(unit *parser.UnitFile) bool {
 	base := strings.TrimSuffix(unit.Filename, filepath.Ext(unit.Filename))
 	return strings.HasSuffix(base, "@")
@@ -974,17 +1064,66 @@ func getResourceName(unit *parser.UnitFile, group, key string) string {
 // Get the resolved container name that contains no '%'.
 // Returns an empty string if not resolvable.
 func GetContainerResourceName(container *parser.UnitFile) string {
-	containerName := getContainerName(container)
-
-	// XXX: only %N is handled.
+	// XXX: only the specifiers derived from the unit name are handled.
 	// it is difficult to properly implement specifiers handling without consulting systemd.
-	resourceName := strings.ReplaceAll(containerName, "%N", GetContainerServiceName(container))
-
-	if !strings.Contains(resourceName, "%") {
-		return resourceName
-	} else {
+	resourceName, ok := resolveUnitNameSpecifiers(container, getContainerName(container))
+	if !ok {
 		return ""
 	}
+	return resourceName
+}
+
+// Resolve the systemd specifiers that only depend on the name of the service
+// generated from the unit: %n, %N, %p, %i and %%. The instance is only known
+// for instances of templates, like foo@bar.container, not for the templates
+// themselves. Returns false if a specifier cannot be resolved.
+func resolveUnitNameSpecifiers(unit *parser.UnitFile, value string) (string, bool) {
+	if !strings.Contains(value, "%") {
+		return value, true
+	}
+
+	serviceName, err := GetUnitServiceName(unit)
+	if err != nil {
+		return "", false
+	}
+	prefix, instance, hasInstance := strings.Cut(serviceName, "@")
+	isTemplate := hasInstance && instance == ""
+
+	var resolved strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '%' {
+			resolved.WriteByte(value[i])
+			continue
+		}
+		i++
+		if i == len(value) {
+			return "", false
+		}
+		switch value[i] {
+		case '%':
+			resolved.WriteByte('%')
+		case 'p':
+			resolved.WriteString(prefix)
+		case 'n':
+			if isTemplate {
+				return "", false
+			}
+			resolved.WriteString(serviceName + ".service")
+		case 'N':
+			if isTemplate {
+				return "", false
+			}
+			resolved.WriteString(serviceName)
+		case 'i':
+			if isTemplate {
+				return "", false
+			}
+			resolved.WriteString(instance)
+		default:
+			return "", false
+		}
+	}
+	return resolved.String(), true
 }
 
 func defaultOneshotServiceGroup(service *parser.UnitFile, remainAfterExit bool) {
@@ -1053,10 +1192,10 @@ func ConvertNetwork(network *parser.UnitFile, unitsInfoMap map[string]*UnitInfo,
 	ipRanges := network.LookupAll(NetworkGroup, KeyIPRange)
 	if len(subnets) > 0 {
 		if len(gateways) > len(subnets) {
-			return nil, warnings, fmt.Errorf("cannot set more gateways than subnets")
+			return nil, warnings, keyError(NetworkGroup, KeyGateway, fmt.Errorf("cannot set more gateways than subnets"))
 		}
 		if len(ipRanges) > len(subnets) {
-			return nil, warnings, fmt.Errorf("cannot set more ranges than subnets")
+			return nil, warnings, keyError(NetworkGroup, KeyIPRange, fmt.Errorf("cannot set more ranges than subnets"))
 		}
 		for i := range subnets {
 			podman.add("--subnet", subnets[i])
@@ -1068,7 +1207,7 @@ func ConvertNetwork(network *parser.UnitFile, unitsInfoMap map[string]*UnitInfo,
 			}
 		}
 	} else if len(ipRanges) > 0 || len(gateways) > 0 {
-		return nil, warnings, fmt.Errorf("cannot set gateway or range without subnet")
+		return nil, warnings, keyError(NetworkGroup, KeySubnet, fmt.Errorf("cannot set gateway or range without subnet"))
 	}
 
 	keyValKeys := map[string]string{
@@ -1134,7 +1273,7 @@ func ConvertVolume(volume *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, i
 
 		imageName, ok := volume.Lookup(VolumeGroup, KeyImage)
 		if !ok {
-			return nil, warnings, fmt.Errorf("the key %s is mandatory when using the image driver", KeyImage)
+			return nil, warnings, keyError(VolumeGroup, KeyImage, fmt.Errorf("the key %s is mandatory when using the image driver", KeyImage))
 		}
 		imageName, err := handleImageSource(imageName, service, unitsInfoMap)
 		if err != nil {
@@ -1186,7 +1325,7 @@ func ConvertVolume(volume *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, i
 					service.AddEscaped(UnitGroup, "RequiresMountsFor", dev)
 				}
 			} else {
-				return nil, warnings, fmt.Errorf("key Type can't be used without Device")
+				return nil, warnings, keyError(VolumeGroup, KeyType, fmt.Errorf("key Type can't be used without Device"))
 			}
 		}
 
@@ -1231,7 +1370,7 @@ func ConvertKube(kube *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, isUse
 
 	yamlPaths := kube.LookupAllStrv(KubeGroup, KeyYaml)
 	if len(yamlPaths) == 0 {
-		return nil, fmt.Errorf("no Yaml key specified")
+		return nil, keyError(KubeGroup, KeyYaml, fmt.Errorf("no Yaml key specified"))
 	}
 
 	// Convert all yaml paths to absolute paths
@@ -1248,7 +1387,7 @@ func ConvertKube(kube *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, isUse
 	killMode, ok := service.Lookup(ServiceGroup, "KillMode")
 	if !ok || (killMode != "mixed" && killMode != "control-group") {
 		if ok {
-			return nil, fmt.Errorf("invalid KillMode '%s'", killMode)
+			return nil, keyError(ServiceGroup, "KillMode", fmt.Errorf("invalid KillMode '%s'", killMode))
 		}
 
 		// We default to mixed instead of control-group, because it lets conmon do its thing
@@ -1261,7 +1400,7 @@ func ConvertKube(kube *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, isUse
 	// Allow users to set the Service Type to oneshot to allow resources only kube yaml
 	serviceType, ok := service.Lookup(ServiceGroup, "Type")
 	if ok && serviceType != "notify" && serviceType != "oneshot" {
-		return nil, fmt.Errorf("invalid service Type '%s'", serviceType)
+		return nil, keyError(ServiceGroup, "Type", fmt.Errorf("invalid service Type '%s'", serviceType))
 	}
 
 	if serviceType != "oneshot" {
@@ -1322,7 +1461,9 @@ func ConvertKube(kube *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, isUse
 		execStart.add("--configmap", configMapPath)
 	}
 
-	handlePublishPorts(kube, KubeGroup, execStart)
+	if err := handlePublishPorts(kube, KubeGroup, execStart); err != nil {
+		return nil, err
+	}
 
 	handlePodmanArgs(kube, KubeGroup, execStart)
 
@@ -1361,7 +1502,7 @@ func ConvertImage(image *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, isU
 
 	imageName, ok := image.Lookup(ImageGroup, KeyImage)
 	if !ok || len(imageName) == 0 {
-		return nil, fmt.Errorf("no Image key specified")
+		return nil, keyError(ImageGroup, KeyImage, fmt.Errorf("no Image key specified"))
 	}
 
 	podman := createBasePodmanCommand(image, ImageGroup)
@@ -1416,7 +1557,7 @@ func ConvertBuild(build *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, isU
 
 	// Fast fail is ResouceName is not set
 	if len(unitInfo.ResourceName) == 0 {
-		return nil, warnings, fmt.Errorf("no ImageTag key specified")
+		return nil, warnings, keyError(BuildGroup, KeyImageTag, fmt.Errorf("no ImageTag key specified"))
 	}
 
 	podman := createBasePodmanCommand(build, BuildGroup)
@@ -1656,7 +1797,9 @@ func ConvertPod(podUnit *parser.UnitFile, unitsInfoMap map[string]*UnitInfo, isU
 		return nil, warnings, err
 	}
 
-	handlePublishPorts(podUnit, PodGroup, execStartPre)
+	if err := handlePublishPorts(podUnit, PodGroup, execStartPre); err != nil {
+		return nil, warnings, err
+	}
 
 	keyValKeys := map[string]string{
 		KeyLabel: "--label",
@@ -1834,7 +1977,7 @@ func handleUserRemap(unitFile *parser.UnitFile, groupName string, podman *Podman
 		podman.add("--userns", usernsOpts("keep-id", keepidOpts))
 
 	default:
-		return fmt.Errorf("unsupported RemapUsers option '%s'", remapUsers)
+		return keyError(groupName, KeyRemapUsers, fmt.Errorf("unsupported RemapUsers option '%s'", remapUsers))
 	}
 
 	return nil
@@ -1926,11 +2069,116 @@ func handleExitPolicy(unitFile *parser.UnitFile, groupName string, podman *Podma
 	}
 }
 
-func handlePublishPorts(unitFile *parser.UnitFile, groupName string, podman *PodmanCmdline) {
+func handlePublishPorts(unitFile *parser.UnitFile, groupName string, podman *PodmanCmdline) error {
+	offset, err := lookupPublishPortOffset(unitFile, groupName)
+	if err != nil {
+		return err
+	}
+
 	publishPorts := unitFile.LookupAll(groupName, KeyPublishPort)
 	for _, publishPort := range publishPorts {
+		if offset != 0 {
+			publishPort, err = offsetPublishPort(publishPort, offset)
+			if err != nil {
+				return keyError(groupName, KeyPublishPort, err)
+			}
+		}
 		podman.add("--publish", publishPort)
 	}
+	return nil
+}
+
+// Get the offset added to the host ports published by the unit, usually
+// derived from the instance of a template with PublishPortOffset=%i.
+func lookupPublishPortOffset(unitFile *parser.UnitFile, groupName string) (int, error) {
+	value, ok := unitFile.Lookup(groupName, KeyPublishPortOffset)
+	if !ok || len(value) == 0 {
+		return 0, nil
+	}
+
+	resolved, ok := resolveUnitNameSpecifiers(unitFile, value)
+	if !ok {
+		if isTemplateUnit(unitFile) {
+			return 0, keyError(groupName, KeyPublishPortOffset, fmt.Errorf("%w: %s=%s in %s depends on the instance name", ErrInstanceRequired, KeyPublishPortOffset, value, unitFile.Filename))
+		}
+		return 0, keyError(groupName, KeyPublishPortOffset, fmt.Errorf("unsupported specifier in %s=%s in %s", KeyPublishPortOffset, value, unitFile.Filename))
+	}
+
+	offset, err := strconv.Atoi(resolved)
+	if err != nil {
+		return 0, keyError(groupName, KeyPublishPortOffset, fmt.Errorf("invalid port offset '%s' in %s, it must be an integer", resolved, unitFile.Filename))
+	}
+	return offset, nil
+}
+
+// RequiresInstanceFiles returns whether unit is a template which is only
+// converted for its instances, because PublishPortOffset= depends on the
+// instance name. No template service is generated from it, each instance needs
+// a Quadlet file of its own, usually a symlink to the template.
+func RequiresInstanceFiles(unit *parser.UnitFile) bool {
+	for _, groupName := range []string{ContainerGroup, PodGroup, KubeGroup} {
+		if _, err := lookupPublishPortOffset(unit, groupName); errors.Is(err, ErrInstanceRequired) {
+			return true
+		}
+	}
+	return false
+}
+
+// Add an offset to the host port or port range of a PublishPort value in the
+// format [[ip:][hostPort]:]containerPort[/protocol]. Values without a host
+// port are returned unchanged.
+func offsetPublishPort(publishPort string, offset int) (string, error) {
+	spec, protocol, _ := strings.Cut(publishPort, "/")
+	hostSpec, containerPort, found := cutLast(spec, ":")
+	if !found {
+		return publishPort, nil
+	}
+
+	ip := ""
+	hostPort := hostSpec
+	if strings.HasPrefix(hostSpec, "[") {
+		// [ipv6]:hostPort
+		end := strings.Index(hostSpec, "]")
+		if end < 0 {
+			return "", fmt.Errorf("invalid port format '%s'", publishPort)
+		}
+		ip, hostPort = hostSpec[:end+1], strings.TrimPrefix(hostSpec[end+1:], ":")
+		ip += ":"
+	} else if before, after, found := cutLast(hostSpec, ":"); found {
+		ip, hostPort = before+":", after
+	}
+	if hostPort == "" {
+		return publishPort, nil
+	}
+
+	ports := strings.Split(hostPort, "-")
+	if len(ports) > 2 {
+		return "", fmt.Errorf("invalid port format '%s'", publishPort)
+	}
+	for i, port := range ports {
+		n, err := strconv.Atoi(port)
+		if err != nil {
+			return "", fmt.Errorf("invalid port format '%s'", publishPort)
+		}
+		n += offset
+		if n < 1 || n > 65535 {
+			return "", fmt.Errorf("host port %d of '%s' with offset %d is out of range", n, publishPort, offset)
+		}
+		ports[i] = strconv.Itoa(n)
+	}
+
+	result := ip + strings.Join(ports, "-") + ":" + containerPort
+	if protocol != "" {
+		result += "/" + protocol
+	}
+	return result, nil
+}
+
+func cutLast(s, sep string) (string, string, bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
 }
 
 func handleLogDriver(unitFile *parser.UnitFile, groupName string, podman *PodmanCmdline) {
@@ -2023,20 +2271,20 @@ func handleSetWorkingDirectory(quadletUnitFile, serviceUnitFile *parser.UnitFile
 	switch strings.ToLower(setWorkingDirectory) {
 	case "yaml":
 		if quadletGroup != KubeGroup {
-			return "", fmt.Errorf("SetWorkingDirectory=%s is only supported in .kube files", setWorkingDirectory)
+			return "", keyError(quadletGroup, KeySetWorkingDirectory, fmt.Errorf("SetWorkingDirectory=%s is only supported in .kube files", setWorkingDirectory))
 		}
 
 		yamlPaths := quadletUnitFile.LookupAllStrv(KubeGroup, KeyYaml)
 		if len(yamlPaths) == 0 {
 			return "", fmt.Errorf("no Yaml key specified")
 		} else if len(yamlPaths) != 1 {
-			return "", fmt.Errorf("SetWorkingDirectory=yaml is only supported when a single Yaml key is provided")
+			return "", keyError(quadletGroup, KeySetWorkingDirectory, fmt.Errorf("SetWorkingDirectory=yaml is only supported when a single Yaml key is provided"))
 		}
 
 		relativeToFile = yamlPaths[0]
 	case "file":
 		if quadletGroup != BuildGroup {
-			return "", fmt.Errorf("SetWorkingDirectory=%s is only supported in .build files", setWorkingDirectory)
+			return "", keyError(quadletGroup, KeySetWorkingDirectory, fmt.Errorf("SetWorkingDirectory=%s is only supported in .build files", setWorkingDirectory))
 		}
 
 		relativeToFile, ok = quadletUnitFile.Lookup(quadletGroup, KeyFile)
@@ -2048,7 +2296,7 @@ func handleSetWorkingDirectory(quadletUnitFile, serviceUnitFile *parser.UnitFile
 	default:
 		// Path / URL handling is for .build files only
 		if quadletGroup != BuildGroup {
-			return "", fmt.Errorf("unsupported value for %s: %s ", ServiceKeyWorkingDirectory, setWorkingDirectory)
+			return "", keyError(quadletGroup, KeySetWorkingDirectory, fmt.Errorf("unsupported value for %s: %s ", ServiceKeyWorkingDirectory, setWorkingDirectory))
 		}
 
 		// Any value other than the above cases will be returned as context
@@ -2295,13 +2543,14 @@ func handleExecReload(quadletUnitFile, serviceUnitFile *parser.UnitFile, groupNa
 	signalOk = signalOk && len(reloadSignal) > 0
 	reloadcmd, cmdOk, warn := quadletUnitFile.LookupLastArgs(groupName, KeyReloadCmd)
 	cmdOk = cmdOk && len(reloadcmd) > 0
+	warn = keyError(groupName, KeyReloadCmd, warn)
 
 	if !cmdOk && !signalOk {
 		return warn, nil
 	}
 
 	if cmdOk && signalOk {
-		return warn, fmt.Errorf("%s and %s are mutually exclusive but both are set", KeyReloadCmd, KeyReloadSignal)
+		return warn, keyError(groupName, KeyReloadSignal, fmt.Errorf("%s and %s are mutually exclusive but both are set", KeyReloadCmd, KeyReloadSignal))
 	}
 
 	serviceReloadCmd := createBasePodmanCommand(quadletUnitFile, groupName)
@@ -2353,7 +2602,7 @@ func lookupAndAddKeyVals(unit *parser.UnitFile, group string, keys map[string]st
 	var warnings error
 	for key, flag := range keys {
 		keyVals, warn := unit.LookupAllKeyVal(group, key)
-		warnings = errors.Join(warnings, warn)
+		warnings = errors.Join(warnings, keyError(group, key, warn))
 		podman.addKeys(flag, keyVals)
 	}
 	return warnings
@@ -2402,7 +2651,7 @@ func ConvertArtifact(artifact *parser.UnitFile, unitsInfoMap map[string]*UnitInf
 
 	artifactName, ok := artifact.Lookup(ArtifactGroup, KeyArtifact)
 	if !ok || len(artifactName) == 0 {
-		return nil, fmt.Errorf("no Artifact key specified")
+		return nil, keyError(ArtifactGroup, KeyArtifact, fmt.Errorf("no Artifact key specified"))
 	}
 
 	podman := createBasePodmanCommand(artifact, ArtifactGroup)
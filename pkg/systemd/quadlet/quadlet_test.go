@@ -0,0 +1,90 @@
+//go:build linux
+
+package quadlet
+
+import (
+	"testing"
+
+	"github.com/containers/podman/v6/pkg/systemd/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOffsetPublishPort(t *testing.T) {
+	tests := []struct {
+		input  string
+		offset int
+		res    string
+	}{
+		{"80", 3, "80"},
+		{"8080:80", 3, "8083:80"},
+		{"8080:80/udp", 3, "8083:80/udp"},
+		{"127.0.0.1:8080:80", 10, "127.0.0.1:8090:80"},
+		{"127.0.0.1::80", 10, "127.0.0.1::80"},
+		{":8080:80", 1, ":8081:80"},
+		{"::80", 1, "::80"},
+		{"[::1]:8080:80", 2, "[::1]:8082:80"},
+		{"[::]::80", 2, "[::]::80"},
+		{"9000-9010:80-90", 100, "9100-9110:80-90"},
+		{"8080:80", -80, "8000:80"},
+	}
+
+	for _, test := range tests {
+		res, err := offsetPublishPort(test.input, test.offset)
+		require.NoError(t, err, "%q", test.input)
+		assert.Equal(t, test.res, res, "%q", test.input)
+	}
+
+	_, err := offsetPublishPort("65535:80", 1)
+	assert.ErrorContains(t, err, "out of range")
+	_, err = offsetPublishPort("http:80", 1)
+	assert.ErrorContains(t, err, "invalid port format")
+}
+
+func TestResolveUnitNameSpecifiers(t *testing.T) {
+	tests := []struct {
+		filename string
+		input    string
+		res      string
+		ok       bool
+	}{
+		{"web.container", "plain", "plain", true},
+		{"web.container", "%N-%p-%i", "web-web-", true},
+		{"web@3.container", "systemd-%p_%i", "systemd-web_3", true},
+		{"web@3.container", "%n 100%%", "web@3.service 100%", true},
+		{"web@.container", "systemd-%p", "systemd-web", true},
+		{"web@.container", "systemd-%p_%i", "", false},
+		{"web@.container", "%N", "", false},
+		{"web@3.container", "%H", "", false},
+		{"web@3.container", "100%", "", false},
+	}
+
+	for _, test := range tests {
+		unit := parser.NewUnitFile()
+		unit.Filename = test.filename
+		res, ok := resolveUnitNameSpecifiers(unit, test.input)
+		assert.Equal(t, test.ok, ok, "%s %q", test.filename, test.input)
+		assert.Equal(t, test.res, res, "%s %q", test.filename, test.input)
+	}
+}
+
+func TestRequiresInstanceFiles(t *testing.T) {
+	tests := []struct {
+		filename string
+		data     string
+		res      bool
+	}{
+		{"web@.container", "[Container]\nPublishPortOffset=%i\nPublishPort=8000:80\n", true},
+		{"web@.pod", "[Pod]\nPublishPortOffset=%i\n", true},
+		{"web@3.container", "[Container]\nPublishPortOffset=%i\n", false},
+		{"web@.container", "[Container]\nContainerName=web-%i\nPublishPort=8000:80\n", false},
+		{"web@.container", "[Container]\nPublishPortOffset=10\n", false},
+	}
+
+	for _, test := range tests {
+		unit := parser.NewUnitFile()
+		unit.Filename = test.filename
+		require.NoError(t, unit.Parse(test.data))
+		assert.Equal(t, test.res, RequiresInstanceFiles(unit), "%s %q", test.filename, test.data)
+	}
+}
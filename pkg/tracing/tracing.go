@@ -0,0 +1,53 @@
+// Package tracing provides helpers to instrument Podman with OpenTelemetry
+// traces. The helpers only use the OpenTelemetry API, spans are recorded
+// and exported once the podman binary installed a tracer provider.
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the tracer used for all Podman spans.
+const InstrumentationName = "github.com/containers/podman"
+
+// Attribute keys used on Podman spans.
+const (
+	ContainerIDKey   = attribute.Key("podman.container.id")
+	ContainerNameKey = attribute.Key("podman.container.name")
+	ImageKey         = attribute.Key("podman.image")
+	NetworkModeKey   = attribute.Key("podman.network.mode")
+	OCIRuntimeKey    = attribute.Key("podman.oci.runtime")
+)
+
+// Start creates a span as child of the span in ctx, if any.
+// The returned span must be ended by the caller, usually with End.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(InstrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartClient is like Start but marks the span as the client side of a
+// remote call.
+func StartClient(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(InstrumentationName).Start(ctx, name, trace.WithAttributes(attrs...), trace.WithSpanKind(trace.SpanKindClient))
+}
+
+// End records err, if not nil, on span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Inject adds the trace context of ctx to the headers of an outgoing request.
+func Inject(ctx context.Context, header http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// recorder collects all ended spans.
+type recorder struct {
+	mu    sync.Mutex
+	spans []sdktrace.ReadOnlySpan
+}
+
+func (r *recorder) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+func (r *recorder) Shutdown(context.Context) error                  { return nil }
+func (r *recorder) ForceFlush(context.Context) error                { return nil }
+
+func (r *recorder) OnEnd(s sdktrace.ReadOnlySpan) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spans = append(r.spans, s)
+}
+
+func setupProvider(t *testing.T) *recorder {
+	rec := &recorder{}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+	prevTP, prevProp := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetTextMapPropagator(prevProp)
+	})
+	return rec
+}
+
+func TestStartEnd(t *testing.T) {
+	rec := setupProvider(t)
+
+	ctx, parent := Start(context.Background(), "parent", ContainerIDKey.String("abc"))
+	_, child := Start(ctx, "child")
+	End(child, errors.New("failed"))
+	End(parent, nil)
+
+	require.Len(t, rec.spans, 2)
+	c, p := rec.spans[0], rec.spans[1]
+	assert.Equal(t, "child", c.Name())
+	assert.Equal(t, p.SpanContext().SpanID(), c.Parent().SpanID())
+	assert.Equal(t, codes.Error, c.Status().Code)
+	assert.Equal(t, "failed", c.Status().Description)
+	assert.Equal(t, codes.Unset, p.Status().Code)
+	assert.Contains(t, p.Attributes(), ContainerIDKey.String("abc"))
+}
+
+func TestInject(t *testing.T) {
+	setupProvider(t)
+
+	header := http.Header{}
+	Inject(context.Background(), header)
+	assert.Empty(t, header.Get("traceparent"))
+
+	ctx, span := StartClient(context.Background(), "GET /_ping")
+	defer span.End()
+	Inject(ctx, header)
+	assert.Contains(t, header.Get("traceparent"), span.SpanContext().TraceID().String())
+}
@@ -1112,6 +1112,58 @@ var _ = Describe("Podman checkpoint", func() {
 		os.Remove(preCheckpointFileName)
 	})
 
+	It("podman checkpoint container with incremental --pre-checkpoint and export", func() {
+		if podmanTest.Host.Arch == "arm64" {
+			Skip("skip on arm64/aarch64, https://github.com/checkpoint-restore/criu/issues/2676")
+		}
+		SkipIfContainerized("FIXME: #24230 - no longer works in container testing")
+		SkipIfRemote("--import-previous is not yet supported on the remote client")
+		if !criu.MemTrack() {
+			Skip("system (architecture/kernel/CRIU) does not support memory tracking")
+		}
+		localRunString := getRunString([]string{ALPINE, "top"})
+		session := podmanTest.Podman(localRunString)
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		cid := session.OutputToString()
+		preCheckpointFileNames := []string{
+			filepath.Join(podmanTest.TempDir, "/pre-checkpoint-1-"+cid+".tar.gz"),
+			filepath.Join(podmanTest.TempDir, "/pre-checkpoint-2-"+cid+".tar.gz"),
+		}
+		checkpointFileName := filepath.Join(podmanTest.TempDir, "/checkpoint-"+cid+".tar.gz")
+
+		result := podmanTest.Podman([]string{"container", "checkpoint", "-P", "-e", preCheckpointFileNames[0], cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(ExitCleanly())
+
+		result = podmanTest.Podman([]string{"container", "checkpoint", "-P", "--with-previous", "-e", preCheckpointFileNames[1], cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(ExitCleanly())
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+		Expect(podmanTest.GetContainerStatus()).To(ContainSubstring("Up"))
+
+		result = podmanTest.Podman([]string{"container", "checkpoint", "--with-previous", "-e", checkpointFileName, cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(ExitCleanly())
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(0))
+
+		result = podmanTest.Podman([]string{"rm", "-t", "0", "-f", cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(ExitCleanly())
+
+		result = podmanTest.Podman([]string{"container", "restore", "-i", checkpointFileName, "--import-previous", preCheckpointFileNames[0], "--import-previous", preCheckpointFileNames[1]})
+		result.WaitWithDefaultTimeout()
+
+		Expect(result).Should(ExitCleanly())
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+		Expect(podmanTest.GetContainerStatus()).To(ContainSubstring("Up"))
+
+		os.Remove(checkpointFileName)
+		for _, name := range preCheckpointFileNames {
+			os.Remove(name)
+		}
+	})
+
 	It("podman checkpoint and restore container with different port mappings", func() {
 		Skip("FIXME: #26289 - Rawhide only issue, skip for now")
 		randomPort, err := utils.GetRandomPort()
@@ -1331,6 +1383,56 @@ var _ = Describe("Podman checkpoint", func() {
 		})
 	}
 
+	It("podman pod checkpoint and restore", func() {
+		SkipIfRemote("checkpointing pods is not supported on the remote client")
+		if err := criu.CheckForCriu(criu.PodCriuVersion); err != nil {
+			Skip(fmt.Sprintf("check CRIU pod version error: %v", err))
+		}
+		if !crutils.CRRuntimeSupportsPodCheckpointRestore(podmanTest.OCIRuntime) {
+			Skip("runtime does not support pod restore: " + podmanTest.OCIRuntime)
+		}
+
+		podName := "test_pod"
+		session := podmanTest.Podman([]string{"pod", "create", "--name", podName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitCleanly())
+		podID := session.OutputToString()
+
+		session = podmanTest.Podman([]string{"run", "-d", "--pod", podName, "--name", "first", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitCleanly())
+		session = podmanTest.Podman([]string{"run", "-d", "--pod", podName, "--name", "second", "--requires", "first", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitCleanly())
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(3))
+
+		fileName := filepath.Join(podmanTest.TempDir, "pod-checkpoint.tar.zst")
+		result := podmanTest.Podman([]string{"pod", "checkpoint", "-e", fileName, podName})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(ExitCleanly())
+		Expect(result.OutputToString()).To(Equal(podID))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(0))
+
+		result = podmanTest.Podman([]string{"pod", "rm", "-f", podName})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(ExitCleanly())
+		Expect(podmanTest.NumberOfContainers()).To(Equal(0))
+
+		result = podmanTest.Podman([]string{"pod", "restore", "-i", fileName})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(ExitCleanly())
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(3))
+
+		result = podmanTest.Podman([]string{"ps", "--pod", "--format", "{{.Names}} {{.PodName}}"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(ExitCleanly())
+		Expect(result.OutputToStringArray()).To(ContainElements("first "+podName, "second "+podName))
+
+		result = podmanTest.Podman([]string{"pod", "rm", "-fa"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).To(ExitCleanly())
+	})
+
 	It("podman checkpoint container with export (migration) and --ipc host", func() {
 		localRunString := getRunString([]string{"--rm", "--ipc", "host", ALPINE, "top"})
 		session := podmanTest.Podman(localRunString)
@@ -5,11 +5,16 @@ package integration
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strconv"
 	"sync"
 	"time"
 
 	"github.com/containers/podman/v6/cmd/podman/system"
+	"github.com/containers/podman/v6/libpod/events"
 	. "github.com/containers/podman/v6/test/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -307,4 +312,50 @@ var _ = Describe("Podman events", func() {
 		Expect(result).Should(ExitCleanly())
 		Expect(result.OutputToStringArray()).ToNot(BeEmpty(), "Number of health_status events")
 	})
+
+	It("podman events webhook backend", func() {
+		SkipIfRemote("the events backend is configured on the server")
+
+		var mu sync.Mutex
+		var received []events.Event
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var batch []events.Event
+			if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			received = append(received, batch...)
+		}))
+		defer srv.Close()
+
+		confPath := filepath.Join(podmanTest.TempDir, "events-webhook.conf")
+		conf := fmt.Sprintf("url = %q\nfilters = [\"event=died\"]\n", srv.URL)
+		err := os.WriteFile(confPath, []byte(conf), 0o644)
+		Expect(err).ToNot(HaveOccurred())
+		os.Setenv("CONTAINERS_EVENTS_WEBHOOK_CONF", confPath)
+		defer os.Unsetenv("CONTAINERS_EVENTS_WEBHOOK_CONF")
+
+		session := podmanTest.Podman([]string{"--events-backend", "webhook", "run", "--name", "webhook-died", ALPINE, "false"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(1, ""))
+
+		Eventually(func() []string {
+			mu.Lock()
+			defer mu.Unlock()
+			names := []string{}
+			for _, e := range received {
+				Expect(e.Status).To(Equal(events.Exited))
+				names = append(names, e.Name)
+			}
+			return names
+		}, 10*time.Second, 250*time.Millisecond).Should(ContainElement("webhook-died"))
+
+		// Events are still stored locally.
+		result := podmanTest.Podman([]string{"--events-backend", "webhook", "events", "--stream=false", "--filter", "container=webhook-died", "--filter", "event=died"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(ExitCleanly())
+		Expect(result.OutputToStringArray()).ToNot(BeEmpty())
+	})
 })
@@ -224,6 +224,78 @@ farm2 [QA] false true
 			Expect(session.OutputToString()).To(Equal(`farm1 [QA QB] true true`))
 		})
 
+		It("set scheduling priority and weight", func() {
+			cmd := []string{"farm", "create", "--priority", "QA=1", "--weight", "QB=3", "farm1", "QA", "QB"}
+			session := podmanTest.Podman(cmd)
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+
+			nodesFormat := []string{"farm", "list", "--format", "{{range .}}{{.Name}} {{.Nodes}}\n{{end}}"}
+			session = podmanTest.Podman(nodesFormat)
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+			Expect(session.OutputToString()).To(Equal(`farm1 map[QA:{1 0} QB:{0 3}]`))
+
+			cmd = []string{"farm", "update", "--priority", "QB=2", "--weight", "QA=2", "farm1"}
+			session = podmanTest.Podman(cmd)
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+
+			session = podmanTest.Podman(nodesFormat)
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+			Expect(session.OutputToString()).To(Equal(`farm1 map[QA:{1 2} QB:{2 3}]`))
+
+			// removing a connection drops its settings
+			cmd = []string{"farm", "update", "--remove", "QB", "farm1"}
+			session = podmanTest.Podman(cmd)
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+
+			session = podmanTest.Podman(nodesFormat)
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+			Expect(session.OutputToString()).To(Equal(`farm1 map[QA:{1 2}]`))
+
+			cmd = []string{"farm", "update", "--priority", "QB=1", "farm1"}
+			session = podmanTest.Podman(cmd)
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitWithError(125, `cannot set scheduling of "QB", it is not a connection in farm "farm1"`))
+
+			cmd = []string{"farm", "update", "--weight", "QA=-1", "farm1"}
+			session = podmanTest.Podman(cmd)
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitWithError(125, `invalid weight -1 for "QA": must not be negative`))
+		})
+
+		It("set build cache repository", func() {
+			cmd := []string{"farm", "create", "--cache-repo", "quay.io/libpod/cache", "farm1", "QA", "QB"}
+			session := podmanTest.Podman(cmd)
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+
+			cacheFormat := []string{"farm", "list", "--format", "{{range .}}{{.Name}} {{.Cache}}\n{{end}}"}
+			session = podmanTest.Podman(cacheFormat)
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+			Expect(session.OutputToString()).To(Equal(`farm1 quay.io/libpod/cache`))
+
+			cmd = []string{"farm", "update", "--cache-repo", "quay.io/libpod/cache:latest", "farm1"}
+			session = podmanTest.Podman(cmd)
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitWithError(125, "invalid cache repository: repository must contain neither a tag nor digest"))
+
+			cmd = []string{"farm", "update", "--cache-repo", "", "farm1"}
+			session = podmanTest.Podman(cmd)
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+
+			session = podmanTest.Podman(cacheFormat)
+			session.WaitWithDefaultTimeout()
+			Expect(session).Should(ExitCleanly())
+			Expect(session.OutputToString()).To(Equal(`farm1`))
+		})
+
 		It("remove farms", func() {
 			// create farm with multiple system connections
 			cmd := []string{"farm", "create", "farm1", "QA", "QB"}
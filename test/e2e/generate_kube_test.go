@@ -12,6 +12,7 @@ import (
 
 	"github.com/containers/podman/v6/libpod/define"
 
+	appsv1 "github.com/containers/podman/v6/pkg/k8s.io/api/apps/v1"
 	v1 "github.com/containers/podman/v6/pkg/k8s.io/api/core/v1"
 	"github.com/containers/podman/v6/pkg/util"
 	. "github.com/containers/podman/v6/test/utils"
@@ -1647,6 +1648,71 @@ USER test1`
 		Expect(kube).Should(ExitWithError(125, "k8s Jobs can not have restartPolicy set to Always; only Never and OnFailure policies allowed"))
 	})
 
+	It("on pod with --type=statefulset and --replicas=2", func() {
+		podName := "test-pod"
+		session := podmanTest.Podman([]string{"pod", "create", podName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"create", "--pod", podName, "-v", "testvol:/data", CITEST_IMAGE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		kube := podmanTest.Podman([]string{"kube", "generate", "--type", "statefulset", "--replicas", "2", podName})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitCleanly())
+
+		sts := new(appsv1.StatefulSet)
+		err := yaml.Unmarshal(kube.Out.Contents(), sts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sts.Kind).To(Equal("StatefulSet"))
+		Expect(sts.Name).To(Equal(podName + "-statefulset"))
+		Expect(sts.Spec.Selector.MatchLabels).To(HaveKeyWithValue("app", podName))
+		Expect(int(*sts.Spec.Replicas)).To(Equal(2))
+		Expect(sts.Spec.VolumeClaimTemplates).To(HaveLen(1))
+		Expect(sts.Spec.Template.Spec.Volumes).To(BeEmpty())
+		Expect(sts.Spec.Template.Spec.Containers[0].VolumeMounts[0].Name).To(Equal(sts.Spec.VolumeClaimTemplates[0].Name))
+	})
+
+	It("on pod with --type=cronjob", func() {
+		podName := "test-pod"
+		session := podmanTest.Podman([]string{"pod", "create", podName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"create", "--pod", podName, CITEST_IMAGE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		kube := podmanTest.Podman([]string{"kube", "generate", "--type", "cronjob", podName})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitWithError(125, "k8s CronJobs require a schedule"))
+
+		kube = podmanTest.Podman([]string{"kube", "generate", "--type", "cronjob", "--schedule", "*/10 * * * *", podName})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitCleanly())
+
+		cronJob := new(v1.CronJob)
+		err := yaml.Unmarshal(kube.Out.Contents(), cronJob)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cronJob.Kind).To(Equal("CronJob"))
+		Expect(cronJob.Name).To(Equal(podName + "-cronjob"))
+		Expect(cronJob.Spec.Schedule).To(Equal("*/10 * * * *"))
+		Expect(cronJob.Spec.JobTemplate.Spec.Template.Spec.RestartPolicy).To(Equal(v1.RestartPolicyNever))
+		Expect(cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers).To(HaveLen(1))
+	})
+
+	It("with --schedule and --type=pod should fail", func() {
+		podName := "test-pod"
+		session := podmanTest.Podman([]string{"pod", "create", podName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		kube := podmanTest.Podman([]string{"kube", "generate", "--type", "pod", "--schedule", "@daily", podName})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitWithError(125, "--schedule can only be set when --type is set to cronjob"))
+	})
+
 	It("on pod with invalid name", func() {
 		podName := "test_pod"
 		session := podmanTest.Podman([]string{"pod", "create", podName})
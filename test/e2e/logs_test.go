@@ -4,8 +4,10 @@ package integration
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"os/exec"
+	"strings"
 	"time"
 
 	. "github.com/containers/podman/v6/test/utils"
@@ -662,7 +664,54 @@ var _ = Describe("Podman logs", func() {
 	It("log tag with non-journald driver fails", func() {
 		logc := podmanTest.Podman([]string{"run", "--log-driver", "k8s-file", "--log-opt", "tag=mytag", ALPINE, "true"})
 		logc.WaitWithDefaultTimeout()
-		Expect(logc).To(ExitWithError(125, "log tags can only be used with the journald log driver"))
+		Expect(logc).To(ExitWithError(125, "log tags can only be used with the journald, syslog, gelf and fluentd log drivers"))
+	})
+
+	It("podman logs with rotated log files", func() {
+		logc := podmanTest.PodmanExitCleanly("run", "--name", "rotate", "--log-driver", "k8s-file", "--log-opt", "max-size=10", "--log-opt", "max-file=3", "--log-opt", "compress=true", ALPINE, "echo", "podman")
+		Expect(logc.OutputToString()).To(Equal("podman"))
+		// The log file is rotated before the container is started again.
+		podmanTest.PodmanExitCleanly("start", "--attach", "rotate")
+		podmanTest.PodmanExitCleanly("start", "--attach", "rotate")
+
+		inspect := podmanTest.PodmanExitCleanly("container", "inspect", "--format", "{{.HostConfig.LogConfig.Path}} {{index .HostConfig.LogConfig.Config \"max-file\"}}", "rotate")
+		logPath, maxFiles, _ := strings.Cut(inspect.OutputToString(), " ")
+		Expect(maxFiles).To(Equal("3"))
+		if !IsRemote() {
+			Expect(logPath + ".1").To(BeAnExistingFile())
+			Expect(logPath + ".2.gz").To(BeAnExistingFile())
+		}
+
+		results := podmanTest.PodmanExitCleanly("logs", "rotate")
+		Expect(results.OutputToStringArray()).To(Equal([]string{"podman", "podman", "podman"}))
+		results = podmanTest.PodmanExitCleanly("logs", "--tail", "2", "rotate")
+		Expect(results.OutputToStringArray()).To(Equal([]string{"podman", "podman"}))
+
+		logc = podmanTest.Podman([]string{"run", "--log-driver", "k8s-file", "--log-opt", "max-file=3", ALPINE, "true"})
+		logc.WaitWithDefaultTimeout()
+		Expect(logc).To(ExitWithError(125, "log rotation requires a maximum log size"))
+	})
+
+	It("podman run with the syslog log driver", func() {
+		SkipIfRemote("the log server must be reachable from the server")
+		l, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		Expect(err).ToNot(HaveOccurred())
+		defer l.Close()
+
+		podmanTest.PodmanExitCleanly("run", "--name", "shipped", "--log-driver", "syslog", "--log-opt", "syslog-address=udp://"+l.LocalAddr().String(), "--log-opt", "tag=shipped-tag", ALPINE, "echo", "podman")
+		// The logs are also written to the local log file.
+		results := podmanTest.PodmanExitCleanly("logs", "shipped")
+		Expect(results.OutputToString()).To(Equal("podman"))
+
+		Expect(l.SetReadDeadline(time.Now().Add(30 * time.Second))).To(Succeed())
+		buf := make([]byte, 65536)
+		n, _, err := l.ReadFrom(buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(buf[:n])).To(MatchRegexp(`^<30>1 \S+ \S+ shipped-tag - - - podman$`))
+
+		logc := podmanTest.Podman([]string{"run", "--log-driver", "gelf", ALPINE, "true"})
+		logc.WaitWithDefaultTimeout()
+		Expect(logc).To(ExitWithError(125, "the gelf log driver requires the gelf-address log option"))
 	})
 
 	It("podman pod logs with container names", func() {
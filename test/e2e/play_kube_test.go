@@ -523,6 +523,84 @@ spec:
           periodSeconds: 1
 `
 
+var readinessProbePodYaml = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: readiness-probe
+spec:
+  restartPolicy: Never
+  containers:
+  - command:
+    - top
+    - -d
+    - "1.5"
+    name: testimage
+    image: ` + CITEST_IMAGE + `
+    readinessProbe:
+      exec:
+        command:
+        - /bin/sh
+        - -c
+        - cat /testfile
+      initialDelaySeconds: 0
+      periodSeconds: 1
+      failureThreshold: 1
+`
+
+var statefulSetYaml = `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: web
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - name: ctr
+        image: ` + CITEST_IMAGE + `
+        command:
+        - top
+        volumeMounts:
+        - name: data
+          mountPath: /data
+  volumeClaimTemplates:
+  - metadata:
+      name: data
+    spec:
+      accessModes:
+      - ReadWriteOnce
+      resources:
+        requests:
+          storage: 1Gi
+`
+
+var cronJobYaml = `
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: nightly
+spec:
+  schedule: "0 3 * * *"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          restartPolicy: Never
+          containers:
+          - name: ctr
+            image: ` + CITEST_IMAGE + `
+            command:
+            - "true"
+`
+
 var selinuxLabelPodYaml = `
 apiVersion: v1
 kind: Pod
@@ -2719,6 +2797,123 @@ var _ = Describe("Podman kube play", func() {
 		Expect(inspect[0].State.Health).To(HaveField("Status", define.HealthCheckHealthy))
 	})
 
+	It("support container readiness probe", func() {
+		ctrName := "readiness-probe-testimage"
+		err := writeYaml(readinessProbePodYaml, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.Podman([]string{"kube", "play", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitCleanly())
+
+		hc := podmanTest.Podman([]string{"healthcheck", "run", "--readiness", ctrName})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc).Should(ExitWithError(1, ""))
+		Expect(hc.OutputToString()).To(Equal(define.ReadinessCheckNotReady))
+
+		inspect := podmanTest.InspectContainer(ctrName)
+		Expect(inspect[0].Config.ReadinessCheck).ToNot(BeNil())
+		Expect(inspect[0].Config.ReadinessCheck.Interval).To(Equal(time.Second))
+		// A readiness probe does not add a healthcheck.
+		Expect(inspect[0].Config.Healthcheck).To(BeNil())
+		Expect(inspect[0].State.Ready).To(HaveValue(BeFalse()))
+
+		exec := podmanTest.Podman([]string{"exec", ctrName, "sh", "-c", "echo ready > /testfile"})
+		exec.WaitWithDefaultTimeout()
+		Expect(exec).Should(ExitCleanly())
+
+		hc = podmanTest.Podman([]string{"healthcheck", "run", "--readiness", ctrName})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc).Should(ExitCleanly())
+
+		inspect = podmanTest.InspectContainer(ctrName)
+		Expect(inspect[0].State.Ready).To(HaveValue(BeTrue()))
+
+		ps := podmanTest.Podman([]string{"ps", "--filter", "name=" + ctrName, "--format", "{{.Ready}} {{.Status}}"})
+		ps.WaitWithDefaultTimeout()
+		Expect(ps).Should(ExitCleanly())
+		Expect(ps.OutputToString()).To(HavePrefix(define.ReadinessCheckReady))
+		Expect(ps.OutputToString()).To(ContainSubstring("(ready)"))
+
+		wait := podmanTest.Podman([]string{"wait", "--condition", define.ReadinessCheckReady, ctrName})
+		wait.WaitWithDefaultTimeout()
+		Expect(wait).Should(ExitCleanly())
+	})
+
+	It("support StatefulSet with volumeClaimTemplates", func() {
+		err := writeYaml(statefulSetYaml, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.Podman([]string{"kube", "play", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitCleanly())
+
+		for _, ordinal := range []string{"0", "1"} {
+			podName := "web-" + ordinal
+			inspect := podmanTest.Podman([]string{"pod", "inspect", podName, "--format", "{{.Hostname}}"})
+			inspect.WaitWithDefaultTimeout()
+			Expect(inspect).Should(ExitCleanly())
+			Expect(inspect.OutputToString()).To(Equal(podName))
+
+			volName := "data-" + podName
+			exists := podmanTest.Podman([]string{"volume", "exists", volName})
+			exists.WaitWithDefaultTimeout()
+			Expect(exists).Should(ExitCleanly())
+
+			mounts := podmanTest.Podman([]string{"container", "inspect", podName + "-ctr", "--format", "{{range .Mounts}}{{.Name}}{{end}}"})
+			mounts.WaitWithDefaultTimeout()
+			Expect(mounts).Should(ExitCleanly())
+			Expect(mounts.OutputToString()).To(Equal(volName))
+		}
+
+		down := podmanTest.Podman([]string{"kube", "down", "--force", kubeYaml})
+		down.WaitWithDefaultTimeout()
+		Expect(down).Should(ExitCleanly())
+
+		exists := podmanTest.Podman([]string{"volume", "exists", "data-web-0"})
+		exists.WaitWithDefaultTimeout()
+		Expect(exists).Should(ExitWithError(1, ""))
+	})
+
+	It("support CronJob", func() {
+		SkipIfRemote("the systemd timer is created on the server side")
+		SkipIfSystemdNotRunning("CronJobs are scheduled with systemd timers")
+		err := writeYaml(cronJobYaml, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.Podman([]string{"kube", "play", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitCleanly())
+
+		// The pod is only started by the timer.
+		inspect := podmanTest.Podman([]string{"pod", "inspect", "nightly-pod", "--format", "{{.State}}"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal(define.PodStateCreated))
+
+		systemctl := []string{"is-active", "podman-kube-cronjob-nightly-pod.timer"}
+		if isRootless() {
+			systemctl = append([]string{"--user"}, systemctl...)
+		}
+		session := SystemExec("systemctl", systemctl)
+		Expect(session).Should(ExitCleanly())
+
+		// --replace replaces the timer instead of failing on the existing unit.
+		kube = podmanTest.Podman([]string{"kube", "play", "--replace", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(ExitCleanly())
+
+		session = SystemExec("systemctl", systemctl)
+		Expect(session).Should(ExitCleanly())
+
+		down := podmanTest.Podman([]string{"kube", "down", kubeYaml})
+		down.WaitWithDefaultTimeout()
+		Expect(down).Should(ExitCleanly())
+
+		session = SystemExec("systemctl", systemctl)
+		Expect(session).Should(ExitWithError(3, ""))
+	})
+
 	It("fail with nonexistent authfile", func() {
 		err := generateKubeYaml("pod", getPod(), kubeYaml)
 		Expect(err).ToNot(HaveOccurred())
@@ -986,6 +986,7 @@ BOGUS=foo
 		Entry("stoptimeout.container", "stoptimeout.container"),
 		Entry("subidmapping.container", "subidmapping.container"),
 		Entry("sysctl.container", "sysctl.container"),
+		Entry("timer.container", "timer.container"),
 		Entry("timezone.container", "timezone.container"),
 		Entry("ulimit.container", "ulimit.container"),
 		Entry("unmask.container", "unmask.container"),
@@ -998,6 +999,7 @@ BOGUS=foo
 		Entry("merged-override.container", "merged-override.container"),
 		Entry("template@.container", "template@.container"),
 		Entry("template@instance.container", "template@instance.container"),
+		Entry("ports-offset@7.container", "ports-offset@7.container"),
 		Entry("Unit After Override", "unit-after-override.container"),
 		Entry("NetworkAlias", "network-alias.container"),
 		Entry("CgroupMode", "cgroups-mode.container"),
@@ -1125,6 +1127,7 @@ BOGUS=foo
 		Entry("Artifact - Options", "options.artifact"),
 
 		Entry("Pod - Basic", "basic.pod"),
+		Entry("Pod - PublishPortOffset", "ports-offset.pod"),
 		Entry("Pod - DNS", "dns.pod"),
 		Entry("Pod - DNS Option", "dns-option.pod"),
 		Entry("Pod - DNS Search", "dns-search.pod"),
@@ -1149,6 +1152,7 @@ BOGUS=foo
 	DescribeTable("Running expected warning quadlet test case",
 		runWarningQuadletTestCase,
 		Entry("label-unsupported-escape.container", "label-unsupported-escape.container", "unsupported escape char"),
+		Entry("timer-remainafterexit.container", "timer-remainafterexit.container", "RemainAfterExit=yes prevents the timer of timer-remainafterexit.container from starting the service more than once"),
 		Entry("shortname.container", "shortname.container", "Warning: shortname.container specifies the image \"shortname\" which not a fully qualified image name. This is not ideal for performance and security reasons. See the podman-pull manpage discussion of short-name-aliases.conf for details."),
 
 		Entry("Unsupported Service Key - User", "service-user.container", "Warning: using key User in the Service group is not supported"),
@@ -1163,6 +1167,7 @@ BOGUS=foo
 
 		Entry("exec-unsupported-escape.container", "exec-unsupported-escape.container", "unsupported escape char"),
 		Entry("reloadcmd-unsupported-escape.container", "reloadcmd-unsupported-escape.container", "unsupported escape char"),
+		Entry("ports-offset@.container", "ports-offset@.container", "Warning: only the instances of the template are generated: PublishPortOffset=%i in ports-offset@.container depends on the instance name"),
 	)
 
 	DescribeTable("Running expected error quadlet test case",
@@ -1175,6 +1180,7 @@ BOGUS=foo
 		Entry("userns-with-remap.container", "userns-with-remap.container", "converting \"userns-with-remap.container\": deprecated Remap keys are set along with explicit mapping keys"),
 		Entry("reloadboth.container", "reloadboth.container", "converting \"reloadboth.container\": ReloadCmd and ReloadSignal are mutually exclusive but both are set"),
 		Entry("dependent.error.container", "dependent.error.container", "converting \"dependent.error.container\": unable to translate dependency for basic.container"),
+		Entry("ports-offset-invalid@x.container", "ports-offset-invalid@x.container", "converting \"ports-offset-invalid@x.container\": invalid port offset 'x' in ports-offset-invalid@x.container, it must be an integer"),
 
 		Entry("image-no-image.volume", "image-no-image.volume", "converting \"image-no-image.volume\": the key Image is mandatory when using the image driver"),
 		Entry("Volume - Quadlet image (.build) not found", "build-not-found.quadlet.volume", "converting \"build-not-found.quadlet.volume\": requested Quadlet image not-found.build was not found"),
@@ -1234,6 +1240,7 @@ BOGUS=foo
 		Entry("Container - Reuse another named container's network", "network.reuse.name.container", []string{"name.container"}),
 		Entry("Container - Reuse another container's network", "a.network.reuse.container", []string{"basic.container"}),
 		Entry("Container - Reuse another named container's network", "a.network.reuse.name.container", []string{"name.container"}),
+		Entry("Container - Reuse the network of a template instance", "network.reuse.instance.container", []string{"ports-offset@7.container"}),
 		Entry(
 			"Container - Dependency between quadlet units",
 			"dependent.container",
@@ -36,4 +36,70 @@ var _ = Describe("Podman run restart containers", func() {
 		session2.WaitWithDefaultTimeout()
 		Expect(session2).Should(ExitCleanly())
 	})
+
+	It("podman create with --restart-delay", func() {
+		session := podmanTest.Podman([]string{"create", "--name", "test", "--restart", "on-failure", "--restart-delay", "2s", "--restart-max-delay", "1m", ALPINE, "false"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		inspect := podmanTest.Podman([]string{"inspect", "test"})
+		inspect.WaitWithDefaultTimeout()
+		data := inspect.InspectContainerToJSON()
+		Expect(data).To(HaveLen(1))
+		Expect(data[0].HostConfig.RestartPolicy).To(HaveField("Delay", "2s"))
+		Expect(data[0].HostConfig.RestartPolicy).To(HaveField("MaxDelay", "1m0s"))
+		Expect(data[0].HostConfig.RestartPolicy).To(HaveField("ResetWindow", "10m0s"))
+	})
+
+	It("podman create with invalid --restart-delay fails", func() {
+		session := podmanTest.Podman([]string{"create", "--restart-delay", "1m", "--restart-max-delay", "10s", ALPINE, "false"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "maximum restart delay 10s must not be smaller than the restart delay 1m0s"))
+
+		session = podmanTest.Podman([]string{"create", "--restart-max-delay", "10s", ALPINE, "false"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "--restart-max-delay and --restart-reset-window require --restart-delay"))
+	})
+
+	It("podman run with --restart-delay enters backoff", func() {
+		session := podmanTest.Podman([]string{"run", "-d", "--name", "test", "--restart", "always", "--restart-delay", "1h", ALPINE, "false"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		Eventually(func() string {
+			inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.State.Status}}", "test"})
+			inspect.WaitWithDefaultTimeout()
+			return inspect.OutputToString()
+		}, "10s", "500ms").Should(Equal("backoff"))
+
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.State.Backoff.Delay}} {{.RestartCount}}", "test"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("1h0m0s 0"))
+
+		ps := podmanTest.Podman([]string{"ps", "-a", "--format", "{{.State}} {{.Status}}"})
+		ps.WaitWithDefaultTimeout()
+		Expect(ps).Should(ExitCleanly())
+		Expect(ps.OutputToString()).To(HavePrefix("backoff Backoff (1) restarting in"))
+
+		ps = podmanTest.Podman([]string{"ps", "-a", "--filter", "status=backoff", "--format", "{{.Names}}"})
+		ps.WaitWithDefaultTimeout()
+		Expect(ps).Should(ExitCleanly())
+		Expect(ps.OutputToString()).To(Equal("test"))
+
+		ps = podmanTest.Podman([]string{"ps", "-a", "--filter", "status=exited", "--format", "{{.Names}}"})
+		ps.WaitWithDefaultTimeout()
+		Expect(ps).Should(ExitCleanly())
+		Expect(ps.OutputToString()).To(BeEmpty())
+
+		// Stopping the container cancels the pending restart.
+		stop := podmanTest.Podman([]string{"stop", "test"})
+		stop.WaitWithDefaultTimeout()
+		Expect(stop).Should(ExitCleanly())
+
+		inspect = podmanTest.Podman([]string{"inspect", "--format", "{{.State.Status}}", "test"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("exited"))
+	})
 })
@@ -524,4 +524,54 @@ var _ = Describe("Podman secret", func() {
 		Expect(inspect).Should(ExitCleanly())
 		Expect(inspect.OutputToString()).To(Equal(secretData))
 	})
+
+	It("podman secret rotate", func() {
+		secretFilePath := filepath.Join(podmanTest.TempDir, "secret")
+		err := os.WriteFile(secretFilePath, []byte("olddata"), 0o755)
+		Expect(err).ToNot(HaveOccurred())
+
+		session := podmanTest.PodmanExitCleanly("secret", "create", "rotated", secretFilePath)
+		oldID := session.OutputToString()
+
+		podmanTest.PodmanExitCleanly("run", "-d", "--name", "consumer", "--secret", "rotated", ALPINE, "top")
+		podmanTest.PodmanExitCleanly("create", "--name", "stopped", "--secret", "rotated", ALPINE, "cat", "/run/secrets/rotated")
+
+		err = os.WriteFile(secretFilePath, []byte("newdata"), 0o755)
+		Expect(err).ToNot(HaveOccurred())
+		session = podmanTest.PodmanExitCleanly("secret", "rotate", "--exec-hook", "touch /tmp/reloaded", "rotated", secretFilePath)
+		newID := session.OutputToString()
+		Expect(newID).ToNot(Equal(oldID))
+
+		inspect := podmanTest.PodmanExitCleanly("secret", "inspect", "--showsecret", "--format", "{{.SecretData}}", "rotated")
+		Expect(inspect.OutputToString()).To(Equal("newdata"))
+
+		// The running container sees the new data without a restart.
+		cat := podmanTest.PodmanExitCleanly("exec", "consumer", "cat", "/run/secrets/rotated")
+		Expect(cat.OutputToString()).To(Equal("newdata"))
+		podmanTest.PodmanExitCleanly("exec", "consumer", "test", "-f", "/tmp/reloaded")
+
+		start := podmanTest.PodmanExitCleanly("start", "--attach", "stopped")
+		Expect(start.OutputToString()).To(Equal("newdata"))
+
+		result := podmanTest.PodmanExitCleanly("events", "--stream=false", "--filter", "event=rotate")
+		Expect(result.OutputToStringArray()).To(ContainElement(ContainSubstring(" secret rotate %s", newID)))
+
+		// The environment of a running container cannot be updated.
+		podmanTest.PodmanExitCleanly("run", "-d", "--name", "envconsumer", "--secret", "rotated,type=env", ALPINE, "top")
+		err = os.WriteFile(secretFilePath, []byte("envdata"), 0o755)
+		Expect(err).ToNot(HaveOccurred())
+		session = podmanTest.Podman([]string{"secret", "rotate", "rotated", secretFilePath})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "is used as environment variable by running container"))
+		inspect = podmanTest.PodmanExitCleanly("secret", "inspect", "--showsecret", "--format", "{{.SecretData}}", "rotated")
+		Expect(inspect.OutputToString()).To(Equal("newdata"))
+
+		session = podmanTest.Podman([]string{"secret", "rotate", "--signal", "NOSIG", "rotated", secretFilePath})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "invalid signal: NOSIG"))
+
+		session = podmanTest.Podman([]string{"secret", "rotate", "nonexistent", secretFilePath})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(125, "no such secret"))
+	})
 })
@@ -4,6 +4,7 @@ package integration
 
 import (
 	"fmt"
+	"os/exec"
 	"path/filepath"
 
 	. "github.com/containers/podman/v6/test/utils"
@@ -120,6 +121,78 @@ var _ = Describe("Podman volume create", func() {
 		Expect(session.OutputToString()).To(ContainSubstring("hello"))
 	})
 
+	It("podman export and import encrypted and compressed volume", func() {
+		volName := "my_vol_" + RandomString(10)
+		session := podmanTest.Podman([]string{"volume", "create", volName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"run", "--volume", volName + ":/data", ALPINE, "sh", "-c", "echo hello >> /data/test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		keyFileName := filepath.Join(podmanTest.TempDir, "key")
+		publicKeyFileName, privateKeyFileName, err := WriteRSAKeyPair(keyFileName, 2048)
+		Expect(err).ToNot(HaveOccurred())
+
+		helloTar := filepath.Join(podmanTest.TempDir, "hello.tar.zst")
+		session = podmanTest.Podman([]string{"volume", "export", "--compress", "zstd", "--encryption-key", "jwe:" + publicKeyFileName, "--output", helloTar, volName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"volume", "create", "my_vol2"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"volume", "import", "my_vol2", helloTar})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "volume archive is encrypted, a decryption key is required"))
+
+		session = podmanTest.Podman([]string{"volume", "import", "--decryption-key", privateKeyFileName, "my_vol2", helloTar})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"run", "--volume", "my_vol2:/data", ALPINE, "cat", "/data/test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("hello"))
+	})
+
+	It("podman export and import volume as artifact", func() {
+		SkipIfRemote("volume artifacts are not supported on the remote client")
+		volName := "my_vol_" + RandomString(10)
+		session := podmanTest.Podman([]string{"volume", "create", volName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"run", "--volume", volName + ":/data", ALPINE, "sh", "-c", "echo hello >> /data/test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		artifactName := "quay.io/podman/volume-backup:" + volName
+		session = podmanTest.Podman([]string{"volume", "export", "--compress", "zstd", "--artifact", artifactName, volName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"artifact", "inspect", "--format", "{{.Manifest.ArtifactType}}", artifactName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("application/vnd.podman.volume.v1"))
+
+		session = podmanTest.Podman([]string{"volume", "create", "my_vol2"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"volume", "import", "--artifact", artifactName, "my_vol2"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"run", "--volume", "my_vol2:/data", ALPINE, "cat", "/data/test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("hello"))
+	})
+
 	It("podman import/export volume should fail", func() {
 		// try import on volume or source which does not exist
 		SkipIfRemote("Volume export check does not work with a remote client")
@@ -299,4 +372,48 @@ var _ = Describe("Podman volume create", func() {
 		Expect(volumesCmd).Should(ExitCleanly())
 		Expect(volumesCmd.OutputToString()).To(Not(ContainSubstring(volName)))
 	})
+
+	It("podman create volume with loop quota", func() {
+		SkipIfRemote("volume usage is computed on the server")
+		if _, err := exec.LookPath("mkfs.ext4"); err != nil {
+			Skip("test requires mkfs.ext4")
+		}
+		if isRootless() {
+			if _, err := exec.LookPath("fuse2fs"); err != nil {
+				Skip("test requires fuse2fs when rootless")
+			}
+		}
+
+		session := podmanTest.Podman([]string{"volume", "create", "--opt", "o=size=16m,quota=bogus", "badvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, `invalid volume quota "bogus"`))
+
+		session = podmanTest.Podman([]string{"volume", "create", "--opt", "o=quota=loop", "badvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "volume option quota=loop requires the size option"))
+
+		volName := "quotavol"
+		session = podmanTest.Podman([]string{"volume", "create", "--opt", "o=size=16m,quota=loop", volName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		inspect := podmanTest.Podman([]string{"volume", "inspect", "--format", "{{.Usage.Limit}}", volName})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(ExitCleanly())
+		Expect(inspect.OutputToString()).To(Equal("16777216"))
+
+		session = podmanTest.Podman([]string{"run", "--rm", "-v", volName + ":/data", ALPINE, "ls", "-A", "/data"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(BeEmpty())
+
+		session = podmanTest.Podman([]string{"run", "--rm", "-v", volName + ":/data", ALPINE, "dd", "if=/dev/zero", "of=/data/fill", "bs=1M", "count=32"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError(1, "No space left on device"))
+
+		df := podmanTest.Podman([]string{"system", "df", "-v"})
+		df.WaitWithDefaultTimeout()
+		Expect(df).Should(ExitCleanly())
+		Expect(df.OutputToString()).To(MatchRegexp(volName + `\s+0\s+\S+\s+16.78MB`))
+	})
 })
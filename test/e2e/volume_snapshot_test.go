@@ -0,0 +1,90 @@
+//go:build linux || freebsd
+
+package integration
+
+import (
+	. "github.com/containers/podman/v6/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Podman volume snapshot", func() {
+	BeforeEach(func() {
+		SkipIfRemote("volume snapshots are not supported on the remote client")
+	})
+
+	AfterEach(func() {
+		podmanTest.CleanupVolume()
+	})
+
+	It("podman volume snapshot create, restore and rm", func() {
+		volName := "my_vol_" + RandomString(10)
+		session := podmanTest.Podman([]string{"volume", "create", volName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"run", "--volume", volName + ":/data", ALPINE, "sh", "-c", "echo hello > /data/test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "create", volName, "clean"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("clean"))
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "create", volName, "clean"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "already has a snapshot clean"))
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "ls", "--format", "{{.Volume}} {{.Name}}"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToStringArray()).To(Equal([]string{volName + " clean"}))
+
+		session = podmanTest.Podman([]string{"run", "--volume", volName + ":/data", ALPINE, "sh", "-c", "echo changed > /data/test; touch /data/new"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "restore", volName, "clean"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"run", "--volume", volName + ":/data", ALPINE, "ls", "/data"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToStringArray()).To(Equal([]string{"test"}))
+
+		session = podmanTest.Podman([]string{"run", "--volume", volName + ":/data", ALPINE, "cat", "/data/test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("hello"))
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "rm", volName, "clean"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+		Expect(session.OutputToString()).To(Equal("clean"))
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "restore", volName, "clean"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "has no snapshot clean"))
+	})
+
+	It("podman volume snapshot restore fails for volume in use", func() {
+		volName := "my_vol_" + RandomString(10)
+		session := podmanTest.Podman([]string{"volume", "create", volName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "create", volName, "empty"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"run", "-d", "--volume", volName + ":/data", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitCleanly())
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "restore", volName, "empty"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError(125, "is used by running container"))
+	})
+})
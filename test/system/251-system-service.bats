@@ -60,6 +60,12 @@ function _podman_system_service {
     assert $status -eq 0
     is $(jq -c <<< "$output") '{"ignored":"'$nonce'"}' "noop responder"
 
+    run_podman_testing --url "$URL" ls
+    assert $status -eq 0
+    for service in Containers Events Images Pods; do
+        assert "$output" =~ "io.podman.v1.$service" "$service service is registered"
+    done
+
     systemctl stop $SERVICE_NAME
     rm -f $PODMAN_TMPDIR/myunix.sock
 }
@@ -94,6 +100,37 @@ function _podman_system_service {
     run_podman rm -f -t 0 $cname
 }
 
+@test "podman system service --metrics-address" {
+    unset REMOTESYSTEM_TRANSPORT
+
+    skip_if_remote "podman system service unavailable over remote"
+    port=$(random_free_port)
+    metrics_port=$(random_free_port)
+    URL=tcp://127.0.0.1:$port
+
+    _podman_system_service $URL --time=0 --metrics-address 127.0.0.1:$metrics_port
+    wait_for_port 127.0.0.1 $port
+    wait_for_port 127.0.0.1 $metrics_port
+
+    cname=c-$(safename)
+    run_podman --url $URL run -d --name $cname $IMAGE top -d 2
+    cid="$output"
+    run_podman --url $URL ps
+
+    run curl -sS --fail http://127.0.0.1:$metrics_port/metrics
+    assert $status -eq 0 "curl /metrics"
+    assert "$output" =~ 'podman_containers\{state="running"\} [1-9]' "running containers are counted"
+    assert "$output" =~ "podman_container_memory_usage_bytes\\{id=\"$cid\",name=\"$cname\"\\}" \
+           "memory usage of the container"
+    assert "$output" =~ "podman_container_restarts_total\\{id=\"$cid\",name=\"$cname\"\\} 0" \
+           "restart count of the container"
+    assert "$output" =~ 'podman_api_request_duration_seconds_count\{code="200",method="GET",route="[^"]*/libpod/containers/json"\}' \
+           "API request latency by route"
+
+    run_podman rm -f -t 0 $cname
+    systemctl stop $SERVICE_NAME
+}
+
 # This doesn't actually test podman system service, but we require it,
 # so least-awful choice is to run from this test file.
 @test "podman --host / -H options" {
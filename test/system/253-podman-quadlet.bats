@@ -563,3 +563,161 @@ EOF
     # Cleanup: Remove the installed quadlet
     run_podman quadlet rm long.container
 }
+
+@test "quadlet verb - start, status, logs, restart, stop" {
+    local quadlet_name=lifecycle-$(safename).container
+    local quadlet_file=$PODMAN_TMPDIR/$quadlet_name
+    cat > $quadlet_file <<EOF
+[Container]
+Image=$IMAGE
+Exec=sh -c "echo STARTED CONTAINER FOR LIFECYCLE TEST; trap 'exit' SIGTERM; while :; do sleep 0.1; done"
+EOF
+
+    local service_name=$(quadlet_to_service_name "$quadlet_name")
+
+    run_podman 125 quadlet start nonexistent-$(safename).container
+    assert "$output" =~ "no such quadlet" "start of a nonexistent quadlet"
+
+    run_podman quadlet install $quadlet_file
+
+    run_podman quadlet status --format '{{.UnitName}} {{.ActiveState}} {{.PID}}' $quadlet_name
+    is "$output" "$service_name inactive -" "status before start"
+
+    run_podman quadlet start $quadlet_name
+    is "$output" "$quadlet_name" "start prints the quadlet name"
+
+    run_podman quadlet status --format '{{.ActiveState}} {{.SubState}}' $quadlet_name
+    is "$output" "active running" "status after start"
+
+    run_podman quadlet status --format json $quadlet_name
+    assert "$output" =~ "\"UnitName\": \"$service_name\"" "status in JSON"
+
+    # The container may not have written its output to the journal yet
+    for i in {1..10}; do
+        run_podman quadlet logs $quadlet_name
+        if [[ "$output" =~ "STARTED CONTAINER FOR LIFECYCLE TEST" ]]; then
+            break
+        fi
+        sleep 0.5
+    done
+    assert "$output" =~ "STARTED CONTAINER FOR LIFECYCLE TEST" "logs of the quadlet"
+
+    run_podman quadlet logs --tail 1 $quadlet_name
+    assert "${#lines[@]}" -eq 1 "logs --tail 1 prints one line"
+
+    run_podman quadlet restart $quadlet_name
+    is "$output" "$quadlet_name" "restart prints the quadlet name"
+
+    run_podman quadlet stop $quadlet_name
+    is "$output" "$quadlet_name" "stop prints the quadlet name"
+
+    run_podman quadlet status --format '{{.ActiveState}}' $quadlet_name
+    is "$output" "inactive" "status after stop"
+
+    # A missing quadlet does not hide the status of the others
+    run_podman 125 quadlet status --format '{{.Name}} {{.ActiveState}}' nonexistent-$(safename).container $quadlet_name
+    assert "$output" =~ "$quadlet_name inactive" "status of the existing quadlet"
+    assert "$output" =~ "no such quadlet" "error for the missing quadlet"
+
+    run_podman quadlet rm $quadlet_name
+}
+
+@test "quadlet verb - validate" {
+    local quadlet_name=validate-$(safename).container
+    local quadlet_file=$PODMAN_TMPDIR/$quadlet_name
+    cat > $quadlet_file <<EOF
+[Container]
+Image=$IMAGE
+Bogus=true
+EOF
+
+    run_podman 125 quadlet validate $quadlet_file
+    assert "$output" =~ "$quadlet_file:3: error: unsupported key 'Bogus' in group 'Container'" "unknown key reported with its line"
+
+    run_podman 125 quadlet validate --format json $quadlet_file
+    assert "$output" =~ "\"Key\": \"Bogus\"" "JSON problem has the key"
+    assert "$output" =~ "\"Line\": 3" "JSON problem has the line"
+
+    sed -i /Bogus/d $quadlet_file
+    run_podman quadlet validate $quadlet_file
+    assert "$output" =~ "\+\+\+ $(quadlet_to_service_name "$quadlet_name")" "diff to the unit not generated yet"
+
+    run_podman quadlet validate --diff=false $quadlet_file
+    is "$output" "" "no output for a valid quadlet without diff"
+
+    # Validating does not install anything
+    run_podman quadlet list --format '{{.Name}}'
+    assert "$output" !~ "$quadlet_name" "validated quadlet is not installed"
+}
+
+@test "quadlet verb - template instances" {
+    local template_prefix=instance-$(safename)
+    local quadlet_file=$PODMAN_TMPDIR/$template_prefix@.container
+    cat > $quadlet_file <<EOF
+[Container]
+Image=$IMAGE
+ContainerName=$template_prefix-%i
+Environment=INSTANCE=%i
+Exec=sh -c "echo STARTED INSTANCE \$INSTANCE; trap 'exit' SIGTERM; while :; do sleep 0.1; done"
+EOF
+
+    run_podman quadlet install $quadlet_file
+
+    # Instances do not need a Quadlet of their own
+    run_podman quadlet start $template_prefix@1.container $template_prefix@2.container
+    is "$output" "$template_prefix@1.container
+$template_prefix@2.container" "start prints the instance names"
+
+    run_podman container inspect --format '{{.State.Status}}' $template_prefix-1 $template_prefix-2
+    is "$output" "running
+running" "a container runs for each instance"
+
+    run_podman quadlet list --format '{{.Name}} {{.UnitName}} {{.Status}}' --filter name=$template_prefix@.*
+    assert "$output" =~ "$template_prefix@1.container $template_prefix@1.service active/running" "list shows instance 1"
+    assert "$output" =~ "$template_prefix@2.container $template_prefix@2.service active/running" "list shows instance 2"
+
+    run_podman quadlet status --format '{{.UnitName}} {{.ActiveState}}' $template_prefix@2.container
+    is "$output" "$template_prefix@2.service active" "status of an instance"
+
+    run_podman quadlet stop $template_prefix@1.container $template_prefix@2.container
+
+    run_podman 125 quadlet rm $template_prefix@1.container
+    assert "$output" =~ "could not locate quadlet" "instances are removed with their template"
+
+    run_podman quadlet rm $template_prefix@.container
+}
+
+@test "quadlet verb - template instances with port offsets" {
+    local template_prefix=offset-$(safename)
+    local quadlet_file=$PODMAN_TMPDIR/$template_prefix@.container
+    local ports=$(random_free_port_range 2)
+    local port=${ports%-*}
+    cat > $quadlet_file <<EOF
+[Container]
+Image=$IMAGE
+ContainerName=$template_prefix-%i
+PublishPortOffset=%i
+PublishPort=$port:80
+Exec=sh -c "trap 'exit' SIGTERM; while :; do sleep 0.1; done"
+EOF
+
+    run_podman quadlet install $quadlet_file
+
+    # No template service is generated, instances need a symlink
+    run_podman 125 quadlet start $template_prefix@1.container
+    assert "$output" =~ "each instance needs a symlink" "start without symlink"
+
+    local install_dir=$(get_quadlet_install_dir)
+    ln -s $template_prefix@.container $install_dir/$template_prefix@1.container
+    systemctl daemon-reload
+
+    run_podman quadlet start $template_prefix@1.container
+    is "$output" "$template_prefix@1.container" "start prints the instance name"
+
+    run_podman port $template_prefix-1 80
+    is "$output" "0.0.0.0:$((port + 1))" "host port is offset by the instance"
+
+    run_podman quadlet stop $template_prefix@1.container
+    rm -f "${install_dir:?}/${template_prefix:?}@1.container"
+    run_podman quadlet rm $template_prefix@.container
+}
@@ -273,6 +273,21 @@ function _confirm_update() {
     is "$output" "$ori_image" "Image ID should not change"
 }
 
+@test "podman auto-update - invalid semver and signature-policy labels" {
+    generate_service alpine registry "" "--label io.containers.autoupdate.semver=not-a-range"
+    _wait_service_ready container-$cname.service
+
+    generate_service alpine local "" "--label io.containers.autoupdate.signature-policy=/etc/containers/policy.json"
+    _wait_service_ready container-$cname.service
+
+    run_podman 125 auto-update
+    assert "$output" =~ 'auto-updating container "[0-9a-f]{64}": invalid io.containers.autoupdate.semver label "not-a-range"' "invalid semver range"
+    assert "$output" =~ 'auto-updating container "[0-9a-f]{64}": the io.containers.autoupdate.signature-policy and io.containers.autoupdate.semver labels require the registry policy' "labels require the registry policy"
+
+    run_podman inspect --format "{{.Image}}" $cname
+    is "$output" "$ori_image" "Image ID should not change"
+}
+
 @test "podman auto-update - label io.containers.autoupdate=local" {
     generate_service localtest local
     _wait_service_ready container-$cname.service
@@ -347,6 +362,40 @@ EOF
     _confirm_update $cname $newID
 }
 
+@test "podman auto-update - health check gated rollback and stages" {
+    image=quay.io/libpod/localtest:latest
+    dockerfile=$PODMAN_TMPDIR/Dockerfile
+    cat >$dockerfile <<EOF
+FROM $IMAGE
+RUN printf '#!/bin/sh\nexit 0\n' >/healthy && chmod +x /healthy
+EOF
+    run_podman build -t $image -f $dockerfile $PODMAN_TMPDIR
+
+    health="--health-cmd=/healthy --health-interval=1s --health-retries=1"
+    generate_service localtest local "" "$health --label io.containers.autoupdate.stage=1" noTag
+    canary=$cname
+    canary_image=$ori_image
+    generate_service localtest local "" "$health --label io.containers.autoupdate.stage=2" noTag
+    _wait_service_ready container-$canary.service
+    _wait_service_ready container-$cname.service
+
+    # The updated image starts but fails the health check.
+    cat >$dockerfile <<EOF
+FROM $IMAGE
+LABEL broken=true
+EOF
+    run_podman build -t $image -f $dockerfile $PODMAN_TMPDIR
+
+    run_podman auto-update --health-timeout=30s --format "{{.Unit}},{{.Updated}}"
+    assert "$output" =~ "container-$canary.service,rolled back" "Canary rolled back after failing the health check"
+    assert "$output" =~ "container-$cname.service,halted" "Later stage not updated"
+
+    run_podman inspect --format "{{.Image}}" $canary
+    assert "$output" == "$canary_image" "Canary runs the previous image"
+    run_podman inspect --format "{{.Image}}" $cname
+    assert "$output" == "$ori_image" "Later stage runs the previous image"
+}
+
 @test "podman auto-update with multiple services" {
     # Preserve original image ID, to confirm that it changes (or not)
     run_podman inspect --format "{{.Id}}" $IMAGE